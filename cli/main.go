@@ -32,6 +32,8 @@ var (
 	destroyCmd = app.Command("destroy", "Destroy a deployment")
 	name       = app.Flag("name", "Deployment name to use").String()
 
+	kubeContext = app.Flag("kube-context", "Kubernetes context to deploy to. Defaults to the kubeconfig's current context").String()
+
 	// kingpin vars
 	image = deployCmd.Flag("image", "Image to deploy.").Required().String()
 	port  = deployCmd.Flag("port", "port container listens on").Default("80").Int()
@@ -73,10 +75,15 @@ var (
 // pulumiProgram is the Pulumi program itself where resources are declared. It deploys a simple static website to S3.
 func pulumiProgram(ctx *pulumi.Context) error {
 
-	application, err := productionapp.NewDeployment(ctx, "productionapp", &productionapp.DeploymentArgs{
+	args := &productionapp.DeploymentArgs{
 		Image: pulumi.String(*image),
 		Port:  pulumi.Int(*port),
-	})
+	}
+	if *kubeContext != "" {
+		args.Context = pulumi.String(*kubeContext)
+	}
+
+	application, err := productionapp.NewDeployment(ctx, "productionapp", args)
 	if err != nil {
 		return fmt.Errorf("error creating application: %v", err)
 	}
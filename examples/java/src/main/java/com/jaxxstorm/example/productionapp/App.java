@@ -1,8 +1,8 @@
 package com.jaxxstorm.example.productionapp;
 
 import com.pulumi.Pulumi;
-import com.pulumi.productionapp.Deployment;
-import com.pulumi.productionapp.DeploymentArgs;
+import com.jaxxstorm.productionapp.Deployment;
+import com.jaxxstorm.productionapp.DeploymentArgs;
 
 
 public class App {
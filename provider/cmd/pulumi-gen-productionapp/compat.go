@@ -0,0 +1,355 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+// Two versions of the schema are compared to tell whether programs written against the old one
+// keep working with the new one. A change is breaking if such a program may stop compiling or
+// running: something it uses is removed or retyped, an input it doesn't set becomes required or
+// an output it reads may become unset. Changes to descriptions aren't reported.
+//
+// An object type's properties are inputs when the type is used by inputs and outputs when it is
+// used by outputs, and both when it is used by both.
+
+// compatChange is a difference between two versions of the schema.
+type compatChange struct {
+	pointer  string
+	message  string
+	breaking bool
+}
+
+// compatCommand compares two versions of the schema.
+func compatCommand(args []string) {
+	flags := flag.NewFlagSet("compat", flag.ExitOnError)
+	bump := flags.Bool("bump", false, "only print the version bump the changes call for: major, minor or patch")
+	allowBreaking := flags.Bool("allow-breaking", false, "succeed even if the changes are breaking")
+	flags.Usage = func() {
+		out := flags.Output()
+		fmt.Fprintf(out, "Usage: %s compat [--bump] [--allow-breaking] <old-schema-file> <new-schema-file>\n\n", os.Args[0])
+		fmt.Fprintf(out, "Fails if the changes are breaking, so they call for a major version bump, unless\n")
+		fmt.Fprintf(out, "--allow-breaking is set.\n\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	changes, err := compareSchemas(flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+	if *bump {
+		fmt.Println(versionBump(changes))
+		exitIfBreaking(changes, *allowBreaking)
+		return
+	}
+
+	for _, breaking := range []bool{true, false} {
+		heading := "Non-breaking changes:"
+		if breaking {
+			heading = "Breaking changes:"
+		}
+		printed := false
+		for _, c := range changes {
+			if c.breaking != breaking {
+				continue
+			}
+			if !printed {
+				fmt.Println(heading)
+				printed = true
+			}
+			fmt.Printf("  %s: %s\n", c.pointer, c.message)
+		}
+		if printed {
+			fmt.Println()
+		}
+	}
+	fmt.Printf("Version bump: %s\n", versionBump(changes))
+	exitIfBreaking(changes, *allowBreaking)
+}
+
+// exitIfBreaking exits with an error if the changes are breaking and they aren't allowed.
+func exitIfBreaking(changes []compatChange, allowBreaking bool) {
+	if err := checkBreaking(changes, allowBreaking); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+// checkBreaking returns an error if the changes are breaking, unless allowBreaking.
+func checkBreaking(changes []compatChange, allowBreaking bool) error {
+	if allowBreaking || versionBump(changes) != "major" {
+		return nil
+	}
+	return fmt.Errorf("the breaking changes call for a major version bump; " +
+		"pass --allow-breaking if the release is a major one")
+}
+
+// versionBump returns the semantic version bump a release with the changes calls for.
+func versionBump(changes []compatChange) string {
+	bump := "patch"
+	for _, c := range changes {
+		if c.breaking {
+			return "major"
+		}
+		bump = "minor"
+	}
+	return bump
+}
+
+// compareSchemas returns the changes from the schema at oldPath to the one at newPath.
+func compareSchemas(oldPath, newPath string) ([]compatChange, error) {
+	oldSpec, err := readSpec(oldPath)
+	if err != nil {
+		return nil, err
+	}
+	newSpec, err := readSpec(newPath)
+	if err != nil {
+		return nil, err
+	}
+	return compareSpecs(oldSpec, newSpec), nil
+}
+
+// compareSpecs returns the changes from oldSpec to newSpec.
+func compareSpecs(oldSpec, newSpec schema.PackageSpec) []compatChange {
+	c := &comparer{}
+	c.oldInputs, c.oldOutputs = typeUsage(oldSpec)
+	c.newInputs, c.newOutputs = typeUsage(newSpec)
+
+	c.properties("#/config/variables", oldSpec.Config.Variables, newSpec.Config.Variables,
+		oldSpec.Config.Required, newSpec.Config.Required, true, false)
+	c.resource("#/provider", oldSpec.Provider, newSpec.Provider)
+
+	for _, tok := range unionKeys(oldSpec.Resources, newSpec.Resources) {
+		pointer := resourcesPointer + tok
+		oldResource, inOld := oldSpec.Resources[tok]
+		newResource, inNew := newSpec.Resources[tok]
+		switch {
+		case !inNew:
+			c.change(pointer, true, "resource removed")
+		case !inOld:
+			c.change(pointer, false, "resource added")
+		default:
+			c.resource(pointer, oldResource, newResource)
+		}
+	}
+
+	for _, tok := range unionKeys(oldSpec.Functions, newSpec.Functions) {
+		pointer := "#/functions/" + tok
+		oldFunction, inOld := oldSpec.Functions[tok]
+		newFunction, inNew := newSpec.Functions[tok]
+		switch {
+		case !inNew:
+			c.change(pointer, true, "function removed")
+		case !inOld:
+			c.change(pointer, false, "function added")
+		default:
+			c.object(pointer+"/inputs", oldFunction.Inputs, newFunction.Inputs, true, false)
+			c.object(pointer+"/outputs", oldFunction.Outputs, newFunction.Outputs, false, true)
+		}
+	}
+
+	for _, tok := range unionKeys(oldSpec.Types, newSpec.Types) {
+		pointer := typesPointer + tok
+		oldType, inOld := oldSpec.Types[tok]
+		newType, inNew := newSpec.Types[tok]
+		switch {
+		case !inNew:
+			c.change(pointer, true, "type removed")
+		case !inOld:
+			c.change(pointer, false, "type added")
+		case len(oldType.Enum) > 0 || len(newType.Enum) > 0:
+			c.enum(pointer, oldType, newType)
+		default:
+			input := c.oldInputs[tok] || c.newInputs[tok]
+			output := c.oldOutputs[tok] || c.newOutputs[tok]
+			c.properties(pointer+"/properties", oldType.Properties, newType.Properties,
+				oldType.Required, newType.Required, input, output)
+		}
+	}
+	return c.changes
+}
+
+// comparer collects the changes between two versions of the schema.
+type comparer struct {
+	// oldInputs and newInputs are the types used by inputs, and oldOutputs and newOutputs the
+	// types used by outputs, in each version.
+	oldInputs, oldOutputs map[string]bool
+	newInputs, newOutputs map[string]bool
+	changes               []compatChange
+}
+
+func (c *comparer) change(pointer string, breaking bool, format string, args ...interface{}) {
+	c.changes = append(c.changes, compatChange{pointer: pointer, message: fmt.Sprintf(format, args...), breaking: breaking})
+}
+
+func (c *comparer) resource(pointer string, oldResource, newResource schema.ResourceSpec) {
+	c.properties(pointer+"/inputProperties", oldResource.InputProperties, newResource.InputProperties,
+		oldResource.RequiredInputs, newResource.RequiredInputs, true, false)
+	c.properties(pointer+"/properties", oldResource.Properties, newResource.Properties,
+		oldResource.Required, newResource.Required, false, true)
+
+	for _, name := range unionKeys(oldResource.Methods, newResource.Methods) {
+		oldMethod, inOld := oldResource.Methods[name]
+		newMethod, inNew := newResource.Methods[name]
+		switch {
+		case !inNew:
+			c.change(pointer+"/methods/"+name, true, "method removed")
+		case !inOld:
+			c.change(pointer+"/methods/"+name, false, "method added")
+		case oldMethod != newMethod:
+			c.change(pointer+"/methods/"+name, true, "method's function changed from %s to %s", oldMethod, newMethod)
+		}
+	}
+}
+
+// object compares a function's inputs or outputs, either of which may be missing.
+func (c *comparer) object(pointer string, oldObject, newObject *schema.ObjectTypeSpec, input, output bool) {
+	if oldObject == nil {
+		oldObject = &schema.ObjectTypeSpec{}
+	}
+	if newObject == nil {
+		newObject = &schema.ObjectTypeSpec{}
+	}
+	c.properties(pointer+"/properties", oldObject.Properties, newObject.Properties,
+		oldObject.Required, newObject.Required, input, output)
+}
+
+// properties compares the properties of an object. input and output tell whether they are set
+// by programs, read by programs, or both.
+func (c *comparer) properties(pointer string, oldProperties, newProperties map[string]schema.PropertySpec,
+	oldRequired, newRequired []string, input, output bool) {
+	for _, name := range unionKeys(oldProperties, newProperties) {
+		propertyPointer := pointer + "/" + name
+		oldProperty, inOld := oldProperties[name]
+		newProperty, inNew := newProperties[name]
+		wasRequired, isRequired := contains(oldRequired, name), contains(newRequired, name)
+		switch {
+		case !inNew:
+			c.change(propertyPointer, true, "property removed")
+			continue
+		case !inOld && input && isRequired:
+			c.change(propertyPointer, true, "required input added")
+			continue
+		case !inOld:
+			c.change(propertyPointer, false, "property added")
+			continue
+		}
+
+		if !reflect.DeepEqual(oldProperty.TypeSpec, newProperty.TypeSpec) {
+			c.change(propertyPointer, true, "type changed from %s to %s",
+				typeSpecString(oldProperty.TypeSpec), typeSpecString(newProperty.TypeSpec))
+		}
+
+		switch {
+		case !wasRequired && isRequired && input:
+			c.change(propertyPointer, true, "input became required")
+		case wasRequired && !isRequired && output:
+			c.change(propertyPointer, true, "output may now be unset")
+		case wasRequired != isRequired:
+			c.change(propertyPointer, false, "became %s", map[bool]string{true: "required", false: "optional"}[isRequired])
+		}
+
+		if !reflect.DeepEqual(oldProperty.Default, newProperty.Default) {
+			c.change(propertyPointer, false, "default changed from %s to %s",
+				defaultString(oldProperty.Default), defaultString(newProperty.Default))
+		}
+		if oldProperty.Secret != newProperty.Secret {
+			c.change(propertyPointer, false, "became %s", map[bool]string{true: "secret", false: "not secret"}[newProperty.Secret])
+		}
+		if oldProperty.DeprecationMessage == "" && newProperty.DeprecationMessage != "" {
+			c.change(propertyPointer, false, "deprecated: %s", newProperty.DeprecationMessage)
+		}
+	}
+}
+
+func (c *comparer) enum(pointer string, oldType, newType schema.ComplexTypeSpec) {
+	if oldType.Type != newType.Type {
+		c.change(pointer, true, "enum type changed from %s to %s", oldType.Type, newType.Type)
+		return
+	}
+	values := func(t schema.ComplexTypeSpec) map[string]bool {
+		values := map[string]bool{}
+		for _, e := range t.Enum {
+			values[fmt.Sprint(e.Value)] = true
+		}
+		return values
+	}
+	oldValues, newValues := values(oldType), values(newType)
+	for _, value := range unionKeys(oldValues, newValues) {
+		switch {
+		case !newValues[value]:
+			c.change(pointer, true, "enum value %q removed", value)
+		case !oldValues[value]:
+			c.change(pointer, false, "enum value %q added", value)
+		}
+	}
+}
+
+// typeSpecString renders a type for a change's message.
+func typeSpecString(t schema.TypeSpec) string {
+	var s string
+	switch {
+	case t.Ref != "":
+		s = tokenName(strings.TrimPrefix(t.Ref, typesPointer))
+	case t.Type == "array" && t.Items != nil:
+		s = typeSpecString(*t.Items) + "[]"
+	case t.Type == "object" && t.AdditionalProperties != nil:
+		s = "map[string]" + typeSpecString(*t.AdditionalProperties)
+	case len(t.OneOf) > 0:
+		elements := make([]string, len(t.OneOf))
+		for i, element := range t.OneOf {
+			elements[i] = typeSpecString(element)
+		}
+		s = strings.Join(elements, " | ")
+	default:
+		s = t.Type
+	}
+	if t.Plain {
+		s = "plain " + s
+	}
+	return s
+}
+
+func defaultString(value interface{}) string {
+	if value == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// unionKeys returns the keys in either map, in order.
+func unionKeys[V any](a, b map[string]V) []string {
+	union := map[string]bool{}
+	for k := range a {
+		union[k] = true
+	}
+	for k := range b {
+		union[k] = true
+	}
+	return sortedKeys(union)
+}
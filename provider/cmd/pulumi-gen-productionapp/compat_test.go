@@ -0,0 +1,188 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+const (
+	appToken = "productionapp:index:Deployment"
+	envToken = "productionapp:index:Environment"
+)
+
+// compatSpec returns a small schema: a resource with inputs and outputs and an enum input.
+func compatSpec() schema.PackageSpec {
+	return schema.PackageSpec{
+		Name: "productionapp",
+		Resources: map[string]schema.ResourceSpec{
+			appToken: {
+				ObjectTypeSpec: schema.ObjectTypeSpec{
+					Properties: map[string]schema.PropertySpec{
+						"url":      {TypeSpec: schema.TypeSpec{Type: "string"}},
+						"replicas": {TypeSpec: schema.TypeSpec{Type: "integer"}},
+					},
+					Required: []string{"url", "replicas"},
+				},
+				InputProperties: map[string]schema.PropertySpec{
+					"image":       {TypeSpec: schema.TypeSpec{Type: "string"}},
+					"port":        {TypeSpec: schema.TypeSpec{Type: "integer"}},
+					"environment": {TypeSpec: schema.TypeSpec{Ref: "#/types/" + envToken}},
+				},
+				RequiredInputs: []string{"image"},
+			},
+		},
+		Types: map[string]schema.ComplexTypeSpec{
+			envToken: {
+				ObjectTypeSpec: schema.ObjectTypeSpec{Type: "string"},
+				Enum:           []schema.EnumValueSpec{{Value: "dev"}, {Value: "prod"}},
+			},
+		},
+	}
+}
+
+func TestCompareSpecs(t *testing.T) {
+	const resource = resourcesPointer + appToken
+	tests := []struct {
+		name     string
+		change   func(spec *schema.PackageSpec)
+		expected []compatChange
+		bump     string
+	}{
+		{
+			name: "unchanged",
+			bump: "patch",
+		},
+		{
+			name: "removed property",
+			change: func(spec *schema.PackageSpec) {
+				delete(spec.Resources[appToken].InputProperties, "port")
+			},
+			expected: []compatChange{{resource + "/inputProperties/port", "property removed", true}},
+			bump:     "major",
+		},
+		{
+			name: "newly required input",
+			change: func(spec *schema.PackageSpec) {
+				r := spec.Resources[appToken]
+				r.RequiredInputs = append(r.RequiredInputs, "port")
+				spec.Resources[appToken] = r
+			},
+			expected: []compatChange{{resource + "/inputProperties/port", "input became required", true}},
+			bump:     "major",
+		},
+		{
+			name: "added required input",
+			change: func(spec *schema.PackageSpec) {
+				r := spec.Resources[appToken]
+				r.InputProperties["namespace"] = schema.PropertySpec{TypeSpec: schema.TypeSpec{Type: "string"}}
+				r.RequiredInputs = append(r.RequiredInputs, "namespace")
+				spec.Resources[appToken] = r
+			},
+			expected: []compatChange{{resource + "/inputProperties/namespace", "required input added", true}},
+			bump:     "major",
+		},
+		{
+			name: "changed type",
+			change: func(spec *schema.PackageSpec) {
+				spec.Resources[appToken].InputProperties["port"] = schema.PropertySpec{TypeSpec: schema.TypeSpec{Type: "string"}}
+			},
+			expected: []compatChange{{resource + "/inputProperties/port", "type changed from integer to string", true}},
+			bump:     "major",
+		},
+		{
+			name: "removed enum value",
+			change: func(spec *schema.PackageSpec) {
+				env := spec.Types[envToken]
+				env.Enum = env.Enum[:1]
+				spec.Types[envToken] = env
+			},
+			expected: []compatChange{{typesPointer + envToken, `enum value "prod" removed`, true}},
+			bump:     "major",
+		},
+		{
+			name: "output became optional",
+			change: func(spec *schema.PackageSpec) {
+				r := spec.Resources[appToken]
+				r.Required = []string{"url"}
+				spec.Resources[appToken] = r
+			},
+			expected: []compatChange{{resource + "/properties/replicas", "output may now be unset", true}},
+			bump:     "major",
+		},
+		{
+			name: "added optional input and enum value",
+			change: func(spec *schema.PackageSpec) {
+				spec.Resources[appToken].InputProperties["labels"] = schema.PropertySpec{TypeSpec: schema.TypeSpec{
+					Type: "object", AdditionalProperties: &schema.TypeSpec{Type: "string"},
+				}}
+				env := spec.Types[envToken]
+				env.Enum = append(env.Enum, schema.EnumValueSpec{Value: "staging"})
+				spec.Types[envToken] = env
+			},
+			expected: []compatChange{
+				{resource + "/inputProperties/labels", "property added", false},
+				{typesPointer + envToken, `enum value "staging" added`, false},
+			},
+			bump: "minor",
+		},
+		{
+			name: "input became optional",
+			change: func(spec *schema.PackageSpec) {
+				r := spec.Resources[appToken]
+				r.RequiredInputs = nil
+				spec.Resources[appToken] = r
+			},
+			expected: []compatChange{{resource + "/inputProperties/image", "became optional", false}},
+			bump:     "minor",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newSpec := compatSpec()
+			if tt.change != nil {
+				tt.change(&newSpec)
+			}
+			changes := compareSpecs(compatSpec(), newSpec)
+			if !reflect.DeepEqual(changes, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, changes)
+			}
+			if got := versionBump(changes); got != tt.bump {
+				t.Errorf("expected a %s version bump, got %s", tt.bump, got)
+			}
+		})
+	}
+}
+
+func TestCheckBreaking(t *testing.T) {
+	breaking := []compatChange{
+		{"#/a", "property removed", true},
+		{"#/b", "property added", false},
+		{"#/c", "type changed from integer to string", true},
+	}
+	err := checkBreaking(breaking, false)
+	if expected := "the breaking changes call for a major version bump; pass --allow-breaking if the release is a major one"; err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+	if err := checkBreaking(breaking, true); err != nil {
+		t.Errorf("unexpected error with --allow-breaking: %v", err)
+	}
+	if err := checkBreaking(breaking[1:2], false); err != nil {
+		t.Errorf("unexpected error for non-breaking changes: %v", err)
+	}
+}
@@ -0,0 +1,530 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+// The reference docs are Markdown rendered from the schema: an index of the package's
+// configuration, resources, functions and types, a page for each resource with its inputs,
+// outputs and methods, and pages for the functions and types. Resource pages show the example
+// programs that create the resource, one per language.
+
+// docsHeader marks the docs as generated, the way the SDK generators mark their files, so that
+// stale pages are recognised and removed.
+const docsHeader = "<!-- *** WARNING: this file was generated by " + tool + ". *** -->\n" +
+	"<!-- *** Do not edit by hand unless you're certain you know what you are doing! *** -->\n\n"
+
+// exampleLanguage describes how to find and show a language's example programs.
+type exampleLanguage struct {
+	// dir is the language's directory in the examples directory.
+	dir string
+	// title is the heading the language's examples are shown under.
+	title string
+	// fence is the language of the examples' code blocks.
+	fence string
+	// extensions are the extensions of the language's source files.
+	extensions []string
+}
+
+// exampleLanguages are the languages examples are shown in, in the order they're shown.
+var exampleLanguages = []exampleLanguage{
+	{dir: "nodejs", title: "TypeScript", fence: "typescript", extensions: []string{".ts", ".js"}},
+	{dir: "python", title: "Python", fence: "python", extensions: []string{".py"}},
+	{dir: "go", title: "Go", fence: "go", extensions: []string{".go"}},
+	{dir: "dotnet", title: "C#", fence: "csharp", extensions: []string{".cs"}},
+	{dir: "java", title: "Java", fence: "java", extensions: []string{".java"}},
+	{dir: "yaml", title: "YAML", fence: "yaml", extensions: []string{".yaml"}},
+}
+
+// example is a source file in the examples directory.
+type example struct {
+	language exampleLanguage
+	path     string
+	contents string
+}
+
+// uses reports whether the example creates the resource with the type token.
+func (e example) uses(token string) bool {
+	if e.language.dir == "yaml" {
+		return regexp.MustCompile(`(?m)^\s*type:\s*"?` + regexp.QuoteMeta(token) + `"?\s*$`).MatchString(e.contents)
+	}
+	// Go constructs resources with NewName, the other languages with Name.
+	return regexp.MustCompile(`\b(New)?` + regexp.QuoteMeta(tokenName(token)) + `\(`).MatchString(e.contents)
+}
+
+// docsCommand generates the reference docs from the schema, or checks that they are up to date.
+func docsCommand(args []string) {
+	flags := flag.NewFlagSet("docs", flag.ExitOnError)
+	check := flags.Bool("check", false, "fail and print a diff if the docs on disk are out of date instead of writing them")
+	examplesDir := flags.String("examples", "", "a directory of example programs, one directory per language, "+
+		"to show resources' usage from")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s docs [--check] [--examples <dir>] <out-dir> <schema-file>\n",
+			os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	diff, err := emitDocs(flags.Arg(0), flags.Arg(1), *examplesDir, *check)
+	fmt.Print(diff)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+// emitDocs renders the docs for the schema at schemaPath into outdir and deletes the pages
+// previous generations left there. With check, it leaves outdir alone and fails if it isn't up
+// to date, returning a diff of what generating it would change.
+func emitDocs(outdir, schemaPath, examplesDir string, check bool) (string, error) {
+	pkg, err := readSchema(schemaPath)
+	if err != nil {
+		return "", err
+	}
+	var examples []example
+	if examplesDir != "" {
+		if examples, err = readExamples(examplesDir); err != nil {
+			return "", errors.Wrap(err, "reading examples")
+		}
+	}
+
+	files := generateDocs(pkg, examples)
+
+	stale, err := staleFiles(outdir, files)
+	if err != nil {
+		return "", errors.Wrap(err, "finding stale files")
+	}
+
+	if check {
+		return checkSDK(outdir, files, stale)
+	}
+
+	for f, contents := range files {
+		if err := emitFile(outdir, f, contents); err != nil {
+			return "", errors.Wrapf(err, "emitting file %v", f)
+		}
+	}
+	for _, f := range stale {
+		if err := removeFile(outdir, f); err != nil {
+			return "", errors.Wrapf(err, "removing stale file %v", f)
+		}
+	}
+	return "", nil
+}
+
+// readExamples reads the source files of the example programs in dir.
+func readExamples(dir string) ([]example, error) {
+	var examples []example
+	for _, language := range exampleLanguages {
+		root := filepath.Join(dir, language.dir)
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if os.IsNotExist(err) && path == root {
+				return filepath.SkipDir
+			}
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if path != root && skipDirs[info.Name()] {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !contains(language.extensions, filepath.Ext(path)) {
+				return nil
+			}
+
+			contents, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			examples = append(examples, example{
+				language: language,
+				path:     filepath.ToSlash(rel),
+				contents: string(contents),
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return examples, nil
+}
+
+// generateDocs renders the docs for pkg, returning the pages by their paths.
+func generateDocs(pkg *schema.Package, examples []example) map[string][]byte {
+	resources := append([]*schema.Resource(nil), pkg.Resources...)
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Token < resources[j].Token })
+
+	var functions []*schema.Function
+	for _, f := range pkg.Functions {
+		if !f.IsMethod {
+			functions = append(functions, f)
+		}
+	}
+	sort.Slice(functions, func(i, j int) bool { return functions[i].Token < functions[j].Token })
+
+	var objects []*schema.ObjectType
+	var enums []*schema.EnumType
+	for _, t := range pkg.Types {
+		switch t := t.(type) {
+		case *schema.ObjectType:
+			// Object types are imported as a plain shape and an input shape; the plain shape
+			// documents both.
+			if t.IsPlainShape() {
+				objects = append(objects, t)
+			}
+		case *schema.EnumType:
+			enums = append(enums, t)
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Token < objects[j].Token })
+	sort.Slice(enums, func(i, j int) bool { return enums[i].Token < enums[j].Token })
+
+	files := map[string][]byte{
+		"README.md": []byte(indexPage(pkg, resources, functions, objects, enums)),
+	}
+	for _, r := range resources {
+		files[resourcePage(r)] = []byte(resourceDocs(r, examples))
+	}
+	if len(functions) > 0 {
+		files["functions.md"] = []byte(functionsDocs(functions))
+	}
+	if len(objects)+len(enums) > 0 {
+		files["types.md"] = []byte(typesDocs(objects, enums))
+	}
+	return files
+}
+
+func indexPage(pkg *schema.Package, resources []*schema.Resource, functions []*schema.Function,
+	objects []*schema.ObjectType, enums []*schema.EnumType) string {
+	var b strings.Builder
+	b.WriteString(docsHeader)
+	title := pkg.DisplayName
+	if title == "" {
+		title = pkg.Name
+	}
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	if pkg.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", pkg.Description)
+	}
+
+	if len(pkg.Config) > 0 {
+		fmt.Fprintf(&b, "## Configuration\n\n")
+		fmt.Fprintf(&b, "Set with `pulumi config set %s:<name> <value>`.\n\n", pkg.Name)
+		propertiesTable(&b, pkg.Config, false)
+	}
+
+	if len(resources) > 0 {
+		fmt.Fprintf(&b, "## Resources\n\n")
+		for _, r := range resources {
+			fmt.Fprintf(&b, "- [%s](%s)%s\n", tokenName(r.Token), resourcePage(r), summary(r.Comment))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(functions) > 0 {
+		fmt.Fprintf(&b, "## Functions\n\n")
+		for _, f := range functions {
+			fmt.Fprintf(&b, "- [%s](functions.md#%s)%s\n", tokenName(f.Token), anchor(tokenName(f.Token)),
+				summary(f.Comment))
+		}
+		b.WriteString("\n")
+	}
+
+	if len(objects)+len(enums) > 0 {
+		fmt.Fprintf(&b, "## Types\n\n")
+		for _, t := range objects {
+			fmt.Fprintf(&b, "- %s%s\n", typeLink(t.Token), summary(t.Comment))
+		}
+		for _, t := range enums {
+			fmt.Fprintf(&b, "- %s%s\n", typeLink(t.Token), summary(t.Comment))
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func resourceDocs(r *schema.Resource, examples []example) string {
+	var b strings.Builder
+	b.WriteString(docsHeader)
+	fmt.Fprintf(&b, "# %s\n\n", tokenName(r.Token))
+	fmt.Fprintf(&b, "Type token: `%s`\n\n", r.Token)
+	if r.DeprecationMessage != "" {
+		fmt.Fprintf(&b, "> **Deprecated:** %s\n\n", r.DeprecationMessage)
+	}
+	if r.Comment != "" {
+		fmt.Fprintf(&b, "%s\n\n", r.Comment)
+	}
+
+	var usages []example
+	for _, e := range examples {
+		if e.uses(r.Token) {
+			usages = append(usages, e)
+		}
+	}
+	if len(usages) > 0 {
+		fmt.Fprintf(&b, "## Example usage\n\n")
+		for _, e := range usages {
+			fmt.Fprintf(&b, "### %s\n\n", e.language.title)
+			fmt.Fprintf(&b, "From `examples/%s/%s`:\n\n", e.language.dir, e.path)
+			fmt.Fprintf(&b, "```%s\n%s\n```\n\n", e.language.fence, snippet(e.contents))
+		}
+	}
+
+	fmt.Fprintf(&b, "## Inputs\n\n")
+	propertiesTable(&b, r.InputProperties, true)
+
+	fmt.Fprintf(&b, "## Outputs\n\n")
+	propertiesTable(&b, r.Properties, false)
+
+	if len(r.Methods) > 0 {
+		fmt.Fprintf(&b, "## Methods\n\n")
+		methods := append([]*schema.Method(nil), r.Methods...)
+		sort.Slice(methods, func(i, j int) bool { return methods[i].Name < methods[j].Name })
+		for _, m := range methods {
+			fmt.Fprintf(&b, "### %s\n\n", m.Name)
+			functionDocs(&b, m.Function, "####")
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func functionsDocs(functions []*schema.Function) string {
+	var b strings.Builder
+	b.WriteString(docsHeader)
+	fmt.Fprintf(&b, "# Functions\n\n")
+	for _, f := range functions {
+		fmt.Fprintf(&b, "## %s\n\n", tokenName(f.Token))
+		fmt.Fprintf(&b, "Type token: `%s`\n\n", f.Token)
+		functionDocs(&b, f, "###")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// functionDocs writes a function's description, inputs and outputs, under headings of the
+// given level.
+func functionDocs(b *strings.Builder, f *schema.Function, heading string) {
+	if f.DeprecationMessage != "" {
+		fmt.Fprintf(b, "> **Deprecated:** %s\n\n", f.DeprecationMessage)
+	}
+	if f.Comment != "" {
+		fmt.Fprintf(b, "%s\n\n", f.Comment)
+	}
+
+	var inputs []*schema.Property
+	if f.Inputs != nil {
+		for _, p := range f.Inputs.Properties {
+			// Methods receive the resource they're called on as __self__.
+			if !(f.IsMethod && p.Name == "__self__") {
+				inputs = append(inputs, p)
+			}
+		}
+	}
+	if len(inputs) > 0 {
+		fmt.Fprintf(b, "%s Inputs\n\n", heading)
+		propertiesTable(b, inputs, true)
+	}
+	if f.Outputs != nil && len(f.Outputs.Properties) > 0 {
+		fmt.Fprintf(b, "%s Outputs\n\n", heading)
+		propertiesTable(b, f.Outputs.Properties, false)
+	}
+}
+
+func typesDocs(objects []*schema.ObjectType, enums []*schema.EnumType) string {
+	var b strings.Builder
+	b.WriteString(docsHeader)
+	fmt.Fprintf(&b, "# Types\n\n")
+
+	for _, t := range objects {
+		fmt.Fprintf(&b, "## %s\n\n", tokenName(t.Token))
+		if t.Comment != "" {
+			fmt.Fprintf(&b, "%s\n\n", t.Comment)
+		}
+		propertiesTable(&b, t.Properties, true)
+	}
+
+	for _, t := range enums {
+		fmt.Fprintf(&b, "## %s\n\n", tokenName(t.Token))
+		if t.Comment != "" {
+			fmt.Fprintf(&b, "%s\n\n", t.Comment)
+		}
+		fmt.Fprintf(&b, "An enum of %s values:\n\n", typeName(t.ElementType))
+		fmt.Fprintf(&b, "| Value | Description |\n")
+		fmt.Fprintf(&b, "| --- | --- |\n")
+		for _, e := range t.Elements {
+			description := e.Comment
+			if e.DeprecationMessage != "" {
+				description = strings.TrimSpace("**Deprecated:** " + e.DeprecationMessage + " " + description)
+			}
+			fmt.Fprintf(&b, "| `%v` | %s |\n", e.Value, cell(description))
+		}
+		b.WriteString("\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// propertiesTable writes a table of properties. Inputs also show whether they're required and
+// their defaults.
+func propertiesTable(b *strings.Builder, properties []*schema.Property, inputs bool) {
+	if len(properties) == 0 {
+		fmt.Fprintf(b, "None.\n\n")
+		return
+	}
+	properties = append([]*schema.Property(nil), properties...)
+	sort.Slice(properties, func(i, j int) bool { return properties[i].Name < properties[j].Name })
+
+	if inputs {
+		fmt.Fprintf(b, "| Name | Type | Required | Default | Description |\n")
+		fmt.Fprintf(b, "| --- | --- | --- | --- | --- |\n")
+	} else {
+		fmt.Fprintf(b, "| Name | Type | Description |\n")
+		fmt.Fprintf(b, "| --- | --- | --- |\n")
+	}
+	for _, p := range properties {
+		typ := typeName(p.Type)
+		if p.Secret {
+			typ += " (secret)"
+		}
+		description := p.Comment
+		if p.DeprecationMessage != "" {
+			description = strings.TrimSpace("**Deprecated:** " + p.DeprecationMessage + " " + description)
+		}
+		if inputs {
+			required := "No"
+			if p.IsRequired() {
+				required = "Yes"
+			}
+			fmt.Fprintf(b, "| `%s` | %s | %s | %s | %s |\n", p.Name, cell(typ), required,
+				cell(defaultValue(p.DefaultValue)), cell(description))
+		} else {
+			fmt.Fprintf(b, "| `%s` | %s | %s |\n", p.Name, cell(typ), cell(description))
+		}
+	}
+	b.WriteString("\n")
+}
+
+// typeName renders a type, linking to the docs of the package's types and resources.
+func typeName(t schema.Type) string {
+	switch t := t.(type) {
+	case *schema.OptionalType:
+		return typeName(t.ElementType)
+	case *schema.InputType:
+		return typeName(t.ElementType)
+	case *schema.ArrayType:
+		return typeName(t.ElementType) + "[]"
+	case *schema.MapType:
+		return "map[string]" + typeName(t.ElementType)
+	case *schema.ObjectType:
+		return typeLink(t.Token)
+	case *schema.EnumType:
+		return typeLink(t.Token)
+	case *schema.ResourceType:
+		if t.Resource != nil {
+			return fmt.Sprintf("[%s](%s)", tokenName(t.Token), resourcePage(t.Resource))
+		}
+		return t.Token
+	case *schema.UnionType:
+		names := make([]string, len(t.ElementTypes))
+		for i, e := range t.ElementTypes {
+			names[i] = typeName(e)
+		}
+		return strings.Join(names, " or ")
+	default:
+		return t.String()
+	}
+}
+
+func defaultValue(d *schema.DefaultValue) string {
+	if d == nil {
+		return ""
+	}
+	var values []string
+	if d.Value != nil {
+		values = append(values, fmt.Sprintf("`%v`", d.Value))
+	}
+	for _, v := range d.Environment {
+		values = append(values, fmt.Sprintf("`$%s`", v))
+	}
+	return strings.Join(values, " or ")
+}
+
+func typeLink(token string) string {
+	return fmt.Sprintf("[%s](types.md#%s)", tokenName(token), anchor(tokenName(token)))
+}
+
+func resourcePage(r *schema.Resource) string {
+	return strings.ToLower(tokenName(r.Token)) + ".md"
+}
+
+// tokenName returns the name part of a type token, such as Deployment for
+// productionapp:index:Deployment.
+func tokenName(token string) string {
+	return token[strings.LastIndex(token, ":")+1:]
+}
+
+// anchor returns the anchor Markdown renderers give a heading.
+func anchor(heading string) string {
+	return strings.ToLower(strings.ReplaceAll(heading, " ", "-"))
+}
+
+// summary returns the first sentence of a description, to follow a link in a list.
+func summary(comment string) string {
+	comment = strings.TrimSpace(strings.SplitN(comment, "\n\n", 2)[0])
+	if comment == "" {
+		return ""
+	}
+	if i := strings.Index(comment, ". "); i >= 0 {
+		comment = comment[:i+1]
+	}
+	return ": " + strings.ReplaceAll(comment, "\n", " ")
+}
+
+// snippet tidies an example's source for a code block, trimming blank lines around it and
+// trailing whitespace.
+func snippet(contents string) string {
+	lines := strings.Split(contents, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+// cell escapes text for a table cell.
+func cell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(strings.TrimSpace(text), "\n", "<br>")
+}
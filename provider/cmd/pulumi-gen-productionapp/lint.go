@@ -0,0 +1,352 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+)
+
+// The schema is linted as written, before it is imported, so that every problem in it is
+// reported rather than the first one importing it fails on. Problems are located by the JSON
+// pointer of the part of the schema they concern, such as
+// #/resources/productionapp:index:Deployment/inputProperties/image.
+
+const (
+	typesPointer     = "#/types/"
+	resourcesPointer = "#/resources/"
+)
+
+// lintCommand checks the schema for problems.
+func lintCommand(args []string) {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s lint <schema-file>\n", os.Args[0])
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	problems, err := lintSchema(flags.Arg(0))
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if err == nil && len(problems) > 0 {
+		err = errors.Errorf("%d problems in %s", len(problems), flags.Arg(0))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func readSpec(schemaPath string) (schema.PackageSpec, error) {
+	var spec schema.PackageSpec
+	schemaBytes, err := ioutil.ReadFile(schemaPath)
+	if err != nil {
+		return spec, errors.Wrap(err, "reading schema")
+	}
+	if err = json.Unmarshal(schemaBytes, &spec); err != nil {
+		return spec, errors.Wrap(err, "unmarshalling schema")
+	}
+	return spec, nil
+}
+
+// lintSchema returns the problems in the schema at schemaPath: missing descriptions, references
+// to types, resources and functions it doesn't define, required properties it doesn't define,
+// types nothing uses, and anything else that stops it from being imported.
+func lintSchema(schemaPath string) ([]string, error) {
+	spec, err := readSpec(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{spec: spec, reported: map[string]bool{}}
+	for _, name := range sortedKeys(spec.Config.Variables) {
+		l.property("#/config/variables/"+name, spec.Config.Variables[name])
+	}
+	l.required("#/config/defaults", spec.Config.Required, spec.Config.Variables)
+
+	l.resource("#/provider", spec.Provider, false)
+	for _, tok := range sortedKeys(spec.Resources) {
+		l.resource(resourcesPointer+tok, spec.Resources[tok], true)
+	}
+
+	for _, tok := range sortedKeys(spec.Functions) {
+		f := spec.Functions[tok]
+		pointer := "#/functions/" + tok
+		l.description(pointer, f.Description)
+		if f.Inputs != nil {
+			l.object(pointer+"/inputs", *f.Inputs, false)
+		}
+		if f.Outputs != nil {
+			l.object(pointer+"/outputs", *f.Outputs, false)
+		}
+	}
+
+	used := usedTypes(spec)
+	for _, tok := range sortedKeys(spec.Types) {
+		t := spec.Types[tok]
+		pointer := typesPointer + tok
+		if !used[tok] {
+			l.report(pointer, "type is not used by any resource, function or other type")
+		}
+		if len(t.Enum) > 0 {
+			l.description(pointer, t.Description)
+			for i, e := range t.Enum {
+				l.description(fmt.Sprintf("%s/enum/%d", pointer, i), e.Description)
+			}
+			continue
+		}
+		l.object(pointer, t.ObjectTypeSpec, true)
+	}
+
+	// Importing finds problems the linter doesn't look for, and some it has already reported.
+	if _, err := schema.ImportSpec(spec, nil); err != nil {
+		diags, ok := err.(hcl.Diagnostics)
+		if !ok {
+			return nil, errors.Wrap(err, "importing schema")
+		}
+		for _, diag := range diags {
+			pointer, message := "#", diag.Summary
+			if i := strings.Index(diag.Summary, ": "); i >= 0 && strings.HasPrefix(diag.Summary, "#") {
+				pointer, message = diag.Summary[:i], diag.Summary[i+2:]
+			}
+			if !l.reported[pointer] {
+				l.report(pointer, "%s", message)
+			}
+		}
+	}
+	return l.problems, nil
+}
+
+// linter collects the problems found in a schema.
+type linter struct {
+	spec     schema.PackageSpec
+	problems []string
+	// reported are the pointers problems have been reported at.
+	reported map[string]bool
+}
+
+func (l *linter) report(pointer, format string, args ...interface{}) {
+	l.reported[pointer] = true
+	l.problems = append(l.problems, fmt.Sprintf("%s: %s", pointer, fmt.Sprintf(format, args...)))
+}
+
+func (l *linter) description(pointer, description string) {
+	if strings.TrimSpace(description) == "" {
+		l.report(pointer, "missing description")
+	}
+}
+
+func (l *linter) resource(pointer string, r schema.ResourceSpec, described bool) {
+	if described {
+		l.description(pointer, r.Description)
+	}
+	for _, name := range sortedKeys(r.InputProperties) {
+		l.property(pointer+"/inputProperties/"+name, r.InputProperties[name])
+	}
+	l.required(pointer+"/requiredInputs", r.RequiredInputs, r.InputProperties)
+	l.object(pointer, r.ObjectTypeSpec, false)
+
+	for _, name := range sortedKeys(r.Methods) {
+		if _, ok := l.spec.Functions[r.Methods[name]]; !ok {
+			l.report(pointer+"/methods/"+name, "function %s is not defined", r.Methods[name])
+		}
+	}
+}
+
+// object lints an object's properties, and its description if described.
+func (l *linter) object(pointer string, o schema.ObjectTypeSpec, described bool) {
+	if described {
+		l.description(pointer, o.Description)
+	}
+	for _, name := range sortedKeys(o.Properties) {
+		// Methods receive the resource they're called on as __self__.
+		if name == "__self__" {
+			continue
+		}
+		l.property(pointer+"/properties/"+name, o.Properties[name])
+	}
+	l.required(pointer+"/required", o.Required, o.Properties)
+}
+
+func (l *linter) property(pointer string, p schema.PropertySpec) {
+	l.description(pointer, p.Description)
+	l.typeSpec(pointer, p.TypeSpec)
+}
+
+func (l *linter) typeSpec(pointer string, t schema.TypeSpec) {
+	switch {
+	case t.Ref != "":
+		l.ref(pointer, t.Ref)
+	case t.Type == "array":
+		if t.Items == nil {
+			l.report(pointer, "array has no items")
+			return
+		}
+		l.typeSpec(pointer+"/items", *t.Items)
+	case t.Type == "object":
+		if t.AdditionalProperties != nil {
+			l.typeSpec(pointer+"/additionalProperties", *t.AdditionalProperties)
+		}
+	case len(t.OneOf) > 0:
+		for i, element := range t.OneOf {
+			l.typeSpec(fmt.Sprintf("%s/oneOf/%d", pointer, i), element)
+		}
+	case t.Type == "":
+		l.report(pointer, "missing type")
+	}
+}
+
+// ref lints a reference. References to other packages' schemas can't be checked offline.
+func (l *linter) ref(pointer, ref string) {
+	switch {
+	case strings.HasPrefix(ref, typesPointer):
+		if _, ok := l.spec.Types[strings.TrimPrefix(ref, typesPointer)]; !ok {
+			l.report(pointer, "reference to undefined type %s", ref)
+		}
+	case strings.HasPrefix(ref, resourcesPointer):
+		if _, ok := l.spec.Resources[strings.TrimPrefix(ref, resourcesPointer)]; !ok {
+			l.report(pointer, "reference to undefined resource %s", ref)
+		}
+	case strings.HasPrefix(ref, "#"):
+		l.report(pointer, "invalid reference %s", ref)
+	}
+}
+
+// required lints a list of required properties, which must all be defined.
+func (l *linter) required(pointer string, required []string, properties map[string]schema.PropertySpec) {
+	for i, name := range required {
+		if _, ok := properties[name]; !ok {
+			l.report(fmt.Sprintf("%s/%d", pointer, i), "required property %q is not defined", name)
+		}
+	}
+}
+
+// usedTypes returns the types the schema's resources, functions and configuration use, directly
+// or through other types.
+func usedTypes(spec schema.PackageSpec) map[string]bool {
+	inputs, outputs := typeUsage(spec)
+	used := map[string]bool{}
+	for tok := range inputs {
+		used[tok] = true
+	}
+	for tok := range outputs {
+		used[tok] = true
+	}
+	return used
+}
+
+// typeUsage returns the types used as inputs, by resources' inputs, functions' inputs and the
+// configuration, and the types used as outputs, by resources' outputs and functions' outputs.
+// A type can be both.
+func typeUsage(spec schema.PackageSpec) (inputs, outputs map[string]bool) {
+	var inputRoots, outputRoots []schema.PropertySpec
+	for _, p := range spec.Config.Variables {
+		inputRoots = append(inputRoots, p)
+	}
+	for _, r := range append([]schema.ResourceSpec{spec.Provider}, resourceSpecs(spec)...) {
+		for _, p := range r.InputProperties {
+			inputRoots = append(inputRoots, p)
+		}
+		for _, p := range r.Properties {
+			outputRoots = append(outputRoots, p)
+		}
+	}
+	for _, f := range spec.Functions {
+		if f.Inputs != nil {
+			for _, p := range f.Inputs.Properties {
+				inputRoots = append(inputRoots, p)
+			}
+		}
+		if f.Outputs != nil {
+			for _, p := range f.Outputs.Properties {
+				outputRoots = append(outputRoots, p)
+			}
+		}
+	}
+	return reachableTypes(spec, inputRoots), reachableTypes(spec, outputRoots)
+}
+
+// reachableTypes returns the types the properties refer to, directly or through other types.
+func reachableTypes(spec schema.PackageSpec, properties []schema.PropertySpec) map[string]bool {
+	reached := map[string]bool{}
+	var visit func(t schema.TypeSpec)
+	visit = func(t schema.TypeSpec) {
+		forEachRef(t, func(ref string) {
+			if !strings.HasPrefix(ref, typesPointer) {
+				return
+			}
+			tok := strings.TrimPrefix(ref, typesPointer)
+			if reached[tok] {
+				return
+			}
+			reached[tok] = true
+			for _, p := range spec.Types[tok].Properties {
+				visit(p.TypeSpec)
+			}
+		})
+	}
+	for _, p := range properties {
+		visit(p.TypeSpec)
+	}
+	return reached
+}
+
+// forEachRef calls f with each reference in a type.
+func forEachRef(t schema.TypeSpec, f func(ref string)) {
+	if t.Ref != "" {
+		f(t.Ref)
+	}
+	if t.Items != nil {
+		forEachRef(*t.Items, f)
+	}
+	if t.AdditionalProperties != nil {
+		forEachRef(*t.AdditionalProperties, f)
+	}
+	for _, element := range t.OneOf {
+		forEachRef(element, f)
+	}
+}
+
+func resourceSpecs(spec schema.PackageSpec) []schema.ResourceSpec {
+	resources := make([]schema.ResourceSpec, 0, len(spec.Resources))
+	for _, tok := range sortedKeys(spec.Resources) {
+		resources = append(resources, spec.Resources[tok])
+	}
+	return resources
+}
+
+// sortedKeys returns a map's keys in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
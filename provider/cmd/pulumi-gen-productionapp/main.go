@@ -15,13 +15,18 @@
 package main
 
 import (
-	"encoding/json"
+	"bytes"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
 	dotnetgen "github.com/pulumi/pulumi/pkg/v3/codegen/dotnet"
 	gogen "github.com/pulumi/pulumi/pkg/v3/codegen/go"
 	nodejsgen "github.com/pulumi/pulumi/pkg/v3/codegen/nodejs"
@@ -30,27 +35,156 @@ import (
 	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
 )
 
+// tool is the name the generated files are marked with.
+const tool = "Pulumi SDK Generator"
+
+// languages are the languages SDKs can be generated in, in the order `all` generates them.
+var languages = []string{"dotnet", "go", "java", "nodejs", "python"}
+
+// skipDirs are directories in an SDK that hold build outputs and dependencies rather than
+// generated files.
+var skipDirs = map[string]bool{"bin": true, "build": true, "node_modules": true, "obj": true, "target": true}
+
 func main() {
-	if len(os.Args) < 4 {
-		fmt.Printf("Usage: %s <language> <out-dir> <schema-file>\n", os.Args[0])
-		os.Exit(1)
+	if len(os.Args) >= 2 && os.Args[1] == "schema" {
+		schemaCommand(os.Args[2:])
+		return
+	}
+	if len(os.Args) >= 2 && os.Args[1] == "docs" {
+		docsCommand(os.Args[2:])
+		return
+	}
+	if len(os.Args) >= 2 && os.Args[1] == "yaml" {
+		yamlCommand(os.Args[2:])
+		return
+	}
+	if len(os.Args) >= 2 && os.Args[1] == "lint" {
+		lintCommand(os.Args[2:])
+		return
+	}
+	if len(os.Args) >= 2 && os.Args[1] == "compat" {
+		compatCommand(os.Args[2:])
+		return
 	}
 
-	language, outdir, schemaPath := os.Args[1], os.Args[2], os.Args[3]
+	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	check := flags.Bool("check", false, "fail and print a diff if the SDKs on disk are out of date instead of writing them")
+	flags.Usage = func() {
+		out := flags.Output()
+		fmt.Fprintf(out, "Usage: %s [--check] <language>[,<language>...] <out-dir> <schema-file>\n", os.Args[0])
+		fmt.Fprintf(out, "       %s schema [--check] <schema-file>\n", os.Args[0])
+		fmt.Fprintf(out, "       %s docs [--check] [--examples <dir>] <out-dir> <schema-file>\n", os.Args[0])
+		fmt.Fprintf(out, "       %s yaml <program> <schema-file>\n", os.Args[0])
+		fmt.Fprintf(out, "       %s lint <schema-file>\n", os.Args[0])
+		fmt.Fprintf(out, "       %s compat [--bump] [--allow-breaking] <old-schema-file> <new-schema-file>\n\n", os.Args[0])
+		fmt.Fprintf(out, "<language> is one of %s, or all. With several languages, each SDK is generated\n",
+			strings.Join(languages, ", "))
+		fmt.Fprintf(out, "in <out-dir>/<language>.\n\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(os.Args[1:])
+	if flags.NArg() != 3 {
+		flags.Usage()
+		os.Exit(1)
+	}
 
-	err := emitSDK(language, outdir, schemaPath)
+	selected, err := parseLanguages(flags.Arg(0))
 	if err != nil {
-		fmt.Printf("Failed: %s", err.Error())
+		fmt.Fprintf(os.Stderr, "Failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+	outdir, schemaPath := flags.Arg(1), flags.Arg(2)
+
+	// Each language is generated from its own copy of the schema, since generators record their
+	// language's settings on it.
+	diffs := make([]string, len(selected))
+	errs := make([]error, len(selected))
+	var wg sync.WaitGroup
+	for i, language := range selected {
+		dir := outdir
+		if len(selected) > 1 {
+			dir = filepath.Join(outdir, language)
+		}
+		wg.Add(1)
+		go func(i int, language, dir string) {
+			defer wg.Done()
+			diffs[i], errs[i] = emitSDK(language, dir, schemaPath, *check)
+		}(i, language, dir)
+	}
+	wg.Wait()
+
+	failed := false
+	for i, err := range errs {
+		fmt.Print(diffs[i])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed: %s: %s\n", selected[i], err.Error())
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
 	}
 }
 
-func emitSDK(language, outdir, schemaPath string) error {
+// parseLanguages parses a comma-separated list of languages, or all.
+func parseLanguages(arg string) ([]string, error) {
+	if arg == "all" {
+		return languages, nil
+	}
+	var selected []string
+	seen := map[string]bool{}
+	for _, language := range strings.Split(arg, ",") {
+		if !contains(languages, language) {
+			return nil, errors.Errorf("Unrecognized language %q, must be one of %s or all", language,
+				strings.Join(languages, ", "))
+		}
+		if !seen[language] {
+			seen[language] = true
+			selected = append(selected, language)
+		}
+	}
+	return selected, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaCommand generates the schema from the provider's Go structs, or checks that it is up to
+// date.
+func schemaCommand(args []string) {
+	flags := flag.NewFlagSet("schema", flag.ExitOnError)
+	check := flags.Bool("check", false, "fail if the schema is out of date instead of writing it")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s schema [--check] <schema-file>\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	if err := emitSchema(flags.Arg(0), *check); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+// emitSDK generates the SDK for language into outdir and deletes the files previous
+// generations left there. With check, it leaves outdir alone and fails if it isn't up to date,
+// returning a diff of what generating it would change.
+func emitSDK(language, outdir, schemaPath string, check bool) (string, error) {
 	pkg, err := readSchema(schemaPath)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	tool := "Pulumi SDK Generator"
 	extraFiles := map[string][]byte{}
 
 	var generator func() (map[string][]byte, error)
@@ -66,32 +200,178 @@ func emitSDK(language, outdir, schemaPath string) error {
 	case "java":
 		generator = func() (map[string][]byte, error) { return javagen.GeneratePackage(tool, pkg, extraFiles) }
 	default:
-		return errors.Errorf("Unrecognized language %q", language)
+		return "", errors.Errorf("Unrecognized language %q", language)
 	}
 
 	files, err := generator()
 	if err != nil {
-		return errors.Wrapf(err, "generating %s package", language)
+		return "", errors.Wrapf(err, "generating %s package", language)
+	}
+
+	stale, err := staleFiles(outdir, files)
+	if err != nil {
+		return "", errors.Wrap(err, "finding stale files")
+	}
+
+	if check {
+		return checkSDK(outdir, files, stale)
 	}
 
 	for f, contents := range files {
 		if err := emitFile(outdir, f, contents); err != nil {
-			return errors.Wrapf(err, "emitting file %v", f)
+			return "", errors.Wrapf(err, "emitting file %v", f)
+		}
+	}
+	for _, f := range stale {
+		if err := removeFile(outdir, f); err != nil {
+			return "", errors.Wrapf(err, "removing stale file %v", f)
 		}
 	}
 
-	return nil
+	return "", nil
 }
 
-func readSchema(schemaPath string) (*schema.Package, error) {
-	schemaBytes, err := ioutil.ReadFile(schemaPath)
+// staleFiles returns the generated files in outdir, relative to it, that aren't among files.
+// Files are recognised as generated by the warning the generators mark them with, so files
+// added by hand or by builds are kept.
+func staleFiles(outdir string, files map[string][]byte) ([]string, error) {
+	var stale []string
+	err := filepath.Walk(outdir, func(path string, info os.FileInfo, err error) error {
+		if os.IsNotExist(err) && path == outdir {
+			return filepath.SkipDir
+		}
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != outdir && skipDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, err := filepath.Rel(outdir, path)
+		if err != nil {
+			return err
+		}
+		if _, ok := files[filepath.ToSlash(rel)]; ok {
+			return nil
+		}
+		generated, err := isGenerated(path)
+		if err != nil {
+			return err
+		}
+		if generated {
+			stale = append(stale, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	sort.Strings(stale)
+	return stale, err
+}
+
+// isGenerated reports whether the file at path is marked as generated in its first lines.
+func isGenerated(path string) (bool, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		return nil, errors.Wrap(err, "reading schema")
+		return false, err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, err := f.Read(head)
+	if err != nil && n == 0 {
+		return false, nil
 	}
+	return bytes.Contains(head[:n], []byte("generated by "+tool)), nil
+}
 
-	var spec schema.PackageSpec
-	if err = json.Unmarshal(schemaBytes, &spec); err != nil {
-		return nil, errors.Wrap(err, "unmarshalling schema")
+// checkSDK compares the generated files to the ones in outdir and fails if they differ,
+// returning a diff of each difference. Paths in the diff are relative to outdir.
+func checkSDK(outdir string, files map[string][]byte, stale []string) (string, error) {
+	names := make([]string, 0, len(files))
+	for f := range files {
+		names = append(names, f)
+	}
+	sort.Strings(names)
+
+	var out strings.Builder
+	changed := 0
+	for _, f := range names {
+		existing, err := ioutil.ReadFile(filepath.Join(outdir, f))
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+		if err == nil && bytes.Equal(existing, files[f]) {
+			continue
+		}
+		changed++
+		if err := writeDiff(&out, f, existing, files[f], err == nil); err != nil {
+			return "", err
+		}
+	}
+	for _, f := range stale {
+		changed++
+		existing, err := ioutil.ReadFile(filepath.Join(outdir, f))
+		if err != nil {
+			return "", err
+		}
+		if err := writeDiff(&out, f, existing, nil, true); err != nil {
+			return "", err
+		}
+	}
+
+	if changed > 0 {
+		return out.String(), errors.Errorf("%d files in %s are out of date", changed, outdir)
+	}
+	return "", nil
+}
+
+// writeDiff writes a unified diff of the file f from before to after. A missing file is
+// /dev/null on its side of the diff.
+func writeDiff(out *strings.Builder, f string, before, after []byte, exists bool) error {
+	from, to := "a/"+f, "b/"+f
+	if !exists {
+		from = "/dev/null"
+	}
+	if after == nil {
+		to = "/dev/null"
+	}
+	if !isText(before) || !isText(after) {
+		fmt.Fprintf(out, "Binary files %s and %s differ\n", from, to)
+		return nil
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: from,
+		ToFile:   to,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	out.WriteString(diff)
+	return nil
+}
+
+// splitLines splits contents into lines, keeping their line endings.
+func splitLines(contents []byte) []string {
+	lines := strings.SplitAfter(string(contents), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func isText(contents []byte) bool {
+	return !bytes.ContainsRune(contents, 0)
+}
+
+func readSchema(schemaPath string) (*schema.Package, error) {
+	spec, err := readSpec(schemaPath)
+	if err != nil {
+		return nil, err
 	}
 
 	pkg, err := schema.ImportSpec(spec, nil)
@@ -106,8 +386,26 @@ func emitFile(rootDir, filename string, contents []byte) error {
 	if err := os.MkdirAll(filepath.Dir(outPath), 0755); err != nil {
 		return err
 	}
-	if err := ioutil.WriteFile(outPath, contents, 0600); err != nil {
+	if err := ioutil.WriteFile(outPath, contents, 0644); err != nil {
+		return err
+	}
+	return nil
+}
+
+// removeFile removes filename from rootDir, and the directories containing it that are left
+// empty.
+func removeFile(rootDir, filename string) error {
+	if err := os.Remove(filepath.Join(rootDir, filename)); err != nil {
 		return err
 	}
+	for dir := filepath.Dir(filename); dir != "."; dir = filepath.Dir(dir) {
+		entries, err := ioutil.ReadDir(filepath.Join(rootDir, dir))
+		if err != nil || len(entries) > 0 {
+			return err
+		}
+		if err := os.Remove(filepath.Join(rootDir, dir)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
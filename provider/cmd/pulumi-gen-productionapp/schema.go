@@ -0,0 +1,542 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+
+	"github.com/jaxxstorm/pulumi-productionapp/pkg/provider"
+)
+
+// The schema is generated from the provider's Go structs: the components' args and resource
+// structs, the provider's Config and the functions' inputs, and the object types their fields
+// refer to. Property descriptions are the fields' doc comments and type descriptions the
+// types' doc comments. A field's `schema:` tag marks it `required` or `secret`, sets its
+// `default` or refers it to a type defined in the schema itself with `ref`, such as an enum.
+//
+// The parts of the schema that don't come from Go structs, such as the package's name, enums,
+// functions and language settings, are kept from the existing schema.
+
+const typePrefix = "productionapp:index:"
+
+// emitSchema regenerates the schema at schemaPath. With check, it leaves the schema alone and
+// fails if it isn't up to date.
+func emitSchema(schemaPath string, check bool) error {
+	existing, err := ioutil.ReadFile(schemaPath)
+	if err != nil {
+		return errors.Wrap(err, "reading schema")
+	}
+	var base schema.PackageSpec
+	if err := json.Unmarshal(existing, &base); err != nil {
+		return errors.Wrap(err, "unmarshalling schema")
+	}
+
+	spec, err := generateSchema(base)
+	if err != nil {
+		return err
+	}
+	contents, err := marshalSchema(spec)
+	if err != nil {
+		return err
+	}
+	if _, err := schema.ImportSpec(spec, nil); err != nil {
+		return errors.Wrap(err, "importing generated schema")
+	}
+
+	if check {
+		if !bytes.Equal(existing, contents) {
+			return errors.Errorf("%s is out of date with the provider's Go structs, regenerate it with "+
+				"`pulumi-gen-productionapp schema %s`", schemaPath, schemaPath)
+		}
+		return nil
+	}
+	return ioutil.WriteFile(schemaPath, contents, 0644)
+}
+
+func marshalSchema(spec schema.PackageSpec) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "    ")
+	if err := encoder.Encode(spec); err != nil {
+		return nil, errors.Wrap(err, "marshalling schema")
+	}
+	return buf.Bytes(), nil
+}
+
+// generateSchema returns base with the parts that come from Go structs regenerated.
+func generateSchema(base schema.PackageSpec) (schema.PackageSpec, error) {
+	docs, err := readDocs(reflect.TypeOf(provider.Config{}).PkgPath())
+	if err != nil {
+		return schema.PackageSpec{}, err
+	}
+	g := &generator{base: base, docs: docs, types: map[string]schema.ComplexTypeSpec{}, modes: map[string]bool{}}
+
+	spec := base
+	config, err := g.properties(reflect.TypeOf(provider.Config{}), true)
+	if err != nil {
+		return spec, errors.Wrap(err, "config")
+	}
+	config.stripPlain()
+	spec.Config = schema.ConfigSpec{Variables: config.properties, Required: config.required}
+	spec.Provider.InputProperties = config.properties
+	spec.Provider.RequiredInputs = config.required
+
+	spec.Resources = map[string]schema.ResourceSpec{}
+	for _, component := range provider.Components {
+		resource, err := g.resource(component)
+		if err != nil {
+			return spec, errors.Wrap(err, component.Token)
+		}
+		spec.Resources[component.Token] = resource
+	}
+
+	spec.Functions = map[string]schema.FunctionSpec{}
+	for tok, function := range base.Functions {
+		spec.Functions[tok] = function
+	}
+	for _, function := range provider.Functions {
+		f, ok := spec.Functions[function.Token]
+		if !ok {
+			return spec, errors.Errorf("%s: the function must be defined in the schema", function.Token)
+		}
+		// Functions are invoked with plain values, and the generated SDKs expect their inputs
+		// not to be marked plain.
+		inputs, err := g.properties(reflect.TypeOf(function.Inputs), true)
+		if err != nil {
+			return spec, errors.Wrap(err, function.Token)
+		}
+		inputs.stripPlain()
+		for name, p := range inputs.properties {
+			p.Default = nil
+			inputs.properties[name] = p
+		}
+		f.Inputs = &schema.ObjectTypeSpec{Properties: inputs.properties, Required: inputs.required}
+		spec.Functions[function.Token] = f
+	}
+
+	// Keep the types defined in the schema itself, which the generated types and functions
+	// refer to.
+	spec.Types = g.types
+	for tok, t := range base.Types {
+		if _, ok := g.types[tok]; !ok && (len(t.Enum) > 0 || referenced(spec, tok)) {
+			spec.Types[tok] = t
+		}
+	}
+	return spec, nil
+}
+
+// generator generates the parts of the schema that come from Go structs.
+type generator struct {
+	base  schema.PackageSpec
+	docs  docs
+	types map[string]schema.ComplexTypeSpec
+	// modes records whether each generated type is used as a plain value.
+	modes map[string]bool
+}
+
+func (g *generator) resource(component provider.Component) (schema.ResourceSpec, error) {
+	inputs, err := g.properties(reflect.TypeOf(component.Args), true)
+	if err != nil {
+		return schema.ResourceSpec{}, errors.Wrap(err, "inputs")
+	}
+	resourceType := reflect.TypeOf(component.Resource)
+	outputs, err := g.properties(resourceType, false)
+	if err != nil {
+		return schema.ResourceSpec{}, errors.Wrap(err, "outputs")
+	}
+
+	resource := g.base.Resources[component.Token]
+	resource.IsComponent = true
+	resource.Description = g.docs.typeDoc(resourceType)
+	resource.InputProperties = inputs.properties
+	resource.RequiredInputs = inputs.required
+	resource.Properties = outputs.properties
+	resource.Required = outputs.required
+	return resource, nil
+}
+
+// objectProperties are the properties of an object, and the ones it requires.
+type objectProperties struct {
+	properties map[string]schema.PropertySpec
+	required   []string
+}
+
+func (o objectProperties) stripPlain() {
+	for name, p := range o.properties {
+		stripPlain(&p.TypeSpec)
+		o.properties[name] = p
+	}
+}
+
+func stripPlain(t *schema.TypeSpec) {
+	t.Plain = false
+	if t.Items != nil {
+		stripPlain(t.Items)
+	}
+	if t.AdditionalProperties != nil {
+		stripPlain(t.AdditionalProperties)
+	}
+}
+
+// properties returns the properties of struct type t, one for each field with a `pulumi:`
+// tag. Fields that aren't Inputs or Outputs are plain if plain is set. The fields of embedded
+// structs are the struct's own.
+func (g *generator) properties(t reflect.Type, plain bool) (objectProperties, error) {
+	result := objectProperties{properties: map[string]schema.PropertySpec{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("pulumi")
+		if name == "" {
+			// Embedded structs from other packages, such as pulumi.ResourceState, aren't part
+			// of the schema.
+			if field.Anonymous && field.Type.Kind() == reflect.Struct && field.Type.PkgPath() == t.PkgPath() {
+				embedded, err := g.properties(field.Type, plain)
+				if err != nil {
+					return result, err
+				}
+				// The embedding struct decides what it requires, such as a function that
+				// checks a component's inputs and requires none of them.
+				for k, v := range embedded.properties {
+					result.properties[k] = v
+				}
+			}
+			continue
+		}
+
+		tag, err := parseSchemaTag(field.Tag.Get("schema"))
+		if err != nil {
+			return result, errors.Wrapf(err, "%s.%s", t.Name(), field.Name)
+		}
+		var typeSpec schema.TypeSpec
+		if tag.ref != "" {
+			typeSpec, err = g.refType(field.Type, tag.ref, plain)
+		} else {
+			typeSpec, err = g.typeSpec(field.Type, plain)
+		}
+		if err != nil {
+			return result, errors.Wrapf(err, "%s.%s", t.Name(), field.Name)
+		}
+		// Property descriptions don't end with a period, unlike type descriptions.
+		description := strings.TrimSuffix(g.docs.fieldDoc(t, field.Name), ".")
+		if description == "" {
+			return result, errors.Errorf("%s.%s: fields in the schema must have a doc comment", t.Name(), field.Name)
+		}
+
+		property := schema.PropertySpec{TypeSpec: typeSpec, Description: description, Secret: tag.secret}
+		if tag.defaultValue != nil {
+			if property.Default, err = parseDefault(typeSpec, *tag.defaultValue); err != nil {
+				return result, errors.Wrapf(err, "%s.%s", t.Name(), field.Name)
+			}
+		}
+		result.properties[name] = property
+		if tag.required {
+			result.required = append(result.required, name)
+		}
+	}
+	return result, nil
+}
+
+var (
+	inputType  = reflect.TypeOf((*interface{ ElementType() reflect.Type })(nil)).Elem()
+	anyType    = reflect.TypeOf((*interface{})(nil)).Elem()
+	anyTypeRef = "pulumi.json#/Any"
+)
+
+// typeSpec returns the schema type of values of Go type t. Inputs and Outputs are never plain,
+// and neither are their elements.
+func (g *generator) typeSpec(t reflect.Type, plain bool) (schema.TypeSpec, error) {
+	if elem, ok := elementType(t); ok {
+		return g.typeSpec(elem, false)
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.typeSpec(t.Elem(), plain)
+	case reflect.String:
+		return schema.TypeSpec{Type: "string", Plain: plain}, nil
+	case reflect.Int:
+		return schema.TypeSpec{Type: "integer", Plain: plain}, nil
+	case reflect.Float64:
+		return schema.TypeSpec{Type: "number", Plain: plain}, nil
+	case reflect.Bool:
+		return schema.TypeSpec{Type: "boolean", Plain: plain}, nil
+	case reflect.Slice:
+		items, err := g.typeSpec(t.Elem(), plain)
+		if err != nil {
+			return schema.TypeSpec{}, err
+		}
+		return schema.TypeSpec{Type: "array", Items: &items, Plain: plain}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return schema.TypeSpec{}, errors.Errorf("map keys must be strings, not %s", t.Key())
+		}
+		values, err := g.typeSpec(t.Elem(), plain)
+		if err != nil {
+			return schema.TypeSpec{}, err
+		}
+		return schema.TypeSpec{Type: "object", AdditionalProperties: &values, Plain: plain}, nil
+	case reflect.Interface:
+		if t == anyType {
+			return schema.TypeSpec{Ref: anyTypeRef, Plain: plain}, nil
+		}
+	case reflect.Struct:
+		tok, err := g.objectType(t, plain)
+		if err != nil {
+			return schema.TypeSpec{}, err
+		}
+		return schema.TypeSpec{Ref: "#/types/" + tok, Plain: plain}, nil
+	}
+	return schema.TypeSpec{}, errors.Errorf("unsupported type %s", t)
+}
+
+// refType returns the schema type of a field of Go type t that refers to ref, a type defined
+// in the schema itself or another package's type, keeping any arrays or maps around it.
+func (g *generator) refType(t reflect.Type, ref string, plain bool) (schema.TypeSpec, error) {
+	if elem, ok := elementType(t); ok {
+		return g.refType(elem, ref, false)
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.refType(t.Elem(), ref, plain)
+	case reflect.Slice:
+		items, err := g.refType(t.Elem(), ref, plain)
+		return schema.TypeSpec{Type: "array", Items: &items, Plain: plain}, err
+	}
+
+	if !strings.Contains(ref, "#") {
+		if _, ok := g.base.Types[typePrefix+ref]; !ok {
+			return schema.TypeSpec{}, errors.Errorf("unknown type %s, it must be defined in the schema", ref)
+		}
+		ref = "#/types/" + typePrefix + ref
+	}
+	return schema.TypeSpec{Ref: ref, Plain: plain}, nil
+}
+
+// objectType generates the object type for struct type t and returns its token. Its fields are
+// plain, unless they are Inputs, if the type is used as a plain value.
+func (g *generator) objectType(t reflect.Type, plain bool) (string, error) {
+	tok := typePrefix + t.Name()
+	if wasPlain, ok := g.modes[tok]; ok {
+		if wasPlain != plain {
+			return "", errors.Errorf("%s is used both as a plain value and as an input or output", t.Name())
+		}
+		return tok, nil
+	}
+	g.modes[tok] = plain
+
+	properties, err := g.properties(t, plain)
+	if err != nil {
+		return "", err
+	}
+	description := g.docs.typeDoc(t)
+	if description == "" {
+		return "", errors.Errorf("%s: types in the schema must have a doc comment", t.Name())
+	}
+	g.types[tok] = schema.ComplexTypeSpec{
+		ObjectTypeSpec: schema.ObjectTypeSpec{
+			Type:        "object",
+			Description: description,
+			Properties:  properties.properties,
+			Required:    properties.required,
+		},
+	}
+	return tok, nil
+}
+
+// elementType returns the type of the values of t if it is an Input or Output type, such as
+// pulumi.StringInput or WorkloadArrayOutput.
+func elementType(t reflect.Type) (reflect.Type, bool) {
+	if t.Kind() == reflect.Interface && t != anyType {
+		// An Input interface converts to an Output, which knows its element type.
+		for i := 0; i < t.NumMethod(); i++ {
+			m := t.Method(i)
+			if strings.HasPrefix(m.Name, "To") && strings.HasSuffix(m.Name, "Output") &&
+				m.Type.NumIn() == 0 && m.Type.NumOut() == 1 {
+				return elementType(m.Type.Out(0))
+			}
+		}
+		return nil, false
+	}
+	if t.Kind() == reflect.Struct && t.Implements(inputType) {
+		return reflect.Zero(t).Interface().(interface{ ElementType() reflect.Type }).ElementType(), true
+	}
+	return nil, false
+}
+
+// schemaTag is a parsed `schema:` struct tag.
+type schemaTag struct {
+	required     bool
+	secret       bool
+	defaultValue *string
+	ref          string
+}
+
+func parseSchemaTag(tag string) (schemaTag, error) {
+	var result schemaTag
+	if tag == "" {
+		return result, nil
+	}
+	for _, option := range strings.Split(tag, ",") {
+		key, value := option, ""
+		if i := strings.Index(option, "="); i >= 0 {
+			key, value = option[:i], option[i+1:]
+		}
+		switch key {
+		case "required":
+			result.required = true
+		case "secret":
+			result.secret = true
+		case "default":
+			result.defaultValue = &value
+		case "ref":
+			result.ref = value
+		default:
+			return result, errors.Errorf("unknown schema tag option %q", option)
+		}
+	}
+	return result, nil
+}
+
+// parseDefault parses the default value of a property of type t.
+func parseDefault(t schema.TypeSpec, value string) (interface{}, error) {
+	switch t.Type {
+	case "boolean":
+		return strconv.ParseBool(value)
+	case "integer":
+		return strconv.Atoi(value)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	}
+	return value, nil
+}
+
+// referenced reports whether any function in spec refers to the type tok.
+func referenced(spec schema.PackageSpec, tok string) bool {
+	functions, err := json.Marshal(spec.Functions)
+	if err != nil {
+		return false
+	}
+	return bytes.Contains(functions, []byte(strconv.Quote("#/types/"+tok)))
+}
+
+// docs are the doc comments of a package's types and their fields.
+type docs struct {
+	types  map[string]string
+	fields map[string]map[string]string
+}
+
+// readDocs reads the doc comments of the package at importPath from its source.
+func readDocs(importPath string) (docs, error) {
+	result := docs{types: map[string]string{}, fields: map[string]map[string]string{}}
+	wd, err := os.Getwd()
+	if err != nil {
+		return result, err
+	}
+	pkg, err := build.Import(importPath, wd, build.FindOnly)
+	if err != nil {
+		return result, errors.Wrapf(err, "finding the source of %s", importPath)
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, pkg.Dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return result, errors.Wrapf(err, "parsing %s", importPath)
+	}
+
+	for _, p := range pkgs {
+		for _, file := range p.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, s := range gen.Specs {
+					spec := s.(*ast.TypeSpec)
+					doc := spec.Doc
+					if doc == nil && len(gen.Specs) == 1 {
+						doc = gen.Doc
+					}
+					result.types[spec.Name.Name] = describe(spec.Name.Name, doc, false)
+
+					st, ok := spec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					fields := map[string]string{}
+					for _, field := range st.Fields.List {
+						for _, name := range field.Names {
+							fields[name.Name] = describe(name.Name, field.Doc, true)
+						}
+					}
+					result.fields[spec.Name.Name] = fields
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func (d docs) typeDoc(t reflect.Type) string {
+	return d.types[t.Name()]
+}
+
+func (d docs) fieldDoc(t reflect.Type, field string) string {
+	return d.fields[t.Name()][field]
+}
+
+// describe turns the doc comment of the Go identifier name into a description for the schema.
+// Type doc comments start with the type's name, which the description drops: "Probe is an HTTP
+// check" describes the Probe type as "An HTTP check". Field doc comments usually don't, so with
+// verbOnly the name is only dropped when followed by "is" or "are".
+func describe(name string, doc *ast.CommentGroup, verbOnly bool) string {
+	if doc == nil {
+		return ""
+	}
+	paragraphs := strings.Split(strings.TrimSpace(doc.Text()), "\n\n")
+	for i, p := range paragraphs {
+		paragraphs[i] = strings.Join(strings.Fields(p), " ")
+	}
+	text := strings.Join(paragraphs, "\n\n")
+
+	if rest := strings.TrimPrefix(text, name+" "); rest != text {
+		trimmed := rest
+		for _, verb := range []string{"is ", "are "} {
+			trimmed = strings.TrimPrefix(trimmed, verb)
+		}
+		if verbOnly && trimmed == rest {
+			return text
+		}
+		runes := []rune(trimmed)
+		runes[0] = unicode.ToUpper(runes[0])
+		text = string(runes)
+	}
+	return text
+}
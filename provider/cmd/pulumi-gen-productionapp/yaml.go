@@ -0,0 +1,539 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// Pulumi YAML programs are checked against the schema without running them: the resources and
+// function invocations of this package must use types and functions the schema defines, set
+// only the properties it defines with values of the right types, and set every required input.
+// References to a resource's outputs, such as ${app.url}, must name outputs it has. Values
+// computed by expressions are only known when the program runs, so they aren't checked.
+
+// interpolation matches the references in an interpolated string, such as ${app.url}.
+var interpolation = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// yamlCommand checks a Pulumi YAML program against the schema.
+func yamlCommand(args []string) {
+	flags := flag.NewFlagSet("yaml", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s yaml <program> <schema-file>\n\n", os.Args[0])
+		fmt.Fprintf(flags.Output(), "<program> is a Pulumi YAML program's Pulumi.yaml or the directory holding it.\n")
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	problems, err := checkYAML(flags.Arg(0), flags.Arg(1))
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	if err == nil && len(problems) > 0 {
+		err = errors.Errorf("%d problems in %s", len(problems), flags.Arg(0))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+// checkYAML checks the Pulumi YAML program at programPath against the schema at schemaPath,
+// returning the problems found.
+func checkYAML(programPath, schemaPath string) ([]string, error) {
+	pkg, err := readSchema(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := os.Stat(programPath); err == nil && info.IsDir() {
+		programPath = filepath.Join(programPath, "Pulumi.yaml")
+	}
+	contents, err := ioutil.ReadFile(programPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading program")
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(contents, &doc); err != nil {
+		return nil, errors.Wrap(err, "parsing program")
+	}
+
+	c := &yamlChecker{pkg: pkg, resources: map[string]*schema.Resource{}}
+	c.check(&doc)
+
+	sort.SliceStable(c.problems, func(i, j int) bool {
+		if c.problems[i].line != c.problems[j].line {
+			return c.problems[i].line < c.problems[j].line
+		}
+		return c.problems[i].column < c.problems[j].column
+	})
+	problems := make([]string, len(c.problems))
+	for i, p := range c.problems {
+		problems[i] = fmt.Sprintf("%s:%d:%d: %s", programPath, p.line, p.column, p.message)
+	}
+	return problems, nil
+}
+
+// yamlChecker checks a program against the package's schema.
+type yamlChecker struct {
+	pkg *schema.Package
+	// resources are the program's resources of this package, by name.
+	resources map[string]*schema.Resource
+	problems  []yamlProblem
+}
+
+// yamlProblem is a problem found in a program, at the position of the node it concerns.
+type yamlProblem struct {
+	line, column int
+	message      string
+}
+
+func (c *yamlChecker) report(n *yaml.Node, format string, args ...interface{}) {
+	c.problems = append(c.problems, yamlProblem{line: n.Line, column: n.Column, message: fmt.Sprintf(format, args...)})
+}
+
+func (c *yamlChecker) check(doc *yaml.Node) {
+	root := resolve(doc)
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = resolve(root.Content[0])
+	}
+	if root.Kind != yaml.MappingNode {
+		c.report(root, "a program must be a mapping")
+		return
+	}
+
+	// Resources are looked up first, so that references to them can be checked wherever
+	// they are.
+	resources := mappingValue(root, "resources")
+	if resources != nil {
+		forEach(resources, func(name *yaml.Node, resource *yaml.Node) {
+			c.declareResource(name.Value, resource)
+		})
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], resolve(root.Content[i+1])
+		switch key.Value {
+		case "resources":
+			forEach(value, func(name *yaml.Node, resource *yaml.Node) {
+				c.checkResource(name.Value, resource)
+			})
+		case "variables", "outputs":
+			c.checkExpressions(value)
+		}
+	}
+}
+
+// declareResource records the resource with the name if it's one of this package's.
+func (c *yamlChecker) declareResource(name string, resource *yaml.Node) {
+	typ := mappingValue(resource, "type")
+	if typ == nil || typ.Kind != yaml.ScalarNode {
+		return
+	}
+	token, ok := c.token(typ.Value)
+	if !ok {
+		return
+	}
+	for _, r := range c.pkg.Resources {
+		if r.Token == token {
+			c.resources[name] = r
+			return
+		}
+	}
+}
+
+func (c *yamlChecker) checkResource(name string, resource *yaml.Node) {
+	resource = resolve(resource)
+	if resource.Kind != yaml.MappingNode {
+		c.report(resource, "resource %s must be a mapping", name)
+		return
+	}
+	typ := mappingValue(resource, "type")
+	if typ == nil {
+		c.report(resource, "resource %s has no type", name)
+		c.checkExpressions(resource)
+		return
+	}
+
+	r, ours := c.resources[name]
+	if _, ok := c.token(typ.Value); ok && !ours {
+		c.report(typ, "resource %s: %s is not a resource type of the %s package", name, typ.Value, c.pkg.Name)
+	}
+
+	properties := mappingValue(resource, "properties")
+	for i := 0; i+1 < len(resource.Content); i += 2 {
+		if key := resource.Content[i]; key.Value != "properties" {
+			c.checkExpressions(resource.Content[i+1])
+		}
+	}
+	if r == nil {
+		if properties != nil {
+			c.checkExpressions(properties)
+		}
+		return
+	}
+
+	// A resource that is read with get needs none of its inputs.
+	if get := mappingValue(resource, "get"); get != nil {
+		if properties != nil {
+			c.checkExpressions(properties)
+		}
+		return
+	}
+	if properties == nil {
+		properties = &yaml.Node{Kind: yaml.MappingNode, Line: resource.Line, Column: resource.Column}
+	}
+	c.checkProperties(properties, r.InputProperties, "resource "+name)
+}
+
+// checkProperties checks a mapping's keys and values against properties.
+func (c *yamlChecker) checkProperties(n *yaml.Node, properties []*schema.Property, context string) {
+	n = resolve(n)
+	if c.isExpression(n) {
+		return
+	}
+	if n.Kind != yaml.MappingNode {
+		c.report(n, "%s must be a mapping, got %s", context, describeNode(n))
+		return
+	}
+
+	byName := map[string]*schema.Property{}
+	for _, p := range properties {
+		byName[p.Name] = p
+	}
+	set := map[string]bool{}
+	forEach(n, func(key *yaml.Node, value *yaml.Node) {
+		p, ok := byName[key.Value]
+		if !ok {
+			c.report(key, "%s: unknown property %q%s", context, key.Value, suggest(key.Value, byName))
+			c.checkExpressions(value)
+			return
+		}
+		set[key.Value] = true
+		c.checkValue(value, p.Type, context+"."+key.Value)
+	})
+
+	var missing []string
+	for _, p := range properties {
+		if p.IsRequired() && !set[p.Name] {
+			missing = append(missing, p.Name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		c.report(n, "%s: missing required property %q", context, name)
+	}
+}
+
+// checkValue checks that a value has type t.
+func (c *yamlChecker) checkValue(n *yaml.Node, t schema.Type, context string) {
+	n = resolve(n)
+	if c.isExpression(n) {
+		return
+	}
+
+	switch t := t.(type) {
+	case *schema.OptionalType:
+		if n.Kind == yaml.ScalarNode && n.ShortTag() == "!!null" {
+			return
+		}
+		c.checkValue(n, t.ElementType, context)
+	case *schema.InputType:
+		c.checkValue(n, t.ElementType, context)
+	case *schema.ArrayType:
+		if n.Kind != yaml.SequenceNode {
+			c.report(n, "%s must be a list, got %s", context, describeNode(n))
+			return
+		}
+		for i, element := range n.Content {
+			c.checkValue(element, t.ElementType, fmt.Sprintf("%s[%d]", context, i))
+		}
+	case *schema.MapType:
+		if n.Kind != yaml.MappingNode {
+			c.report(n, "%s must be a mapping, got %s", context, describeNode(n))
+			return
+		}
+		forEach(n, func(key *yaml.Node, value *yaml.Node) {
+			c.checkValue(value, t.ElementType, context+"."+key.Value)
+		})
+	case *schema.ObjectType:
+		c.checkProperties(n, t.Properties, context)
+	case *schema.EnumType:
+		if n.Kind != yaml.ScalarNode {
+			c.report(n, "%s must be one of %s, got %s", context, enumValues(t), describeNode(n))
+			return
+		}
+		for _, e := range t.Elements {
+			if fmt.Sprint(e.Value) == n.Value {
+				return
+			}
+		}
+		c.report(n, "%s must be one of %s, got %q", context, enumValues(t), n.Value)
+	case *schema.UnionType:
+		// The program's value is checked against the union's types when it runs.
+		c.checkExpressions(n)
+	default:
+		var ok bool
+		switch t {
+		case schema.StringType:
+			ok = n.Kind == yaml.ScalarNode && n.ShortTag() != "!!null"
+		case schema.IntType:
+			ok = n.Kind == yaml.ScalarNode && n.ShortTag() == "!!int"
+		case schema.NumberType:
+			ok = n.Kind == yaml.ScalarNode && (n.ShortTag() == "!!int" || n.ShortTag() == "!!float")
+		case schema.BoolType:
+			ok = n.Kind == yaml.ScalarNode && n.ShortTag() == "!!bool"
+		default:
+			ok = true
+		}
+		if !ok {
+			c.report(n, "%s must be %s, got %s", context, article(t.String()), describeNode(n))
+		}
+		c.checkExpressions(n)
+	}
+}
+
+// isExpression reports whether n is computed when the program runs, checking the references
+// and invocations in it.
+func (c *yamlChecker) isExpression(n *yaml.Node) bool {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if n.ShortTag() == "!!str" && interpolation.MatchString(n.Value) {
+			c.checkExpressions(n)
+			return true
+		}
+	case yaml.MappingNode:
+		if len(n.Content) == 2 && strings.HasPrefix(strings.ToLower(n.Content[0].Value), "fn::") {
+			c.checkExpressions(n)
+			return true
+		}
+	}
+	return false
+}
+
+// checkExpressions checks the references to this package's resources and the invocations of
+// its functions in n.
+func (c *yamlChecker) checkExpressions(n *yaml.Node) {
+	n = resolve(n)
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if n.ShortTag() != "!!str" {
+			return
+		}
+		for _, match := range interpolation.FindAllStringSubmatch(n.Value, -1) {
+			c.checkReference(n, match[1])
+		}
+	case yaml.SequenceNode:
+		for _, element := range n.Content {
+			c.checkExpressions(element)
+		}
+	case yaml.MappingNode:
+		if len(n.Content) == 2 && strings.EqualFold(n.Content[0].Value, "fn::invoke") {
+			c.checkInvoke(n.Content[1])
+			return
+		}
+		for i := 1; i < len(n.Content); i += 2 {
+			c.checkExpressions(n.Content[i])
+		}
+	}
+}
+
+// checkReference checks that a reference to one of this package's resources names one of its
+// outputs.
+func (c *yamlChecker) checkReference(n *yaml.Node, reference string) {
+	parts := strings.FieldsFunc(reference, func(r rune) bool { return r == '.' || r == '[' })
+	if len(parts) < 2 {
+		return
+	}
+	r, ok := c.resources[parts[0]]
+	if !ok {
+		return
+	}
+	output := parts[1]
+	// Every resource has an urn and an id.
+	if output == "urn" || output == "id" {
+		return
+	}
+	for _, p := range r.Properties {
+		if p.Name == output {
+			return
+		}
+	}
+	byName := map[string]*schema.Property{}
+	for _, p := range r.Properties {
+		byName[p.Name] = p
+	}
+	c.report(n, "${%s}: %s has no output %q%s", reference, tokenName(r.Token), output, suggest(output, byName))
+}
+
+// checkInvoke checks an invocation of one of this package's functions.
+func (c *yamlChecker) checkInvoke(n *yaml.Node) {
+	n = resolve(n)
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	arguments := mappingValue(n, "arguments")
+	f, ok := c.function(mappingValue(n, "function"))
+	if !ok {
+		if arguments != nil {
+			c.checkExpressions(arguments)
+		}
+		return
+	}
+
+	var inputs []*schema.Property
+	if f.Inputs != nil {
+		inputs = f.Inputs.Properties
+	}
+	if arguments == nil {
+		arguments = &yaml.Node{Kind: yaml.MappingNode, Line: n.Line, Column: n.Column}
+	}
+	c.checkProperties(arguments, inputs, tokenName(f.Token))
+
+	if ret := mappingValue(n, "return"); ret != nil && ret.Kind == yaml.ScalarNode && f.Outputs != nil {
+		if _, ok := f.Outputs.Property(ret.Value); !ok {
+			byName := map[string]*schema.Property{}
+			for _, p := range f.Outputs.Properties {
+				byName[p.Name] = p
+			}
+			c.report(ret, "%s has no output %q%s", tokenName(f.Token), ret.Value, suggest(ret.Value, byName))
+		}
+	}
+}
+
+// function returns the function of this package an invocation names. It reports false for
+// other packages' functions and for functions the schema doesn't define, reporting the latter.
+func (c *yamlChecker) function(name *yaml.Node) (*schema.Function, bool) {
+	if name == nil || name.Kind != yaml.ScalarNode {
+		return nil, false
+	}
+	token, ok := c.token(name.Value)
+	if !ok {
+		return nil, false
+	}
+	for _, f := range c.pkg.Functions {
+		if f.Token == token && !f.IsMethod {
+			return f, true
+		}
+	}
+	c.report(name, "%s is not a function of the %s package", name.Value, c.pkg.Name)
+	return nil, false
+}
+
+// token returns the full type token of a type or function in this package, expanding the
+// pkg:Name shorthand to pkg:index:Name. It reports false for tokens of other packages.
+func (c *yamlChecker) token(token string) (string, bool) {
+	parts := strings.Split(token, ":")
+	if parts[0] != c.pkg.Name {
+		return "", false
+	}
+	if len(parts) == 2 {
+		return parts[0] + ":index:" + parts[1], true
+	}
+	return token, true
+}
+
+// resolve follows aliases to the node they refer to.
+func resolve(n *yaml.Node) *yaml.Node {
+	for n.Kind == yaml.AliasNode && n.Alias != nil {
+		n = n.Alias
+	}
+	return n
+}
+
+// mappingValue returns the value of the key in a mapping, or nil.
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	n = resolve(n)
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return resolve(n.Content[i+1])
+		}
+	}
+	return nil
+}
+
+// forEach calls f with each key and value of a mapping.
+func forEach(n *yaml.Node, f func(key *yaml.Node, value *yaml.Node)) {
+	n = resolve(n)
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		f(n.Content[i], resolve(n.Content[i+1]))
+	}
+}
+
+func describeNode(n *yaml.Node) string {
+	switch n.Kind {
+	case yaml.SequenceNode:
+		return "a list"
+	case yaml.MappingNode:
+		return "a mapping"
+	}
+	switch n.ShortTag() {
+	case "!!null":
+		return "null"
+	case "!!int":
+		return "the integer " + n.Value
+	case "!!float":
+		return "the number " + n.Value
+	case "!!bool":
+		return "the boolean " + n.Value
+	}
+	return fmt.Sprintf("the string %q", n.Value)
+}
+
+func enumValues(t *schema.EnumType) string {
+	values := make([]string, len(t.Elements))
+	for i, e := range t.Elements {
+		values[i] = fmt.Sprintf("%q", fmt.Sprint(e.Value))
+	}
+	return strings.Join(values, ", ")
+}
+
+func article(noun string) string {
+	if strings.ContainsAny(noun[:1], "aeiou") {
+		return "an " + noun
+	}
+	return "a " + noun
+}
+
+// suggest suggests the property a misspelt name was meant to be, matching names case
+// insensitively.
+func suggest(name string, properties map[string]*schema.Property) string {
+	for candidate := range properties {
+		if strings.EqualFold(candidate, name) {
+			return fmt.Sprintf(", did you mean %q?", candidate)
+		}
+	}
+	return ""
+}
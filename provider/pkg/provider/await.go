@@ -0,0 +1,108 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	skipAwaitAnnotation      = "pulumi.com/skipAwait"
+	timeoutSecondsAnnotation = "pulumi.com/timeoutSeconds"
+)
+
+// Await controls how long Pulumi waits for the application to become ready.
+type Await struct {
+	// Don't wait for the Deployment and Service to become ready. The pre-deploy job is still
+	// awaited.
+	SkipAwait *bool `pulumi:"skipAwait"`
+	// How long to wait for the application's resources to be created. Defaults to 10 minutes.
+	CreateTimeoutSeconds *int `pulumi:"createTimeoutSeconds"`
+	// How long to wait for the application's resources to be updated. Defaults to 10 minutes.
+	UpdateTimeoutSeconds *int `pulumi:"updateTimeoutSeconds"`
+	// Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url`
+	// may be empty.
+	WaitForLoadBalancer *bool `pulumi:"waitForLoadBalancer"`
+}
+
+// awaitSettings are the annotations and options that apply an Await to the Deployment and
+// Service.
+type awaitSettings struct {
+	deploymentAnnotations pulumi.StringMap
+	serviceAnnotations    pulumi.StringMap
+	// timeouts also apply to the pre-deploy Job, unless it sets its own.
+	timeouts *pulumi.CustomTimeouts
+}
+
+func newAwaitSettings(await *Await) (awaitSettings, error) {
+	var settings awaitSettings
+	if await == nil {
+		return settings, nil
+	}
+
+	annotations := pulumi.StringMap{}
+	if await.SkipAwait != nil && *await.SkipAwait {
+		annotations[skipAwaitAnnotation] = pulumi.String("true")
+	}
+
+	if await.CreateTimeoutSeconds != nil || await.UpdateTimeoutSeconds != nil {
+		settings.timeouts = &pulumi.CustomTimeouts{}
+		if t := await.CreateTimeoutSeconds; t != nil {
+			if *t <= 0 {
+				return settings, fmt.Errorf("await.createTimeoutSeconds must be positive, got %d", *t)
+			}
+			settings.timeouts.Create = fmt.Sprintf("%ds", *t)
+		}
+		if t := await.UpdateTimeoutSeconds; t != nil {
+			if *t <= 0 {
+				return settings, fmt.Errorf("await.updateTimeoutSeconds must be positive, got %d", *t)
+			}
+			settings.timeouts.Update = fmt.Sprintf("%ds", *t)
+		}
+		// The annotation applies to both creates and updates, so it is only set when they
+		// agree. It takes precedence over the custom timeouts.
+		if c, u := await.CreateTimeoutSeconds, await.UpdateTimeoutSeconds; c != nil && u != nil && *c == *u {
+			annotations[timeoutSecondsAnnotation] = pulumi.String(strconv.Itoa(*c))
+		}
+	}
+
+	if len(annotations) > 0 {
+		settings.deploymentAnnotations = annotations
+	}
+
+	// Without waiting for the Service, the application's URL may not be known yet.
+	settings.serviceAnnotations = settings.deploymentAnnotations
+	if await.WaitForLoadBalancer != nil && !*await.WaitForLoadBalancer {
+		settings.serviceAnnotations = pulumi.StringMap{skipAwaitAnnotation: pulumi.String("true")}
+		for k, v := range annotations {
+			if k != skipAwaitAnnotation {
+				settings.serviceAnnotations[k] = v
+			}
+		}
+	}
+
+	return settings, nil
+}
+
+// options returns the resource options for the awaited resources.
+func (s awaitSettings) options() []pulumi.ResourceOption {
+	if s.timeouts == nil {
+		return nil
+	}
+	return []pulumi.ResourceOption{pulumi.Timeouts(s.timeouts)}
+}
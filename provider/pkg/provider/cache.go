@@ -0,0 +1,202 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apps/v1"
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const cachePort = 6379
+
+// The set of arguments for creating a Cache component resource.
+type CacheArgs struct {
+	// The Redis image to run.
+	Image pulumi.StringInput `pulumi:"image" schema:"default=redis:6"`
+	// The resource preset for the cache.
+	Size *string `pulumi:"size" schema:"default=small,ref=Size"`
+	// Whether to require a generated password to connect to the cache.
+	EnableAuth *bool `pulumi:"enableAuth" schema:"default=false"`
+}
+
+// Cache is a Redis cache running in the cluster.
+type Cache struct {
+	pulumi.ResourceState
+
+	// The in-cluster hostname of the cache.
+	Host pulumi.StringOutput `pulumi:"host" schema:"required"`
+	// The port the cache listens on.
+	Port pulumi.IntOutput `pulumi:"port" schema:"required"`
+	// The generated password for the cache, when authentication is enabled.
+	Password pulumi.StringOutput `pulumi:"password" schema:"secret"`
+	// A redis:// connection string for the cache.
+	ConnectionString pulumi.StringOutput `pulumi:"connectionString" schema:"required,secret"`
+}
+
+// NewCache creates a new Cache component resource: a single Redis instance behind a
+// ClusterIP service.
+func NewCache(ctx *pulumi.Context,
+	name string, args *CacheArgs, opts ...pulumi.ResourceOption) (*Cache, error) {
+	if args == nil {
+		args = &CacheArgs{}
+	}
+	if args.Image == nil {
+		args.Image = pulumi.String("redis:6")
+	}
+
+	size := "small"
+	if args.Size != nil {
+		size = *args.Size
+	}
+	preset, err := lookupSize(size)
+	if err != nil {
+		return nil, err
+	}
+
+	component := &Cache{}
+
+	err = ctx.RegisterComponentResource("productionapp:index:Cache", name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := pulumi.StringMap{
+		"app.kubernetes.io/app":        pulumi.String(name),
+		"app.kubernetes.io/component":  pulumi.String("cache"),
+		"app.production.instance/name": pulumi.String(name),
+	}
+
+	namespace, err := corev1.NewNamespace(ctx, name, &corev1.NamespaceArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Labels: labels,
+		},
+	}, pulumi.Parent(component))
+	if err != nil {
+		return nil, fmt.Errorf("error creating namespace: %v", err)
+	}
+
+	container := &corev1.ContainerArgs{
+		Name:  pulumi.String("redis"),
+		Image: args.Image,
+		Ports: &corev1.ContainerPortArray{
+			&corev1.ContainerPortArgs{
+				Name:          pulumi.String("redis"),
+				ContainerPort: pulumi.Int(cachePort),
+			},
+		},
+		Resources: preset.toResourceRequirements(),
+	}
+
+	password := pulumi.String("").ToStringOutput()
+	if args.EnableAuth != nil && *args.EnableAuth {
+		var secret *corev1.Secret
+		secret, password, err = newPasswordSecret(ctx, name, namespace.Metadata.Name().Elem(), labels,
+			pulumi.Parent(namespace))
+		if err != nil {
+			return nil, err
+		}
+
+		container.Env = &corev1.EnvVarArray{
+			&corev1.EnvVarArgs{
+				Name: pulumi.String("REDIS_PASSWORD"),
+				ValueFrom: &corev1.EnvVarSourceArgs{
+					SecretKeyRef: &corev1.SecretKeySelectorArgs{
+						Name: secret.Metadata.Name(),
+						Key:  pulumi.String(passwordSecretKey),
+					},
+				},
+			},
+		}
+		container.Args = pulumi.StringArray{
+			pulumi.String("redis-server"),
+			pulumi.String("--requirepass"),
+			pulumi.String("$(REDIS_PASSWORD)"),
+		}
+	}
+
+	_, err = appsv1.NewDeployment(ctx, name, &appsv1.DeploymentArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Namespace: namespace.Metadata.Name().Elem(),
+			Labels:    labels,
+		},
+		Spec: &appsv1.DeploymentSpecArgs{
+			Selector: &metav1.LabelSelectorArgs{
+				MatchLabels: labels,
+			},
+			Replicas: pulumi.Int(1),
+			Template: &corev1.PodTemplateSpecArgs{
+				Metadata: &metav1.ObjectMetaArgs{
+					Labels: labels,
+				},
+				Spec: &corev1.PodSpecArgs{
+					Containers: &corev1.ContainerArray{container},
+				},
+			},
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating deployment: %v", err)
+	}
+
+	service, err := corev1.NewService(ctx, name, &corev1.ServiceArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Namespace: namespace.Metadata.Name().Elem(),
+			Labels:    labels,
+		},
+		Spec: &corev1.ServiceSpecArgs{
+			Ports: &corev1.ServicePortArray{
+				&corev1.ServicePortArgs{
+					Name:       pulumi.String("redis"),
+					Port:       pulumi.Int(cachePort),
+					TargetPort: pulumi.Int(cachePort),
+				},
+			},
+			Type:     pulumi.String("ClusterIP"),
+			Selector: labels,
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating service: %v", err)
+	}
+
+	host := pulumi.Sprintf("%s.%s.svc.cluster.local", service.Metadata.Name().Elem(), namespace.Metadata.Name().Elem())
+	port := pulumi.Int(cachePort).ToIntOutput()
+	connectionString := pulumi.ToSecret(pulumi.All(host, port, password).ApplyT(func(v []interface{}) string {
+		if password := v[2].(string); password != "" {
+			return fmt.Sprintf("redis://:%s@%s:%d", password, v[0], v[1])
+		}
+		return fmt.Sprintf("redis://%s:%d", v[0], v[1])
+	})).(pulumi.StringOutput)
+
+	component.Host = host
+	component.Port = port
+	component.Password = password
+	component.ConnectionString = connectionString
+
+	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
+		"host":             host,
+		"port":             port,
+		"password":         password,
+		"connectionString": connectionString,
+	}); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
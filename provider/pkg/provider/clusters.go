@@ -0,0 +1,74 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Cluster is one of the clusters the application is deployed to, with overrides for the
+// application's settings there.
+type Cluster struct {
+	// The cluster's name, unique among the clusters. Used as a suffix for the names of the
+	// application's resources in it.
+	Name string `pulumi:"name" schema:"required"`
+	// The contents of a kubeconfig file, or the path to one, for the cluster.
+	Kubeconfig pulumi.StringInput `pulumi:"kubeconfig" schema:"secret"`
+	// The kubeconfig context of the cluster.
+	Context pulumi.StringInput `pulumi:"context"`
+	// The number of replicas in the cluster, overriding the environment's. Must be at least 1 when
+	// the application autoscales.
+	Replicas *int `pulumi:"replicas"`
+	// The image to deploy to the cluster, overriding `image`.
+	Image pulumi.StringInput `pulumi:"image"`
+}
+
+// validateCluster checks the i-th of args' clusters against the ones before it and returns the
+// name of the application's resources in it: the component's name suffixed with the cluster's.
+func validateCluster(i int, args *ProductionAppArgs, name string) (string, error) {
+	cluster, previous := args.Clusters[i], args.Clusters[:i]
+	if cluster.Name == "" {
+		return "", fmt.Errorf("clusters[%d].name is required", i)
+	}
+	for _, p := range previous {
+		if p.Name == cluster.Name {
+			return "", fmt.Errorf("clusters[%d].name %q is not unique", i, cluster.Name)
+		}
+	}
+	if cluster.Kubeconfig == nil && cluster.Context == nil {
+		return "", fmt.Errorf("clusters[%d] must set kubeconfig or context", i)
+	}
+	if cluster.Replicas != nil && *cluster.Replicas < 0 {
+		return "", fmt.Errorf("clusters[%d].replicas must not be negative, got %d", i, *cluster.Replicas)
+	}
+	// An unknown environment is reported on its own.
+	preset, _ := lookupEnvironment(args.Environment)
+	if cluster.Replicas != nil && *cluster.Replicas == 0 && autoscalesFromReplicas(preset, args.Autoscaling) {
+		return "", fmt.Errorf("clusters[%d].replicas must be at least 1 when the application autoscales, "+
+			"autoscalers can't scale to zero", i)
+	}
+
+	clusterName := fmt.Sprintf("%s-%s", name, cluster.Name)
+	preDeployJob := args.PreDeployJob != nil
+	if args.SanitizeName != nil && *args.SanitizeName {
+		return sanitizeName(clusterName, preDeployJob), nil
+	}
+	if err := validateName(clusterName, preDeployJob); err != nil {
+		return "", fmt.Errorf("clusters[%d]: %v", i, err)
+	}
+	return clusterName, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const kubernetesProviderType = "pulumi:providers:kubernetes"
+
+func TestClusters(t *testing.T) {
+	m := newMocks()
+	var mu sync.Mutex
+	var url string
+	var urls map[string]string
+	runMocked(t, m, func(ctx *pulumi.Context) error {
+		app, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+			Image:       pulumi.String("nginx"),
+			Port:        pulumi.Int(80),
+			Environment: pulumi.StringRef("staging"),
+			Clusters: []Cluster{
+				{Name: "east", Kubeconfig: pulumi.String("kubeconfig-east"), Replicas: intPtr(5),
+					Image: pulumi.String("nginx:east")},
+				{Name: "west", Context: pulumi.String("west")},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		pulumi.All(app.Url, app.Urls).ApplyT(func(v []interface{}) error {
+			mu.Lock()
+			defer mu.Unlock()
+			url, urls = v[0].(string), v[1].(map[string]string)
+			return nil
+		})
+		return nil
+	})
+
+	tests := []struct {
+		cluster  string
+		provider map[string]interface{}
+		image    string
+		replicas float64
+	}{
+		{cluster: "east", provider: map[string]interface{}{"kubeconfig": "kubeconfig-east"}, image: "nginx:east", replicas: 5},
+		{cluster: "west", provider: map[string]interface{}{"context": "west"}, image: "nginx", replicas: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cluster, func(t *testing.T) {
+			name := "app-" + tt.cluster
+			provider := m.resource(t, kubernetesProviderType, name)
+			for k, v := range tt.provider {
+				if got := field(provider.Inputs.Mappable(), k); got != v {
+					t.Errorf("provider %s: expected %v, got %v", k, v, got)
+				}
+			}
+			// The namespace uses the cluster's provider, and its children inherit it.
+			for _, typ := range []string{namespaceType, deploymentType} {
+				ref := m.resource(t, typ, name).RegisterRPC.GetProvider()
+				if !strings.HasSuffix(ref, kubernetesProviderType+"::"+name+"::"+name+"_id") {
+					t.Errorf("%s: expected the provider %s, got %q", typ, name, ref)
+				}
+			}
+
+			deployment := m.resource(t, deploymentType, name).Inputs.Mappable()
+			if got := field(deployment, "spec", "replicas"); got != tt.replicas {
+				t.Errorf("replicas: expected %v, got %v", tt.replicas, got)
+			}
+			if got := field(deployment, "spec", "template", "spec", "containers", 0, "image"); got != tt.image {
+				t.Errorf("image: expected %v, got %v", tt.image, got)
+			}
+		})
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := map[string]string{"east": "http://192.0.2.1", "west": "http://192.0.2.1"}
+	if !reflect.DeepEqual(urls, expected) {
+		t.Errorf("urls: expected %v, got %v", expected, urls)
+	}
+	if url != urls["east"] {
+		t.Errorf("url: expected the first cluster's, %q, got %q", urls["east"], url)
+	}
+}
+
+func TestClusterReplicas(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment *string
+		autoscaling *Autoscaling
+		replicas    int
+		err         bool
+	}{
+		{name: "staging scales to zero", environment: pulumi.StringRef("staging"), replicas: 0},
+		{name: "prod autoscales", environment: pulumi.StringRef("prod"), replicas: 0, err: true},
+		{name: "autoscaling enabled", autoscaling: &Autoscaling{}, replicas: 0, err: true},
+		{name: "autoscaling minimum", environment: pulumi.StringRef("prod"),
+			autoscaling: &Autoscaling{MinReplicas: intPtr(1)}, replicas: 0},
+		{name: "prod", environment: pulumi.StringRef("prod"), replicas: 1},
+		{name: "negative", replicas: -1, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := &ProductionAppArgs{
+				Environment: tt.environment,
+				Autoscaling: tt.autoscaling,
+				Clusters:    []Cluster{{Name: "east", Context: pulumi.String("east"), Replicas: intPtr(tt.replicas)}},
+			}
+			_, err := validateCluster(0, args, "app")
+			if got := err != nil; got != tt.err {
+				t.Errorf("expected an error: %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Config is the provider's configuration: organisation-wide defaults for the components it
+// creates, set once per stack.
+type Config struct {
+	// A registry to pull images that don't name one from, such as `registry.example.com/mirror`.
+	DefaultRegistry *string `pulumi:"defaultRegistry"`
+	// Labels added to every Deployment's resources. Labels set on a Deployment take precedence.
+	DefaultLabels map[string]string `pulumi:"defaultLabels"`
+	// The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their
+	// replicas, resources and availability settings, and is recorded as the
+	// `app.production.instance/environment` label.
+	Environment *string `pulumi:"environment"`
+}
+
+// parseConfig reads the provider's configuration from the variables the engine configures the
+// provider with. Keys are of the form `<provider>:config:<key>` or `<provider>:<key>`, and
+// object values are JSON encoded.
+func parseConfig(providerName string, variables map[string]string) (Config, error) {
+	var config Config
+	for k, v := range variables {
+		v := v
+		key := strings.TrimPrefix(strings.TrimPrefix(k, providerName+":"), "config:")
+		switch key {
+		case "defaultRegistry":
+			config.DefaultRegistry = &v
+		case "defaultLabels":
+			if err := json.Unmarshal([]byte(v), &config.DefaultLabels); err != nil {
+				return config, fmt.Errorf("%s must be an object of strings: %v", k, err)
+			}
+		case "environment":
+			config.Environment = &v
+		}
+	}
+	return config, nil
+}
+
+// applyTo sets the defaults in args that the component's inputs leave unset. Construct and the
+// validateArgs invoke share it; plain values stay plain, so the invoke, which runs outside a
+// Context, checks the same args construct does without creating outputs.
+func (c Config) applyTo(args *ProductionAppArgs) {
+	if c.DefaultRegistry != nil && *c.DefaultRegistry != "" {
+		registry := strings.TrimSuffix(*c.DefaultRegistry, "/")
+		prefix := func(image pulumi.StringInput) pulumi.StringInput {
+			switch image := image.(type) {
+			case nil:
+				return nil
+			case pulumi.String:
+				return pulumi.String(withRegistry(registry, string(image)))
+			}
+			return image.ToStringOutput().ApplyT(func(image string) string {
+				return withRegistry(registry, image)
+			}).(pulumi.StringOutput)
+		}
+		args.Image = prefix(args.Image)
+		for i := range args.Clusters {
+			args.Clusters[i].Image = prefix(args.Clusters[i].Image)
+		}
+		if args.PreDeployJob != nil && args.PreDeployJob.Image != nil {
+			image := withRegistry(registry, *args.PreDeployJob.Image)
+			args.PreDeployJob.Image = &image
+		}
+	}
+
+	if args.Environment == nil {
+		args.Environment = c.Environment
+	}
+
+	if len(c.DefaultLabels) > 0 {
+		labels := map[string]string{}
+		for k, v := range c.DefaultLabels {
+			labels[k] = v
+		}
+		for k, v := range args.Labels {
+			labels[k] = v
+		}
+		args.Labels = labels
+	}
+}
+
+// withRegistry prefixes image with registry unless the image already names one. As with
+// Docker, the first component of an image names a registry if it contains a '.' or ':' or is
+// "localhost".
+func withRegistry(registry, image string) string {
+	if i := strings.Index(image, "/"); i >= 0 {
+		host := image[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			return image
+		}
+	}
+	return registry + "/" + image
+}
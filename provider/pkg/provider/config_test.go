@@ -0,0 +1,159 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables map[string]string
+		expected  Config
+		err       string
+	}{
+		{
+			name: "config keys",
+			variables: map[string]string{
+				"productionapp:config:defaultRegistry": "registry.example.com",
+				"productionapp:config:defaultLabels":   `{"team":"web"}`,
+				"productionapp:config:environment":     "prod",
+			},
+			expected: Config{
+				DefaultRegistry: stringPtr("registry.example.com"),
+				DefaultLabels:   map[string]string{"team": "web"},
+				Environment:     stringPtr("prod"),
+			},
+		},
+		{
+			name:      "short keys",
+			variables: map[string]string{"productionapp:environment": "dev"},
+			expected:  Config{Environment: stringPtr("dev")},
+		},
+		{
+			name:      "unknown keys",
+			variables: map[string]string{"productionapp:config:version": "1.0.0", "kubernetes:config:context": "prod"},
+		},
+		{
+			name:      "invalid labels",
+			variables: map[string]string{"productionapp:config:defaultLabels": `["web"]`},
+			err:       "productionapp:config:defaultLabels must be an object of strings",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := parseConfig("productionapp", tt.variables)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(config, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, config)
+			}
+		})
+	}
+}
+
+func TestWithRegistry(t *testing.T) {
+	const registry = "registry.example.com/mirror"
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{"nginx", registry + "/nginx"},
+		{"nginx:1.21", registry + "/nginx:1.21"},
+		{"library/nginx", registry + "/library/nginx"},
+		{"org/app@sha256:abc", registry + "/org/app@sha256:abc"},
+		{"ghcr.io/org/app", "ghcr.io/org/app"},
+		{"localhost/app", "localhost/app"},
+		{"localhost:5000/app", "localhost:5000/app"},
+		{"registry:5000/app:v1", "registry:5000/app:v1"},
+	}
+	for _, tt := range tests {
+		if got := withRegistry(registry, tt.image); got != tt.expected {
+			t.Errorf("withRegistry(%q): expected %q, got %q", tt.image, tt.expected, got)
+		}
+	}
+}
+
+func TestApplyTo(t *testing.T) {
+	config := Config{
+		DefaultRegistry: stringPtr("registry.example.com/mirror/"),
+		DefaultLabels:   map[string]string{"team": "platform", "cost-center": "42"},
+		Environment:     stringPtr("staging"),
+	}
+
+	// Plain values stay plain, as the validateArgs invoke needs.
+	args := ProductionAppArgs{
+		Image:        pulumi.String("nginx"),
+		Clusters:     []Cluster{{Name: "east", Image: pulumi.String("ghcr.io/org/app")}, {Name: "west"}},
+		PreDeployJob: &PreDeployJob{Image: stringPtr("migrate")},
+		Labels:       map[string]string{"team": "web"},
+	}
+	config.applyTo(&args)
+	if args.Image != pulumi.String("registry.example.com/mirror/nginx") {
+		t.Errorf("expected the image to be prefixed, got %#v", args.Image)
+	}
+	if args.Clusters[0].Image != pulumi.String("ghcr.io/org/app") {
+		t.Errorf("expected a qualified cluster image to be kept, got %#v", args.Clusters[0].Image)
+	}
+	if args.Clusters[1].Image != nil {
+		t.Errorf("expected an unset cluster image to stay unset, got %#v", args.Clusters[1].Image)
+	}
+	if got := *args.PreDeployJob.Image; got != "registry.example.com/mirror/migrate" {
+		t.Errorf("expected the pre-deploy Job's image to be prefixed, got %q", got)
+	}
+	if expected := map[string]string{"team": "web", "cost-center": "42"}; !reflect.DeepEqual(args.Labels, expected) {
+		t.Errorf("expected the Deployment's labels to take precedence, got %v", args.Labels)
+	}
+	if args.Environment == nil || *args.Environment != "staging" {
+		t.Errorf("expected the configured environment, got %v", args.Environment)
+	}
+
+	args = ProductionAppArgs{Environment: stringPtr("prod")}
+	config.applyTo(&args)
+	if *args.Environment != "prod" {
+		t.Errorf("expected the Deployment's environment to take precedence, got %q", *args.Environment)
+	}
+
+	// Outputs are prefixed once they resolve.
+	var mu sync.Mutex
+	var image string
+	runMocked(t, newMocks(), func(ctx *pulumi.Context) error {
+		args := ProductionAppArgs{Image: pulumi.String("nginx").ToStringOutput()}
+		config.applyTo(&args)
+		args.Image.ToStringOutput().ApplyT(func(v string) string {
+			mu.Lock()
+			defer mu.Unlock()
+			image = v
+			return v
+		})
+		return nil
+	})
+	if image != "registry.example.com/mirror/nginx" {
+		t.Errorf("expected the image output to be prefixed, got %q", image)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apps/v1"
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const databasePort = 5432
+
+// The set of arguments for creating a Database component resource.
+type DatabaseArgs struct {
+	// The Postgres image to run.
+	Image pulumi.StringInput `pulumi:"image" schema:"default=postgres:14"`
+	// The name of the database to create.
+	DatabaseName pulumi.StringInput `pulumi:"databaseName" schema:"default=app"`
+	// The name of the database user to create.
+	Username pulumi.StringInput `pulumi:"username" schema:"default=app"`
+	// The size of the persistent volume backing the database.
+	StorageSize pulumi.StringInput `pulumi:"storageSize" schema:"default=1Gi"`
+	// The storage class for the persistent volume. Uses the cluster default when unset.
+	StorageClassName pulumi.StringInput `pulumi:"storageClassName"`
+}
+
+// Database is a Postgres database running in the cluster, intended for development environments.
+type Database struct {
+	pulumi.ResourceState
+
+	// The in-cluster hostname of the database.
+	Host pulumi.StringOutput `pulumi:"host" schema:"required"`
+	// The port the database listens on.
+	Port pulumi.IntOutput `pulumi:"port" schema:"required"`
+	// The name of the database.
+	DatabaseName pulumi.StringOutput `pulumi:"databaseName" schema:"required"`
+	// The name of the database user.
+	Username pulumi.StringOutput `pulumi:"username" schema:"required"`
+	// The generated password for the database user.
+	Password pulumi.StringOutput `pulumi:"password" schema:"required,secret"`
+	// A postgresql:// connection string for the database.
+	ConnectionString pulumi.StringOutput `pulumi:"connectionString" schema:"required,secret"`
+}
+
+// NewDatabase creates a new Database component resource: a single Postgres instance
+// running as a StatefulSet with its own persistent volume.
+func NewDatabase(ctx *pulumi.Context,
+	name string, args *DatabaseArgs, opts ...pulumi.ResourceOption) (*Database, error) {
+	if args == nil {
+		args = &DatabaseArgs{}
+	}
+	if args.Image == nil {
+		args.Image = pulumi.String("postgres:14")
+	}
+	if args.DatabaseName == nil {
+		args.DatabaseName = pulumi.String("app")
+	}
+	if args.Username == nil {
+		args.Username = pulumi.String("app")
+	}
+	if args.StorageSize == nil {
+		args.StorageSize = pulumi.String("1Gi")
+	}
+
+	var err error
+	component := &Database{}
+
+	err = ctx.RegisterComponentResource("productionapp:index:Database", name, component, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := pulumi.StringMap{
+		"app.kubernetes.io/app":        pulumi.String(name),
+		"app.kubernetes.io/component":  pulumi.String("database"),
+		"app.production.instance/name": pulumi.String(name),
+	}
+
+	namespace, err := corev1.NewNamespace(ctx, name, &corev1.NamespaceArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Labels: labels,
+		},
+	}, pulumi.Parent(component))
+	if err != nil {
+		return nil, fmt.Errorf("error creating namespace: %v", err)
+	}
+
+	secret, password, err := newPasswordSecret(ctx, name, namespace.Metadata.Name().Elem(), labels,
+		pulumi.Parent(namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := corev1.NewService(ctx, name, &corev1.ServiceArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Namespace: namespace.Metadata.Name().Elem(),
+			Labels:    labels,
+		},
+		Spec: &corev1.ServiceSpecArgs{
+			ClusterIP: pulumi.String("None"),
+			Ports: &corev1.ServicePortArray{
+				&corev1.ServicePortArgs{
+					Name:       pulumi.String("postgres"),
+					Port:       pulumi.Int(databasePort),
+					TargetPort: pulumi.Int(databasePort),
+				},
+			},
+			Selector: labels,
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating service: %v", err)
+	}
+
+	_, err = appsv1.NewStatefulSet(ctx, name, &appsv1.StatefulSetArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Namespace: namespace.Metadata.Name().Elem(),
+			Labels:    labels,
+		},
+		Spec: &appsv1.StatefulSetSpecArgs{
+			ServiceName: service.Metadata.Name().Elem(),
+			Selector: &metav1.LabelSelectorArgs{
+				MatchLabels: labels,
+			},
+			Replicas: pulumi.Int(1),
+			Template: &corev1.PodTemplateSpecArgs{
+				Metadata: &metav1.ObjectMetaArgs{
+					Labels: labels,
+				},
+				Spec: &corev1.PodSpecArgs{
+					Containers: &corev1.ContainerArray{
+						&corev1.ContainerArgs{
+							Name:  pulumi.String("postgres"),
+							Image: args.Image,
+							Ports: &corev1.ContainerPortArray{
+								&corev1.ContainerPortArgs{
+									Name:          pulumi.String("postgres"),
+									ContainerPort: pulumi.Int(databasePort),
+								},
+							},
+							Env: &corev1.EnvVarArray{
+								&corev1.EnvVarArgs{
+									Name:  pulumi.String("POSTGRES_DB"),
+									Value: args.DatabaseName,
+								},
+								&corev1.EnvVarArgs{
+									Name:  pulumi.String("POSTGRES_USER"),
+									Value: args.Username,
+								},
+								&corev1.EnvVarArgs{
+									Name: pulumi.String("POSTGRES_PASSWORD"),
+									ValueFrom: &corev1.EnvVarSourceArgs{
+										SecretKeyRef: &corev1.SecretKeySelectorArgs{
+											Name: secret.Metadata.Name(),
+											Key:  pulumi.String(passwordSecretKey),
+										},
+									},
+								},
+								&corev1.EnvVarArgs{
+									Name:  pulumi.String("PGDATA"),
+									Value: pulumi.String("/var/lib/postgresql/data/pgdata"),
+								},
+							},
+							VolumeMounts: &corev1.VolumeMountArray{
+								&corev1.VolumeMountArgs{
+									Name:      pulumi.String("data"),
+									MountPath: pulumi.String("/var/lib/postgresql/data"),
+								},
+							},
+						},
+					},
+				},
+			},
+			VolumeClaimTemplates: &corev1.PersistentVolumeClaimTypeArray{
+				&corev1.PersistentVolumeClaimTypeArgs{
+					Metadata: &metav1.ObjectMetaArgs{
+						Name: pulumi.String("data"),
+					},
+					Spec: &corev1.PersistentVolumeClaimSpecArgs{
+						AccessModes: pulumi.StringArray{
+							pulumi.String("ReadWriteOnce"),
+						},
+						StorageClassName: args.StorageClassName,
+						Resources: &corev1.ResourceRequirementsArgs{
+							Requests: pulumi.StringMap{
+								"storage": args.StorageSize,
+							},
+						},
+					},
+				},
+			},
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating statefulset: %v", err)
+	}
+
+	host := pulumi.Sprintf("%s.%s.svc.cluster.local", service.Metadata.Name().Elem(), namespace.Metadata.Name().Elem())
+	port := pulumi.Int(databasePort).ToIntOutput()
+	databaseName := args.DatabaseName.ToStringOutput()
+	username := args.Username.ToStringOutput()
+	connectionString := pulumi.ToSecret(pulumi.Sprintf("postgresql://%s:%s@%s:%d/%s",
+		username, password, host, port, databaseName)).(pulumi.StringOutput)
+
+	component.Host = host
+	component.Port = port
+	component.DatabaseName = databaseName
+	component.Username = username
+	component.Password = password
+	component.ConnectionString = connectionString
+
+	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
+		"host":             host,
+		"port":             port,
+		"databaseName":     databaseName,
+		"username":         username,
+		"password":         password,
+		"connectionString": connectionString,
+	}); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apps/v1"
+	autoscalingv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/autoscaling/v1"
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	policyv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/policy/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// defaultReplicas is the number of replicas when no environment is set.
+const defaultReplicas = 3
+
+// environmentPreset is a coherent set of defaults for the application in one environment
+// tier.
+type environmentPreset struct {
+	Replicas int
+	// MinAvailable is the PodDisruptionBudget's minimum, a number or percentage of pods, if
+	// the application has one.
+	MinAvailable *string
+	// Autoscaling scales the application from Replicas up to MaxReplicas, if set.
+	Autoscaling *autoscalingPreset
+	Resources   resourcePreset
+	// AntiAffinity spreads the application's pods across nodes.
+	AntiAffinity     bool
+	ImagePullPolicy  string
+	ProtectNamespace bool
+}
+
+type autoscalingPreset struct {
+	MaxReplicas                    int
+	TargetCPUUtilizationPercentage int
+}
+
+// environmentPresets are the environments the application can be deployed to: dev is cheap
+// and prod is safe.
+var environmentPresets = map[string]environmentPreset{
+	"dev": {
+		Replicas: 1,
+		Resources: resourcePreset{
+			Requests: map[string]string{"cpu": "50m", "memory": "64Mi"},
+			Limits:   map[string]string{"cpu": "250m", "memory": "256Mi"},
+		},
+		ImagePullPolicy: "Always",
+	},
+	"staging": {
+		Replicas:     2,
+		MinAvailable: pulumi.StringRef("1"),
+		Resources: resourcePreset{
+			Requests: map[string]string{"cpu": "100m", "memory": "128Mi"},
+			Limits:   map[string]string{"cpu": "500m", "memory": "512Mi"},
+		},
+		AntiAffinity:    true,
+		ImagePullPolicy: "IfNotPresent",
+	},
+	"prod": {
+		Replicas:     3,
+		MinAvailable: pulumi.StringRef("50%"),
+		Autoscaling: &autoscalingPreset{
+			MaxReplicas:                    10,
+			TargetCPUUtilizationPercentage: 70,
+		},
+		Resources: resourcePreset{
+			Requests: map[string]string{"cpu": "250m", "memory": "256Mi"},
+			Limits:   map[string]string{"cpu": "1", "memory": "1Gi"},
+		},
+		AntiAffinity:     true,
+		ImagePullPolicy:  "IfNotPresent",
+		ProtectNamespace: true,
+	},
+}
+
+// lookupEnvironment returns the preset for environment, or nil if no environment is set.
+func lookupEnvironment(environment *string) (*environmentPreset, error) {
+	if environment == nil {
+		return nil, nil
+	}
+	preset, ok := environmentPresets[*environment]
+	if !ok {
+		names := make([]string, 0, len(environmentPresets))
+		for name := range environmentPresets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown environment %q, must be one of %s", *environment, strings.Join(names, ", "))
+	}
+	return &preset, nil
+}
+
+// podAntiAffinity prefers scheduling the pods matching selector on different nodes.
+func podAntiAffinity(selector pulumi.StringMap) *corev1.AffinityArgs {
+	return &corev1.AffinityArgs{
+		PodAntiAffinity: &corev1.PodAntiAffinityArgs{
+			PreferredDuringSchedulingIgnoredDuringExecution: corev1.WeightedPodAffinityTermArray{
+				&corev1.WeightedPodAffinityTermArgs{
+					Weight: pulumi.Int(100),
+					PodAffinityTerm: &corev1.PodAffinityTermArgs{
+						TopologyKey: pulumi.String("kubernetes.io/hostname"),
+						LabelSelector: &metav1.LabelSelectorArgs{
+							MatchLabels: selector,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Autoscaling scales the application with a HorizontalPodAutoscaler, overriding the
+// environment's autoscaling or enabling it for environments that don't autoscale.
+type Autoscaling struct {
+	// The fewest replicas to scale to. Defaults to the application's replicas.
+	MinReplicas *int `pulumi:"minReplicas"`
+	// The most replicas to scale to. Defaults to the environment's maximum, or 10.
+	MaxReplicas *int `pulumi:"maxReplicas"`
+	// The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to
+	// the environment's target, or 70.
+	TargetCpuUtilizationPercentage *int `pulumi:"targetCpuUtilizationPercentage"`
+}
+
+func (a *Autoscaling) validate() error {
+	if a.MinReplicas != nil && *a.MinReplicas < 1 {
+		return fmt.Errorf("autoscaling.minReplicas must be at least 1, got %d", *a.MinReplicas)
+	}
+	if a.MaxReplicas != nil && *a.MaxReplicas < 1 {
+		return fmt.Errorf("autoscaling.maxReplicas must be at least 1, got %d", *a.MaxReplicas)
+	}
+	if a.MinReplicas != nil && a.MaxReplicas != nil && *a.MaxReplicas < *a.MinReplicas {
+		return fmt.Errorf("autoscaling.maxReplicas (%d) must not be less than autoscaling.minReplicas (%d)",
+			*a.MaxReplicas, *a.MinReplicas)
+	}
+	if t := a.TargetCpuUtilizationPercentage; t != nil && (*t < 1 || *t > 100) {
+		return fmt.Errorf("autoscaling.targetCpuUtilizationPercentage must be between 1 and 100, got %d", *t)
+	}
+	return nil
+}
+
+// horizontalScaling is the resolved configuration of the application's autoscaler.
+type horizontalScaling struct {
+	minReplicas                    int
+	maxReplicas                    int
+	targetCPUUtilizationPercentage int
+}
+
+// autoscalingSettings resolves the application's autoscaling from the environment's preset
+// and the autoscaling input, or returns nil if it doesn't autoscale. The autoscaler scales
+// from replicas unless the input sets its minimum, and its maximum is never below its
+// minimum.
+func autoscalingSettings(preset *environmentPreset, replicas int, autoscaling *Autoscaling) (*horizontalScaling, error) {
+	prod := environmentPresets["prod"].Autoscaling
+	var scaling *horizontalScaling
+	switch {
+	case preset != nil && preset.Autoscaling != nil:
+		scaling = &horizontalScaling{replicas, preset.Autoscaling.MaxReplicas,
+			preset.Autoscaling.TargetCPUUtilizationPercentage}
+	case autoscaling != nil:
+		scaling = &horizontalScaling{replicas, prod.MaxReplicas, prod.TargetCPUUtilizationPercentage}
+	default:
+		return nil, nil
+	}
+
+	if autoscaling != nil {
+		if err := autoscaling.validate(); err != nil {
+			return nil, err
+		}
+		if autoscaling.MinReplicas != nil {
+			scaling.minReplicas = *autoscaling.MinReplicas
+		}
+		if autoscaling.MaxReplicas != nil {
+			scaling.maxReplicas = *autoscaling.MaxReplicas
+		}
+		if autoscaling.TargetCpuUtilizationPercentage != nil {
+			scaling.targetCPUUtilizationPercentage = *autoscaling.TargetCpuUtilizationPercentage
+		}
+	}
+	if scaling.maxReplicas < scaling.minReplicas {
+		scaling.maxReplicas = scaling.minReplicas
+	}
+	return scaling, nil
+}
+
+// autoscalesFromReplicas reports whether the application autoscales with its replicas as the
+// autoscaler's minimum, which must then be at least 1.
+func autoscalesFromReplicas(preset *environmentPreset, autoscaling *Autoscaling) bool {
+	autoscales := (preset != nil && preset.Autoscaling != nil) || autoscaling != nil
+	return autoscales && (autoscaling == nil || autoscaling.MinReplicas == nil)
+}
+
+// newEnvironmentResources creates the PodDisruptionBudget and HorizontalPodAutoscaler the
+// application asks for, if any.
+func newEnvironmentResources(ctx *pulumi.Context, name string, minAvailable *string, scaling *horizontalScaling,
+	deployment *appsv1.Deployment, namespace *corev1.Namespace, metadata objectMetadata) error {
+	if minAvailable != nil {
+		var value pulumi.Input = pulumi.String(*minAvailable)
+		if n, err := strconv.Atoi(*minAvailable); err == nil {
+			value = pulumi.Int(n)
+		}
+		_, err := policyv1.NewPodDisruptionBudget(ctx, name, &policyv1.PodDisruptionBudgetArgs{
+			Metadata: metadata.objectMeta(namespace),
+			Spec: &policyv1.PodDisruptionBudgetSpecArgs{
+				MinAvailable: value,
+				Selector: &metav1.LabelSelectorArgs{
+					MatchLabels: metadata.selectorLabels,
+				},
+			},
+		}, pulumi.Parent(namespace))
+		if err != nil {
+			return fmt.Errorf("error creating pod disruption budget: %v", err)
+		}
+	}
+
+	if scaling != nil {
+		_, err := autoscalingv1.NewHorizontalPodAutoscaler(ctx, name, &autoscalingv1.HorizontalPodAutoscalerArgs{
+			Metadata: metadata.objectMeta(namespace),
+			Spec: &autoscalingv1.HorizontalPodAutoscalerSpecArgs{
+				ScaleTargetRef: &autoscalingv1.CrossVersionObjectReferenceArgs{
+					ApiVersion: pulumi.String("apps/v1"),
+					Kind:       pulumi.String("Deployment"),
+					Name:       deployment.Metadata.Name().Elem(),
+				},
+				MinReplicas:                    pulumi.Int(scaling.minReplicas),
+				MaxReplicas:                    pulumi.Int(scaling.maxReplicas),
+				TargetCPUUtilizationPercentage: pulumi.Int(scaling.targetCPUUtilizationPercentage),
+			},
+		}, pulumi.Parent(namespace))
+		if err != nil {
+			return fmt.Errorf("error creating horizontal pod autoscaler: %v", err)
+		}
+	}
+
+	return nil
+}
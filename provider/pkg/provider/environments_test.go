@@ -0,0 +1,155 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	namespaceType  = "kubernetes:core/v1:Namespace"
+	deploymentType = "kubernetes:apps/v1:Deployment"
+	pdbType        = "kubernetes:policy/v1:PodDisruptionBudget"
+	hpaType        = "kubernetes:autoscaling/v1:HorizontalPodAutoscaler"
+)
+
+// field returns the value at path in v, or nil if there is none.
+func field(v interface{}, path ...interface{}) interface{} {
+	for _, key := range path {
+		switch key := key.(type) {
+		case string:
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			v = m[key]
+		case int:
+			a, ok := v.([]interface{})
+			if !ok || key >= len(a) {
+				return nil
+			}
+			v = a[key]
+		}
+	}
+	return v
+}
+
+func TestEnvironmentPresets(t *testing.T) {
+	tests := []struct {
+		environment      string
+		replicas         float64
+		minAvailable     interface{}
+		hpa              []float64 // minReplicas, maxReplicas, targetCPUUtilizationPercentage
+		requests         map[string]interface{}
+		limits           map[string]interface{}
+		antiAffinity     bool
+		imagePullPolicy  string
+		protectNamespace bool
+	}{
+		{
+			environment:     "dev",
+			replicas:        1,
+			requests:        map[string]interface{}{"cpu": "50m", "memory": "64Mi"},
+			limits:          map[string]interface{}{"cpu": "250m", "memory": "256Mi"},
+			imagePullPolicy: "Always",
+		},
+		{
+			environment:     "staging",
+			replicas:        2,
+			minAvailable:    float64(1),
+			requests:        map[string]interface{}{"cpu": "100m", "memory": "128Mi"},
+			limits:          map[string]interface{}{"cpu": "500m", "memory": "512Mi"},
+			antiAffinity:    true,
+			imagePullPolicy: "IfNotPresent",
+		},
+		{
+			environment:      "prod",
+			replicas:         3,
+			minAvailable:     "50%",
+			hpa:              []float64{3, 10, 70},
+			requests:         map[string]interface{}{"cpu": "250m", "memory": "256Mi"},
+			limits:           map[string]interface{}{"cpu": "1", "memory": "1Gi"},
+			antiAffinity:     true,
+			imagePullPolicy:  "IfNotPresent",
+			protectNamespace: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.environment, func(t *testing.T) {
+			m := newMocks()
+			runMocked(t, m, func(ctx *pulumi.Context) error {
+				_, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+					Image:       pulumi.String("nginx"),
+					Port:        pulumi.Int(80),
+					Environment: pulumi.StringRef(tt.environment),
+				})
+				return err
+			})
+
+			deployment := m.resource(t, deploymentType, "app").Inputs.Mappable()
+			if got := field(deployment, "spec", "replicas"); got != tt.replicas {
+				t.Errorf("replicas: expected %v, got %v", tt.replicas, got)
+			}
+			container := field(deployment, "spec", "template", "spec", "containers", 0)
+			if got := field(container, "resources", "requests"); !reflect.DeepEqual(got, tt.requests) {
+				t.Errorf("requests: expected %v, got %v", tt.requests, got)
+			}
+			if got := field(container, "resources", "limits"); !reflect.DeepEqual(got, tt.limits) {
+				t.Errorf("limits: expected %v, got %v", tt.limits, got)
+			}
+			if got := field(container, "imagePullPolicy"); got != tt.imagePullPolicy {
+				t.Errorf("imagePullPolicy: expected %v, got %v", tt.imagePullPolicy, got)
+			}
+			affinity := field(deployment, "spec", "template", "spec", "affinity", "podAntiAffinity")
+			if got := affinity != nil; got != tt.antiAffinity {
+				t.Errorf("anti-affinity: expected %v, got %v", tt.antiAffinity, affinity)
+			}
+
+			if tt.minAvailable == nil {
+				if m.registered(pdbType, "app") {
+					t.Errorf("expected no PodDisruptionBudget")
+				}
+			} else {
+				pdb := m.resource(t, pdbType, "app").Inputs.Mappable()
+				if got := field(pdb, "spec", "minAvailable"); got != tt.minAvailable {
+					t.Errorf("minAvailable: expected %v, got %v", tt.minAvailable, got)
+				}
+			}
+
+			if tt.hpa == nil {
+				if m.registered(hpaType, "app") {
+					t.Errorf("expected no HorizontalPodAutoscaler")
+				}
+			} else {
+				spec := field(m.resource(t, hpaType, "app").Inputs.Mappable(), "spec")
+				got := []interface{}{
+					field(spec, "minReplicas"), field(spec, "maxReplicas"), field(spec, "targetCPUUtilizationPercentage"),
+				}
+				expected := []interface{}{tt.hpa[0], tt.hpa[1], tt.hpa[2]}
+				if !reflect.DeepEqual(got, expected) {
+					t.Errorf("autoscaler minReplicas, maxReplicas and target: expected %v, got %v", expected, got)
+				}
+			}
+
+			namespace := m.resource(t, namespaceType, "app")
+			if got := namespace.RegisterRPC.GetProtect(); got != tt.protectNamespace {
+				t.Errorf("namespace protect: expected %v, got %v", tt.protectNamespace, got)
+			}
+		})
+	}
+}
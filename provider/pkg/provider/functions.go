@@ -0,0 +1,349 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// ValidateArgsArgs are the inputs of the validateArgs function: a Deployment's name and its
+// inputs.
+type ValidateArgsArgs struct {
+	// The name the Deployment would be given.
+	Name string `pulumi:"name" schema:"required"`
+	ProductionAppArgs
+}
+
+// invokeValidateArgs is an implementation of Invoke for the validateArgs function. It checks a
+// Deployment's name and inputs the way constructing it would, without creating anything.
+func invokeValidateArgs(config Config, inputs resource.PropertyMap) (resource.PropertyMap, error) {
+	problems := []string{}
+	args := &ValidateArgsArgs{}
+	if err := decodeInputs(inputs, args); err != nil {
+		problems = append(problems, err.Error())
+	} else {
+		config.applyTo(&args.ProductionAppArgs)
+		problems = append(problems, validateDeploymentArgs(args.Name, &args.ProductionAppArgs)...)
+	}
+
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"problems": problems,
+	}), nil
+}
+
+// checkDeploymentArgs checks a Deployment's inputs before any resources are created, logging
+// a diagnostic for every problem found.
+func checkDeploymentArgs(ctx *pulumi.Context, name string, args *ProductionAppArgs) error {
+	problems := validateDeploymentArgs(name, args)
+	for _, problem := range problems {
+		if err := ctx.Log.Error(problem, nil); err != nil {
+			return err
+		}
+	}
+	if len(problems) > 0 {
+		return errors.Errorf("invalid arguments: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validateDeploymentArgs returns a problem for every invalid input of a Deployment named name.
+// These are the problems NewProductionApp reports before creating any resources.
+func validateDeploymentArgs(name string, args *ProductionAppArgs) []string {
+	var problems []string
+	check := func(prefix string, err error) {
+		if err != nil {
+			problems = append(problems, prefix+err.Error())
+		}
+	}
+
+	if name == "" {
+		problems = append(problems, "name is required")
+	} else if args.SanitizeName != nil && *args.SanitizeName {
+		name = sanitizeName(name, args.PreDeployJob != nil)
+	} else {
+		check("", validateName(name, args.PreDeployJob != nil))
+	}
+	if args.Image == nil {
+		problems = append(problems, "image is required")
+	}
+	if args.Port == nil {
+		problems = append(problems, "port is required")
+	}
+
+	problems = append(problems, patchProblems(args.Patches)...)
+
+	preset, err := lookupEnvironment(args.Environment)
+	check("", err)
+
+	if len(args.Clusters) > 0 && (args.Kubeconfig != nil || args.Context != nil) {
+		problems = append(problems, "kubeconfig and context cannot be combined with clusters, set them per cluster")
+	}
+	for i := range args.Clusters {
+		_, err := validateCluster(i, args, name)
+		check("", err)
+	}
+
+	if args.RegistryCredentials != nil {
+		check("registryCredentials: ", validateRegistryCredentials(args.RegistryCredentials))
+	}
+	svcType, err := serviceType(args.ServiceType)
+	check("", err)
+	_, _, err = shutdownSettings(args.Shutdown, behindLoadBalancer(svcType, args.Ingress))
+	check("shutdown: ", err)
+	_, _, err = podVolumes(args.Volumes, args.VolumeMounts, args.AllowHostPath != nil && *args.AllowHostPath)
+	check("", err)
+	if args.Quota != nil {
+		check("quota: ", args.Quota.validate(svcType))
+	}
+	_, err = newAwaitSettings(args.Await)
+	check("", err)
+	if args.SmokeTest != nil {
+		check("", args.SmokeTest.validate(svcType, args.Ingress, args.Await))
+	}
+	check("", validateProbes(args))
+	_, err = containerResources(preset, args.Size, args.Resources)
+	check("", err)
+	if args.Ingress != nil {
+		check("", args.Ingress.validate())
+	}
+	if args.Autoscaling != nil {
+		check("", args.Autoscaling.validate())
+	}
+
+	return problems
+}
+
+// invokeGetPresets is an implementation of Invoke for the getPresets function. It returns the
+// environment presets a Deployment can use and the sizes a Cache or a Deployment's container
+// can have.
+func invokeGetPresets() (resource.PropertyMap, error) {
+	environments := map[string]interface{}{}
+	for _, name := range sortedKeys(environmentPresets) {
+		preset := environmentPresets[name]
+		p := map[string]interface{}{
+			"replicas":         preset.Replicas,
+			"resources":        preset.Resources.toMap(),
+			"antiAffinity":     preset.AntiAffinity,
+			"imagePullPolicy":  preset.ImagePullPolicy,
+			"protectNamespace": preset.ProtectNamespace,
+		}
+		if preset.MinAvailable != nil {
+			p["minAvailable"] = *preset.MinAvailable
+		}
+		if preset.Autoscaling != nil {
+			p["maxReplicas"] = preset.Autoscaling.MaxReplicas
+			p["targetCpuUtilizationPercentage"] = preset.Autoscaling.TargetCPUUtilizationPercentage
+		}
+		environments[name] = p
+	}
+
+	sizePresets := map[string]interface{}{}
+	for _, name := range sortedKeys(sizes) {
+		sizePresets[name] = sizes[name].toMap()
+	}
+
+	return resource.NewPropertyMapFromMap(map[string]interface{}{
+		"environments": environments,
+		"sizes":        sizePresets,
+	}), nil
+}
+
+func (p resourcePreset) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"requests": p.Requests,
+		"limits":   p.Limits,
+	}
+}
+
+// objectInputArgs are the Args types `inputs.CopyTo` decodes each object Input type into.
+var objectInputArgs = map[reflect.Type]reflect.Type{
+	reflect.TypeOf((*DatabaseConnectionInput)(nil)).Elem():  reflect.TypeOf(DatabaseConnectionArgs{}),
+	reflect.TypeOf((*CacheConnectionInput)(nil)).Elem():     reflect.TypeOf(CacheConnectionArgs{}),
+	reflect.TypeOf((*RegistryCredentialsInput)(nil)).Elem(): reflect.TypeOf(RegistryCredentialsArgs{}),
+}
+
+var (
+	stringInputType      = reflect.TypeOf((*pulumi.StringInput)(nil)).Elem()
+	intInputType         = reflect.TypeOf((*pulumi.IntInput)(nil)).Elem()
+	stringArrayInputType = reflect.TypeOf((*pulumi.StringArrayInput)(nil)).Elem()
+)
+
+// decodeInputs decodes the inputs of an invoke into args, a pointer to a component's args
+// struct, like `inputs.CopyTo` does for construct. Invokes are called with plain values, so
+// unknown values are left unset and secrets are not tracked.
+func decodeInputs(inputs resource.PropertyMap, args interface{}) error {
+	return decodeValue("", resource.NewObjectProperty(inputs), reflect.ValueOf(args).Elem())
+}
+
+func decodeValue(path string, v resource.PropertyValue, dest reflect.Value) error {
+	for {
+		if v.IsSecret() {
+			v = v.SecretValue().Element
+		} else if v.IsOutput() && v.OutputValue().Known {
+			v = v.OutputValue().Element
+		} else {
+			break
+		}
+	}
+	if v.IsNull() || v.IsComputed() || v.IsOutput() {
+		return nil
+	}
+
+	mismatch := func() error {
+		return fmt.Errorf("%s: expected %s, got %s", path, typeName(dest.Type()), v.TypeString())
+	}
+
+	switch dest.Type() {
+	case stringInputType:
+		if !v.IsString() {
+			return mismatch()
+		}
+		dest.Set(reflect.ValueOf(pulumi.String(v.StringValue())))
+		return nil
+	case intInputType:
+		if !v.IsNumber() {
+			return mismatch()
+		}
+		dest.Set(reflect.ValueOf(pulumi.Int(int(v.NumberValue()))))
+		return nil
+	case stringArrayInputType:
+		var values []string
+		if err := decodeValue(path, v, reflect.ValueOf(&values).Elem()); err != nil {
+			return err
+		}
+		dest.Set(reflect.ValueOf(pulumi.ToStringArray(values)))
+		return nil
+	}
+	if argsType, ok := objectInputArgs[dest.Type()]; ok {
+		args := reflect.New(argsType).Elem()
+		if err := decodeValue(path, v, args); err != nil {
+			return err
+		}
+		dest.Set(args)
+		return nil
+	}
+
+	switch dest.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(dest.Type().Elem())
+		if err := decodeValue(path, v, elem.Elem()); err != nil {
+			return err
+		}
+		dest.Set(elem)
+	case reflect.Struct:
+		if !v.IsObject() {
+			return mismatch()
+		}
+		obj := v.ObjectValue()
+		for i := 0; i < dest.NumField(); i++ {
+			field := dest.Type().Field(i)
+			tag := strings.Split(field.Tag.Get("pulumi"), ",")[0]
+			if tag == "" {
+				// The fields of an embedded struct are decoded from the same object.
+				if field.Anonymous && field.Type.Kind() == reflect.Struct {
+					if err := decodeValue(path, v, dest.Field(i)); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if fv, ok := obj[resource.PropertyKey(tag)]; ok {
+				if err := decodeValue(joinPath(path, tag), fv, dest.Field(i)); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Slice:
+		if !v.IsArray() {
+			return mismatch()
+		}
+		arr := v.ArrayValue()
+		slice := reflect.MakeSlice(dest.Type(), len(arr), len(arr))
+		for i, e := range arr {
+			if err := decodeValue(fmt.Sprintf("%s[%d]", path, i), e, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		dest.Set(slice)
+	case reflect.Map:
+		if !v.IsObject() {
+			return mismatch()
+		}
+		m := reflect.MakeMap(dest.Type())
+		for k, e := range v.ObjectValue() {
+			elem := reflect.New(dest.Type().Elem()).Elem()
+			if err := decodeValue(joinPath(path, string(k)), e, elem); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(string(k)), elem)
+		}
+		dest.Set(m)
+	case reflect.String:
+		if !v.IsString() {
+			return mismatch()
+		}
+		dest.SetString(v.StringValue())
+	case reflect.Int:
+		if !v.IsNumber() {
+			return mismatch()
+		}
+		dest.SetInt(int64(v.NumberValue()))
+	case reflect.Bool:
+		if !v.IsBool() {
+			return mismatch()
+		}
+		dest.SetBool(v.BoolValue())
+	case reflect.Interface:
+		dest.Set(reflect.ValueOf(v.Mappable()))
+	default:
+		return fmt.Errorf("%s: cannot decode into %s", path, dest.Type())
+	}
+	return nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// typeName names the kind of value a Go type holds, in the schema's terms.
+func typeName(t reflect.Type) string {
+	switch t {
+	case stringInputType:
+		return "a string"
+	case intInputType:
+		return "an integer"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Int:
+		return "an integer"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Slice:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/pkg/v3/codegen/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// TestValidateArgs checks that the validateArgs invoke reports the problems constructing the
+// Deployment does, with the provider's configuration applied the same way.
+func TestValidateArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   Config
+		inputs   map[string]interface{}
+		args     ProductionAppArgs
+		problems []string
+	}{
+		{
+			name:   "valid",
+			config: Config{DefaultRegistry: stringPtr("registry.example.com/mirror/"), Environment: stringPtr("staging")},
+			inputs: map[string]interface{}{"name": "app", "image": "nginx", "port": 80},
+			args:   ProductionAppArgs{Image: pulumi.String("nginx"), Port: pulumi.Int(80)},
+		},
+		{
+			name:     "configured environment",
+			config:   Config{Environment: stringPtr("qa")},
+			inputs:   map[string]interface{}{"name": "app", "image": "nginx", "port": 80},
+			args:     ProductionAppArgs{Image: pulumi.String("nginx"), Port: pulumi.Int(80)},
+			problems: []string{`unknown environment "qa", must be one of dev, prod, staging`},
+		},
+		{
+			name: "smoke test without a load balancer",
+			inputs: map[string]interface{}{
+				"name": "app", "image": "nginx", "port": 80, "serviceType": "ClusterIP",
+				"smokeTest": map[string]interface{}{},
+			},
+			args: ProductionAppArgs{
+				Image: pulumi.String("nginx"), Port: pulumi.Int(80), ServiceType: stringPtr("ClusterIP"),
+				SmokeTest: &SmokeTest{},
+			},
+			problems: []string{"smokeTest requires a LoadBalancer Service or an ingress, a ClusterIP Service is only reachable from inside the cluster"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := invokeValidateArgs(tt.config, resource.NewPropertyMapFromMap(tt.inputs))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var problems []string
+			for _, p := range result["problems"].ArrayValue() {
+				problems = append(problems, p.StringValue())
+			}
+			if !reflect.DeepEqual(problems, tt.problems) {
+				t.Errorf("validateArgs: expected %q, got %q", tt.problems, problems)
+			}
+
+			args := tt.args
+			tt.config.applyTo(&args)
+			err = pulumi.RunErr(func(ctx *pulumi.Context) error {
+				_, err := NewProductionApp(ctx, "app", &args)
+				return err
+			}, pulumi.WithMocks("project", "stack", newMocks()))
+			switch {
+			case len(tt.problems) == 0 && err != nil:
+				t.Errorf("NewProductionApp: unexpected error: %v", err)
+			case len(tt.problems) > 0 && (err == nil || !strings.Contains(err.Error(), strings.Join(tt.problems, "; "))):
+				t.Errorf("NewProductionApp: expected an error containing %q, got %v", strings.Join(tt.problems, "; "), err)
+			}
+		})
+	}
+}
+
+// TestGetPresetsSchema checks the hand-written schema of the getPresets function against the
+// presets it returns.
+func TestGetPresetsSchema(t *testing.T) {
+	b, err := ioutil.ReadFile("../../cmd/pulumi-resource-productionapp/schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec schema.PackageSpec
+	if err := json.Unmarshal(b, &spec); err != nil {
+		t.Fatal(err)
+	}
+	presets, err := invokeGetPresets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := presets.Mappable()
+
+	enum := func(token string) []string {
+		var values []string
+		for _, e := range spec.Types[token].Enum {
+			values = append(values, e.Value.(string))
+		}
+		sort.Strings(values)
+		return values
+	}
+	keys := func(v interface{}) []string {
+		var keys []string
+		for k := range v.(map[string]interface{}) {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+	if got, expected := keys(result["environments"]), enum("productionapp:index:Environment"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected the environments %v of the Environment enum, got %v", expected, got)
+	}
+	if got, expected := keys(result["sizes"]), enum("productionapp:index:Size"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected the sizes %v of the Size enum, got %v", expected, got)
+	}
+
+	for name, preset := range result["environments"].(map[string]interface{}) {
+		checkObjectSchema(t, "environments."+name, spec, "productionapp:index:EnvironmentPreset", preset)
+	}
+	for name, size := range result["sizes"].(map[string]interface{}) {
+		checkObjectSchema(t, "sizes."+name, spec, "productionapp:index:ResourcePreset", size)
+	}
+}
+
+// checkObjectSchema checks that v has the required properties of the object type token and
+// that every property it has is declared with a matching type.
+func checkObjectSchema(t *testing.T, path string, spec schema.PackageSpec, token string, v interface{}) {
+	t.Helper()
+	typ := spec.Types[token]
+	obj := v.(map[string]interface{})
+	for _, required := range typ.Required {
+		if _, ok := obj[required]; !ok {
+			t.Errorf("%s: missing the required property %s", path, required)
+		}
+	}
+	for k, v := range obj {
+		property, ok := typ.Properties[k]
+		if !ok {
+			t.Errorf("%s: %s isn't declared by %s", path, k, token)
+			continue
+		}
+		if ref := strings.TrimPrefix(property.Ref, "#/types/"); ref != "" {
+			checkObjectSchema(t, path+"."+k, spec, ref, v)
+			continue
+		}
+		var matches bool
+		switch property.Type {
+		case "integer":
+			_, matches = v.(float64)
+		case "boolean":
+			_, matches = v.(bool)
+		case "string":
+			_, matches = v.(string)
+		case "object":
+			_, matches = v.(map[string]interface{})
+		}
+		if !matches {
+			t.Errorf("%s.%s: expected %s, got %T", path, k, property.Type, v)
+		}
+	}
+}
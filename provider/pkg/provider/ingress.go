@@ -0,0 +1,119 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	networkingv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/networking/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Ingress routes a host name to the application's Service.
+type Ingress struct {
+	// The host name to route, such as `app.example.com`.
+	Host string `pulumi:"host" schema:"required"`
+	// The path prefix to route. Defaults to `/`.
+	Path *string `pulumi:"path"`
+	// The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default
+	// class.
+	ClassName *string `pulumi:"className"`
+	// A Secret in the application's namespace holding the host's TLS certificate. Setting it serves
+	// the application over HTTPS.
+	TlsSecretName *string `pulumi:"tlsSecretName"`
+	// Annotations added to the Ingress, such as the controller's settings.
+	Annotations map[string]string `pulumi:"annotations"`
+}
+
+func (i *Ingress) validate() error {
+	if i.Host == "" {
+		return fmt.Errorf("ingress.host is required")
+	}
+	if strings.Contains(i.Host, "/") {
+		return fmt.Errorf("ingress.host must be a host name, got %q", i.Host)
+	}
+	if i.Path != nil && !strings.HasPrefix(*i.Path, "/") {
+		return fmt.Errorf("ingress.path must start with '/', got %q", *i.Path)
+	}
+	return nil
+}
+
+// url returns the URL the ingress serves the application at.
+func (i *Ingress) url() string {
+	scheme := "http"
+	if i.TlsSecretName != nil {
+		scheme = "https"
+	}
+	path := ""
+	if i.Path != nil && *i.Path != "/" {
+		path = *i.Path
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, i.Host, path)
+}
+
+// newIngress creates an Ingress that routes the ingress's host and path to service.
+func newIngress(ctx *pulumi.Context, name string, ingress *Ingress, service *corev1.Service,
+	namespace *corev1.Namespace, metadata objectMetadata, opts ...pulumi.ResourceOption) (*networkingv1.Ingress, error) {
+	if err := ingress.validate(); err != nil {
+		return nil, err
+	}
+
+	path := "/"
+	if ingress.Path != nil {
+		path = *ingress.Path
+	}
+	var tls networkingv1.IngressTLSArray
+	if ingress.TlsSecretName != nil {
+		tls = append(tls, &networkingv1.IngressTLSArgs{
+			Hosts:      pulumi.StringArray{pulumi.String(ingress.Host)},
+			SecretName: pulumi.String(*ingress.TlsSecretName),
+		})
+	}
+
+	resource, err := networkingv1.NewIngress(ctx, name, &networkingv1.IngressArgs{
+		Metadata: metadata.withAnnotations(pulumi.ToStringMap(ingress.Annotations)).objectMeta(namespace),
+		Spec: &networkingv1.IngressSpecArgs{
+			IngressClassName: optionalString(ingress.ClassName),
+			Rules: networkingv1.IngressRuleArray{
+				&networkingv1.IngressRuleArgs{
+					Host: pulumi.String(ingress.Host),
+					Http: &networkingv1.HTTPIngressRuleValueArgs{
+						Paths: networkingv1.HTTPIngressPathArray{
+							&networkingv1.HTTPIngressPathArgs{
+								Path:     pulumi.String(path),
+								PathType: pulumi.String("Prefix"),
+								Backend: &networkingv1.IngressBackendArgs{
+									Service: &networkingv1.IngressServiceBackendArgs{
+										Name: service.Metadata.Name().Elem(),
+										Port: &networkingv1.ServiceBackendPortArgs{
+											Number: pulumi.Int(80),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Tls: tls,
+		},
+	}, append(opts, pulumi.Parent(namespace))...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ingress: %v", err)
+	}
+	return resource, nil
+}
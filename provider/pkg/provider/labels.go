@@ -0,0 +1,147 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	appLabel          = "app.kubernetes.io/app"
+	instanceNameLabel = "app.production.instance/name"
+	versionLabel      = "app.kubernetes.io/version"
+	managedByLabel    = "app.kubernetes.io/managed-by"
+	environmentLabel  = "app.production.instance/environment"
+
+	managedBy = "pulumi-productionapp"
+
+	maxLabelValueLength = 63
+)
+
+// objectMetadata is the metadata shared by every resource the Deployment component creates.
+type objectMetadata struct {
+	// selectorLabels select the application's pods. They never change, as a Deployment's
+	// selector is immutable.
+	selectorLabels pulumi.StringMap
+	labels         pulumi.StringMap
+	annotations    pulumi.StringMap
+}
+
+// newObjectMetadata merges the user's labels and annotations with the built-in ones. The
+// recommended labels can be overridden, but the selector labels always win.
+func newObjectMetadata(ctx *pulumi.Context, name string, args *ProductionAppArgs) (objectMetadata, error) {
+	metadata := objectMetadata{
+		selectorLabels: pulumi.StringMap{
+			appLabel:          pulumi.String(name),
+			instanceNameLabel: pulumi.String(name),
+		},
+		labels: pulumi.StringMap{
+			managedByLabel: pulumi.String(managedBy),
+		},
+	}
+	if args.Image != nil {
+		metadata.labels[versionLabel] = args.Image.ToStringOutput().ApplyT(imageVersion).(pulumi.StringOutput)
+	}
+	if args.Environment != nil {
+		metadata.labels[environmentLabel] = pulumi.String(*args.Environment)
+	}
+
+	for _, k := range sortedKeys(args.Labels) {
+		if _, ok := metadata.selectorLabels[k]; ok {
+			if err := ctx.Log.Warn(fmt.Sprintf("labels.%s: ignored, the label is used to select the application's pods", k), nil); err != nil {
+				return metadata, err
+			}
+			continue
+		}
+		metadata.labels[k] = pulumi.String(args.Labels[k])
+	}
+	for k, v := range metadata.selectorLabels {
+		metadata.labels[k] = v
+	}
+
+	if len(args.Annotations) > 0 {
+		metadata.annotations = pulumi.ToStringMap(args.Annotations)
+	}
+
+	return metadata, nil
+}
+
+// objectMeta returns the metadata of a resource in the application's namespace.
+func (m objectMetadata) objectMeta(namespace *corev1.Namespace) *metav1.ObjectMetaArgs {
+	return &metav1.ObjectMetaArgs{
+		Namespace:   namespace.Metadata.Name().Elem(),
+		Labels:      m.labels,
+		Annotations: m.annotations,
+	}
+}
+
+// withAnnotations returns a copy of the metadata with extra annotations added.
+func (m objectMetadata) withAnnotations(extra pulumi.StringMap) objectMetadata {
+	if len(extra) == 0 {
+		return m
+	}
+	annotations := pulumi.StringMap{}
+	for k, v := range m.annotations {
+		annotations[k] = v
+	}
+	for k, v := range extra {
+		annotations[k] = v
+	}
+	m.annotations = annotations
+	return m
+}
+
+// imageVersion derives a label value from an image's tag, falling back to its digest.
+func imageVersion(image string) string {
+	var digest string
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, digest = image[:i], image[i+1:]
+	}
+
+	version := "latest"
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		version = image[i+1:]
+	} else if digest != "" {
+		version = digest[strings.Index(digest, ":")+1:]
+	}
+
+	return labelValue(version)
+}
+
+// labelValue makes s a valid label value: at most 63 alphanumeric characters, '-', '_' or
+// '.', beginning and ending with an alphanumeric character.
+func labelValue(s string) string {
+	value := []byte(s)
+	for i, c := range value {
+		if !isAlphanumeric(c) && c != '-' && c != '_' && c != '.' {
+			value[i] = '-'
+		}
+	}
+	if len(value) > maxLabelValueLength {
+		value = value[:maxLabelValueLength]
+	}
+	return strings.TrimFunc(string(value), func(r rune) bool {
+		return r > 127 || !isAlphanumeric(byte(r))
+	})
+}
+
+func isAlphanumeric(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
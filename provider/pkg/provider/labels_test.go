@@ -0,0 +1,133 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestImageVersion(t *testing.T) {
+	digest := strings.Repeat("0123456789abcdef", 4)
+	tests := []struct {
+		image    string
+		expected string
+	}{
+		{"nginx", "latest"},
+		{"nginx:1.21", "1.21"},
+		{"ghcr.io/org/app:v2.0.1", "v2.0.1"},
+		{"localhost:5000/app", "latest"},
+		{"localhost:5000/app:v2", "v2"},
+		{"nginx@sha256:" + digest, digest[:63]},
+		{"localhost:5000/app@sha256:abc123", "abc123"},
+		{"nginx:1.21@sha256:" + digest, "1.21"},
+		{"app:v1+build.7", "v1-build.7"},
+	}
+	for _, tt := range tests {
+		if got := imageVersion(tt.image); got != tt.expected {
+			t.Errorf("imageVersion(%q): expected %q, got %q", tt.image, tt.expected, got)
+		}
+	}
+}
+
+func TestLabelValue(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"v1.0_rc-1", "v1.0_rc-1"},
+		{"feature/login", "feature-login"},
+		{"-v1-", "v1"},
+		{"_v1.", "v1"},
+		{"héllo", "h--llo"},
+		{"é", ""},
+		{"", ""},
+		{strings.Repeat("a", 70), strings.Repeat("a", 63)},
+		{strings.Repeat("a", 62) + "-b", strings.Repeat("a", 62)},
+	}
+	for _, tt := range tests {
+		if got := labelValue(tt.value); got != tt.expected {
+			t.Errorf("labelValue(%q): expected %q, got %q", tt.value, tt.expected, got)
+		}
+	}
+}
+
+func TestObjectMetadata(t *testing.T) {
+	m := newMocks()
+	runMocked(t, m, func(ctx *pulumi.Context) error {
+		_, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+			Image:       pulumi.String("ghcr.io/org/app:v2"),
+			Port:        pulumi.Int(80),
+			Environment: stringPtr("dev"),
+			Labels: map[string]string{
+				appLabel:          "other",
+				instanceNameLabel: "other",
+				managedByLabel:    "team-web",
+				versionLabel:      "v3",
+				"team":            "web",
+			},
+			Annotations: map[string]string{"owner": "web@example.com"},
+		})
+		return err
+	})
+
+	expected := map[string]interface{}{
+		appLabel:          "app",
+		instanceNameLabel: "app",
+		managedByLabel:    "team-web",
+		versionLabel:      "v3",
+		environmentLabel:  "dev",
+		"team":            "web",
+	}
+	for _, typ := range []string{namespaceType, deploymentType, "kubernetes:core/v1:Service"} {
+		metadata := m.resource(t, typ, "app").Inputs["metadata"].Mappable()
+		for k, v := range expected {
+			if got := field(metadata, "labels", k); got != v {
+				t.Errorf("%s: expected the label %s=%v, got %v", typ, k, v, got)
+			}
+		}
+		if got := field(metadata, "annotations", "owner"); got != "web@example.com" {
+			t.Errorf("%s: expected the owner annotation, got %v", typ, got)
+		}
+	}
+
+	deployment := m.resource(t, deploymentType, "app").Inputs.Mappable()
+	selector := field(deployment, "spec", "selector", "matchLabels").(map[string]interface{})
+	if len(selector) != 2 || selector[appLabel] != "app" || selector[instanceNameLabel] != "app" {
+		t.Errorf("expected the selector to use only the built-in selector labels, got %v", selector)
+	}
+}
+
+// TestObjectMetadataVersion checks that the version label is derived from the image unless a
+// label overrides it.
+func TestObjectMetadataVersion(t *testing.T) {
+	m := newMocks()
+	runMocked(t, m, func(ctx *pulumi.Context) error {
+		_, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+			Image: pulumi.String("localhost:5000/app@sha256:abc123"),
+			Port:  pulumi.Int(80),
+		})
+		return err
+	})
+	metadata := m.resource(t, deploymentType, "app").Inputs["metadata"].Mappable()
+	if got := field(metadata, "labels", versionLabel); got != "abc123" {
+		t.Errorf("expected the version label from the image's digest, got %v", got)
+	}
+	if got := field(metadata, "labels", environmentLabel); got != nil {
+		t.Errorf("expected no environment label without an environment, got %v", got)
+	}
+}
@@ -0,0 +1,346 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/provider"
+)
+
+// restartedAtAnnotation is the pod template annotation `kubectl rollout restart` bumps.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// The Deployment component's methods act on its workloads through kubectl, with the kubeconfig
+// and context each workload was deployed with. A workload deployed with neither uses kubectl's
+// own kubeconfig, as the provider did.
+
+// restartResult is the result of the Deployment component's restart method.
+type restartResult struct {
+	RestartedAt pulumi.StringOutput `pulumi:"restartedAt"`
+}
+
+// callRestart restarts the application's pods by bumping the restartedAt annotation on each of
+// its Deployments' pod templates. Previews don't restart anything.
+func callRestart(ctx *pulumi.Context, self *ProductionApp) (*provider.CallResult, error) {
+	restartedAt := self.Workloads.ApplyTWithContext(context.Background(), func(goCtx context.Context,
+		workloads []Workload) (string, error) {
+		if ctx.DryRun() {
+			return "", nil
+		}
+		if _, err := lookKubectl(); err != nil {
+			return "", err
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		patch, err := json.Marshal(map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"annotations": map[string]string{restartedAtAnnotation: now},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, w := range workloads {
+			if _, err := w.kubectl(goCtx, "patch", "deployment", w.Deployment, "--type", "merge",
+				"--patch", string(patch)); err != nil {
+				return "", w.errorf("restarting: %v", err)
+			}
+		}
+		return now, nil
+	}).(pulumi.StringOutput)
+
+	return provider.NewCallResult(&restartResult{RestartedAt: restartedAt})
+}
+
+// ReplicaStatus is the number of a Deployment's replicas in each state.
+type ReplicaStatus struct {
+	Replicas          int `json:"replicas"`
+	ReadyReplicas     int `json:"readyReplicas"`
+	AvailableReplicas int `json:"availableReplicas"`
+	UpdatedReplicas   int `json:"updatedReplicas"`
+}
+
+// deploymentStatus is the status of the application's Deployments.
+type deploymentStatus struct {
+	total    ReplicaStatus
+	clusters map[string]interface{}
+}
+
+// getStatusResult is the result of the Deployment component's getStatus method.
+type getStatusResult struct {
+	Replicas          pulumi.IntOutput `pulumi:"replicas"`
+	ReadyReplicas     pulumi.IntOutput `pulumi:"readyReplicas"`
+	AvailableReplicas pulumi.IntOutput `pulumi:"availableReplicas"`
+	UpdatedReplicas   pulumi.IntOutput `pulumi:"updatedReplicas"`
+	Clusters          pulumi.MapOutput `pulumi:"clusters"`
+}
+
+// callGetStatus reads the live status of the application's Deployments, totalled across
+// clusters and broken down by cluster when there are several.
+func callGetStatus(ctx *pulumi.Context, self *ProductionApp) (*provider.CallResult, error) {
+	status := self.Workloads.ApplyTWithContext(context.Background(), func(goCtx context.Context,
+		workloads []Workload) (deploymentStatus, error) {
+		status := deploymentStatus{clusters: map[string]interface{}{}}
+		if _, err := lookKubectl(); err != nil {
+			return status, err
+		}
+		for _, w := range workloads {
+			out, err := w.kubectl(goCtx, "get", "deployment", w.Deployment, "--output", "json")
+			if err != nil {
+				return status, w.errorf("reading status: %v", err)
+			}
+			var deployment struct {
+				Status ReplicaStatus `json:"status"`
+			}
+			if err := json.Unmarshal(out, &deployment); err != nil {
+				return status, w.errorf("reading status: %v", err)
+			}
+
+			status.total.Replicas += deployment.Status.Replicas
+			status.total.ReadyReplicas += deployment.Status.ReadyReplicas
+			status.total.AvailableReplicas += deployment.Status.AvailableReplicas
+			status.total.UpdatedReplicas += deployment.Status.UpdatedReplicas
+			if w.Cluster != nil {
+				status.clusters[*w.Cluster] = deployment.Status.toMap()
+			}
+		}
+		return status, nil
+	}).(pulumi.AnyOutput)
+
+	count := func(f func(ReplicaStatus) int) pulumi.IntOutput {
+		return status.ApplyT(func(v interface{}) int {
+			return f(v.(deploymentStatus).total)
+		}).(pulumi.IntOutput)
+	}
+	return provider.NewCallResult(&getStatusResult{
+		Replicas:          count(func(s ReplicaStatus) int { return s.Replicas }),
+		ReadyReplicas:     count(func(s ReplicaStatus) int { return s.ReadyReplicas }),
+		AvailableReplicas: count(func(s ReplicaStatus) int { return s.AvailableReplicas }),
+		UpdatedReplicas:   count(func(s ReplicaStatus) int { return s.UpdatedReplicas }),
+		Clusters: status.ApplyT(func(v interface{}) map[string]interface{} {
+			return v.(deploymentStatus).clusters
+		}).(pulumi.MapOutput),
+	})
+}
+
+func (s ReplicaStatus) toMap() map[string]interface{} {
+	return map[string]interface{}{
+		"replicas":          s.Replicas,
+		"readyReplicas":     s.ReadyReplicas,
+		"availableReplicas": s.AvailableReplicas,
+		"updatedReplicas":   s.UpdatedReplicas,
+	}
+}
+
+// getKubectlCommandsResult is the result of the Deployment component's getKubectlCommands
+// method.
+type getKubectlCommandsResult struct {
+	Commands pulumi.ArrayOutput `pulumi:"commands"`
+}
+
+// callGetKubectlCommands returns kubectl commands for inspecting and operating each of the
+// application's workloads. The kubeconfig contents a workload was deployed with aren't
+// included in its commands, which read them from a file the caller saves them to instead.
+func callGetKubectlCommands(ctx *pulumi.Context, self *ProductionApp) (*provider.CallResult, error) {
+	commands := self.Workloads.ApplyT(func(workloads []Workload) []interface{} {
+		commands := make([]interface{}, 0, len(workloads))
+		for _, w := range workloads {
+			commands = append(commands, w.kubectlCommands())
+		}
+		return commands
+	}).(pulumi.ArrayOutput)
+
+	return provider.NewCallResult(&getKubectlCommandsResult{Commands: commands})
+}
+
+// kubectlCommands returns the workload's kubectl commands, as a KubectlCommands object.
+func (w Workload) kubectlCommands() map[string]interface{} {
+	kubeconfig := w.kubeconfigPath()
+	if w.hasKubeconfig() && kubeconfig == "" {
+		kubeconfig = "kubeconfig.yaml"
+		if w.Cluster != nil {
+			kubeconfig = fmt.Sprintf("kubeconfig-%s.yaml", *w.Cluster)
+		}
+	}
+	command := func(args ...string) string {
+		return shellJoin(append(append([]string{"kubectl"}, args...), w.kubectlFlags(kubeconfig)...))
+	}
+	deployment := "deployment/" + w.Deployment
+	c := map[string]interface{}{
+		"getPods":       command("get", "pods"),
+		"logs":          command("logs", deployment, "--all-containers", "--follow"),
+		"describe":      command("describe", deployment),
+		"rolloutStatus": command("rollout", "status", deployment),
+		"restart":       command("rollout", "restart", deployment),
+	}
+	if w.Cluster != nil {
+		c["cluster"] = *w.Cluster
+	}
+	if kubeconfig != "" {
+		c["kubeconfig"] = kubeconfig
+	}
+	return c
+}
+
+// kubectlFlags returns the flags that select the workload's namespace and cluster, reading its
+// kubeconfig from the file at kubeconfig if it is set. Each application has a namespace of its
+// own.
+func (w Workload) kubectlFlags(kubeconfig string) []string {
+	flags := []string{"--namespace", w.Namespace}
+	if kubeconfig != "" {
+		flags = append(flags, "--kubeconfig", kubeconfig)
+	}
+	if w.Context != nil && *w.Context != "" {
+		flags = append(flags, "--context", *w.Context)
+	}
+	return flags
+}
+
+func (w Workload) hasKubeconfig() bool {
+	return w.Kubeconfig != nil && *w.Kubeconfig != ""
+}
+
+// kubeconfigPath returns the path of the workload's kubeconfig if it was deployed with the path
+// to one rather than its contents, which span several lines.
+func (w Workload) kubeconfigPath() string {
+	if !w.hasKubeconfig() || strings.Contains(*w.Kubeconfig, "\n") {
+		return ""
+	}
+	return *w.Kubeconfig
+}
+
+// kubectl runs kubectl with args against the workload's namespace and cluster. A kubeconfig's
+// contents are written to a temporary file for the duration of the command.
+func (w Workload) kubectl(ctx context.Context, args ...string) ([]byte, error) {
+	// Workloads in one of several clusters were deployed with a kubeconfig or a context, and
+	// kubectl's own kubeconfig may point anywhere, so it isn't used for them. Workloads recorded
+	// before their kubeconfig was have neither.
+	if w.Cluster != nil && !w.hasKubeconfig() && (w.Context == nil || *w.Context == "") {
+		return nil, fmt.Errorf("the cluster's kubeconfig and context aren't recorded, " +
+			"run `pulumi up` to record them")
+	}
+
+	kubeconfig := w.kubeconfigPath()
+	if w.hasKubeconfig() && kubeconfig == "" {
+		f, err := ioutil.TempFile("", "kubeconfig-*.yaml")
+		if err != nil {
+			return nil, fmt.Errorf("writing kubeconfig: %v", err)
+		}
+		defer os.Remove(f.Name())
+		_, err = f.WriteString(*w.Kubeconfig)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, fmt.Errorf("writing kubeconfig: %v", err)
+		}
+		kubeconfig = f.Name()
+	}
+	return kubectl(ctx, append(args, w.kubectlFlags(kubeconfig)...)...)
+}
+
+// errorf returns an error about the workload, naming its cluster if it has one.
+func (w Workload) errorf(format string, args ...interface{}) error {
+	err := fmt.Errorf(format, args...)
+	if w.Cluster != nil {
+		return fmt.Errorf("cluster %q: %v", *w.Cluster, err)
+	}
+	return err
+}
+
+// lookKubectl returns the path of kubectl, which the restart and getStatus methods run.
+func lookKubectl() (string, error) {
+	path, err := exec.LookPath("kubectl")
+	if err != nil {
+		return "", fmt.Errorf("kubectl was not found on the PATH; the provider runs it to reach the "+
+			"application's clusters, so install it where the provider runs: %v", err)
+	}
+	return path, nil
+}
+
+// kubectl runs kubectl with args and returns what it writes to stdout.
+func kubectl(ctx context.Context, args ...string) ([]byte, error) {
+	path, err := lookKubectl()
+	if err != nil {
+		return nil, err
+	}
+	out, err := exec.CommandContext(ctx, path, args...).Output()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return out, err
+}
+
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// shellJoin joins args into a command line for a POSIX shell, quoting them where needed.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if shellSafe.MatchString(arg) {
+			quoted[i] = arg
+		} else {
+			quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'"'"'`) + "'"
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+// resourceModule rehydrates references to the provider's components, such as the `__self__`
+// argument of a method call, from their state.
+type resourceModule struct {
+	version semver.Version
+}
+
+func (m *resourceModule) Version() semver.Version {
+	return m.version
+}
+
+func (m *resourceModule) Construct(ctx *pulumi.Context, name, typ, urn string) (pulumi.Resource, error) {
+	var r pulumi.Resource
+	switch typ {
+	case "productionapp:index:Deployment":
+		r = &ProductionApp{}
+	default:
+		return nil, fmt.Errorf("unknown resource type: %s", typ)
+	}
+
+	err := ctx.RegisterResource(typ, name, nil, r, pulumi.URN_(urn))
+	return r, err
+}
+
+// registerResourceModule registers the provider's components with the Pulumi runtime, so that
+// references to them can be rehydrated.
+func registerResourceModule(providerName, version string) {
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		v = semver.Version{}
+	}
+	pulumi.RegisterResourceModule(providerName, "index", &resourceModule{version: v})
+}
@@ -0,0 +1,166 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func TestShellJoin(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"kubectl", "get", "pods"}, "kubectl get pods"},
+		{[]string{"--context", "arn:aws:eks:us-east-1:123:cluster/prod"}, "--context arn:aws:eks:us-east-1:123:cluster/prod"},
+		{[]string{"--context", "my context"}, "--context 'my context'"},
+		{[]string{"--context", "it's"}, `--context 'it'"'"'s'`},
+		{[]string{"$HOME", "a;b", "*"}, `'$HOME' 'a;b' '*'`},
+		{[]string{""}, "''"},
+	}
+	for _, tt := range tests {
+		if got := shellJoin(tt.args); got != tt.expected {
+			t.Errorf("shellJoin(%q): expected %s, got %s", tt.args, tt.expected, got)
+		}
+	}
+}
+
+func TestKubectlCommands(t *testing.T) {
+	contents, path, cluster, kubeContext := "apiVersion: v1\nkind: Config\n", "/home/me/.kube/prod", "east", "prod cluster"
+	tests := []struct {
+		name     string
+		workload Workload
+		expected map[string]interface{}
+	}{
+		{
+			name:     "ambient kubeconfig",
+			workload: Workload{Namespace: "app-1234", Deployment: "app-5678"},
+			expected: map[string]interface{}{
+				"getPods":       "kubectl get pods --namespace app-1234",
+				"logs":          "kubectl logs deployment/app-5678 --all-containers --follow --namespace app-1234",
+				"describe":      "kubectl describe deployment/app-5678 --namespace app-1234",
+				"rolloutStatus": "kubectl rollout status deployment/app-5678 --namespace app-1234",
+				"restart":       "kubectl rollout restart deployment/app-5678 --namespace app-1234",
+			},
+		},
+		{
+			name:     "context",
+			workload: Workload{Namespace: "app-1234", Deployment: "app-5678", Context: &kubeContext, Cluster: &cluster},
+			expected: map[string]interface{}{
+				"cluster":       "east",
+				"getPods":       "kubectl get pods --namespace app-1234 --context 'prod cluster'",
+				"logs":          "kubectl logs deployment/app-5678 --all-containers --follow --namespace app-1234 --context 'prod cluster'",
+				"describe":      "kubectl describe deployment/app-5678 --namespace app-1234 --context 'prod cluster'",
+				"rolloutStatus": "kubectl rollout status deployment/app-5678 --namespace app-1234 --context 'prod cluster'",
+				"restart":       "kubectl rollout restart deployment/app-5678 --namespace app-1234 --context 'prod cluster'",
+			},
+		},
+		{
+			name:     "kubeconfig contents",
+			workload: Workload{Namespace: "app-1234", Deployment: "app-5678", Kubeconfig: &contents, Cluster: &cluster},
+			expected: map[string]interface{}{
+				"cluster":       "east",
+				"kubeconfig":    "kubeconfig-east.yaml",
+				"getPods":       "kubectl get pods --namespace app-1234 --kubeconfig kubeconfig-east.yaml",
+				"logs":          "kubectl logs deployment/app-5678 --all-containers --follow --namespace app-1234 --kubeconfig kubeconfig-east.yaml",
+				"describe":      "kubectl describe deployment/app-5678 --namespace app-1234 --kubeconfig kubeconfig-east.yaml",
+				"rolloutStatus": "kubectl rollout status deployment/app-5678 --namespace app-1234 --kubeconfig kubeconfig-east.yaml",
+				"restart":       "kubectl rollout restart deployment/app-5678 --namespace app-1234 --kubeconfig kubeconfig-east.yaml",
+			},
+		},
+		{
+			name:     "kubeconfig path",
+			workload: Workload{Namespace: "app-1234", Deployment: "app-5678", Kubeconfig: &path},
+			expected: map[string]interface{}{
+				"kubeconfig":    path,
+				"getPods":       "kubectl get pods --namespace app-1234 --kubeconfig /home/me/.kube/prod",
+				"logs":          "kubectl logs deployment/app-5678 --all-containers --follow --namespace app-1234 --kubeconfig /home/me/.kube/prod",
+				"describe":      "kubectl describe deployment/app-5678 --namespace app-1234 --kubeconfig /home/me/.kube/prod",
+				"rolloutStatus": "kubectl rollout status deployment/app-5678 --namespace app-1234 --kubeconfig /home/me/.kube/prod",
+				"restart":       "kubectl rollout restart deployment/app-5678 --namespace app-1234 --kubeconfig /home/me/.kube/prod",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.workload.kubectlCommands(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// fakeKubectl puts a kubectl on the PATH that prints its arguments and the kubeconfig it is
+// given.
+func fakeKubectl(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake kubectl is a shell script")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho \"$@\"\nwhile [ $# -gt 0 ]; do [ \"$1\" = --kubeconfig ] && /bin/cat \"$2\"; shift; done\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "kubectl"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+}
+
+func TestWorkloadKubectl(t *testing.T) {
+	fakeKubectl(t)
+	contents, cluster, kubeContext := "apiVersion: v1\nkind: Config\n", "east", "prod"
+
+	out, err := Workload{Namespace: "app", Kubeconfig: &contents}.kubectl(context.Background(), "get", "pods")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.SplitN(string(out), "\n", 2)
+	args := strings.Fields(lines[0])
+	if len(args) != 6 || args[4] != "--kubeconfig" {
+		t.Fatalf("expected the kubeconfig to be passed to kubectl, got %q", lines[0])
+	}
+	if lines[1] != contents {
+		t.Errorf("expected kubectl to read the workload's kubeconfig, got %q", lines[1])
+	}
+	if _, err := os.Stat(args[5]); !os.IsNotExist(err) {
+		t.Errorf("expected the temporary kubeconfig %s to be removed", args[5])
+	}
+
+	out, err = Workload{Namespace: "app", Context: &kubeContext, Cluster: &cluster}.kubectl(context.Background(), "get", "pods")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(out)); got != "get pods --namespace app --context prod" {
+		t.Errorf("unexpected arguments %q", got)
+	}
+
+	_, err = Workload{Namespace: "app", Cluster: &cluster}.kubectl(context.Background(), "get", "pods")
+	if err == nil || !strings.Contains(err.Error(), "kubeconfig and context aren't recorded") {
+		t.Errorf("expected an error for a cluster without a kubeconfig or context, got %v", err)
+	}
+}
+
+func TestKubectlMissing(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	_, err := Workload{Namespace: "app"}.kubectl(context.Background(), "get", "pods")
+	if err == nil || !strings.Contains(err.Error(), "kubectl was not found on the PATH") {
+		t.Errorf("expected an error saying kubectl is missing, got %v", err)
+	}
+}
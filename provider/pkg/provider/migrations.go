@@ -0,0 +1,54 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// layoutVersion is the version of the layout of the Deployment component's children: their
+// names, types and parents. Changing the layout changes the children's URNs, so every change
+// must bump the version and add a migration that aliases the previous layout; otherwise
+// upgrading the provider replaces live resources.
+const layoutVersion = 2
+
+// layoutMigration describes the layout of one earlier version.
+type layoutMigration struct {
+	Version     int
+	Description string
+	// NamespaceAliases returns the aliases of the namespace named name in this version. The
+	// namespace's children inherit them.
+	NamespaceAliases func(name string) []pulumi.Alias
+}
+
+// layoutMigrations are the earlier layouts, oldest first.
+var layoutMigrations = []layoutMigration{
+	{
+		Version:     1,
+		Description: "The namespace had no parent, so it did not inherit the component's providers.",
+		NamespaceAliases: func(name string) []pulumi.Alias {
+			return []pulumi.Alias{{NoParent: pulumi.Bool(true)}}
+		},
+	},
+}
+
+// namespaceAliases returns the aliases of the namespace named name in every earlier layout.
+func namespaceAliases(name string) []pulumi.Alias {
+	var aliases []pulumi.Alias
+	for _, migration := range layoutMigrations {
+		aliases = append(aliases, migration.NamespaceAliases(name)...)
+	}
+	return aliases
+}
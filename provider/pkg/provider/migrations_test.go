@@ -0,0 +1,100 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"sync"
+	"testing"
+
+	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apps/v1"
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// TestLayoutVersions catches a layout change that bumps the version without aliasing the previous
+// layout.
+func TestLayoutVersions(t *testing.T) {
+	for i, migration := range layoutMigrations {
+		if migration.Version != i+1 {
+			t.Errorf("layoutMigrations[%d] describes version %d, expected version %d", i, migration.Version, i+1)
+		}
+	}
+	if got := len(layoutMigrations); got != layoutVersion-1 {
+		t.Errorf("layout version %d has %d migrations, expected one for every earlier version", layoutVersion, got)
+	}
+}
+
+// TestLayoutMigrations checks that the resources the component creates today are aliased to the
+// URNs they had in the first layout, so upgrading the provider doesn't replace them.
+func TestLayoutMigrations(t *testing.T) {
+	const serviceResourceType = "kubernetes:core/v1:Service"
+	types := []string{namespaceType, deploymentType, serviceResourceType}
+
+	// Version 1: the namespace had no parent and the other resources were its children.
+	var mu sync.Mutex
+	baseline := map[string]string{}
+	record := func(typ string, r pulumi.Resource) {
+		r.URN().ApplyT(func(urn pulumi.URN) error {
+			mu.Lock()
+			defer mu.Unlock()
+			baseline[typ] = string(urn)
+			return nil
+		})
+	}
+	runMocked(t, newMocks(), func(ctx *pulumi.Context) error {
+		namespace, err := corev1.NewNamespace(ctx, "app", &corev1.NamespaceArgs{})
+		if err != nil {
+			return err
+		}
+		record(namespaceType, namespace)
+		deployment, err := appsv1.NewDeployment(ctx, "app", &appsv1.DeploymentArgs{}, pulumi.Parent(namespace))
+		if err != nil {
+			return err
+		}
+		record(deploymentType, deployment)
+		service, err := corev1.NewService(ctx, "app", &corev1.ServiceArgs{}, pulumi.Parent(namespace))
+		if err != nil {
+			return err
+		}
+		record(serviceResourceType, service)
+		return nil
+	})
+
+	m := newMocks()
+	runMocked(t, m, func(ctx *pulumi.Context) error {
+		_, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+			Image: pulumi.String("nginx"),
+			Port:  pulumi.Int(80),
+		})
+		return err
+	})
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, typ := range types {
+		old, ok := baseline[typ]
+		if !ok {
+			t.Fatalf("the baseline %s's URN wasn't resolved", typ)
+		}
+		aliases := m.resource(t, typ, "app").RegisterRPC.GetAliases()
+		found := false
+		for _, alias := range aliases {
+			found = found || alias == old
+		}
+		if !found {
+			t.Errorf("%s: expected an alias to %s, got %v", typ, old, aliases)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// mocks records the resources a program registers, so tests can inspect their inputs and
+// options. Services are given a load balancer, as the Kubernetes provider would once it is
+// ready.
+type mocks struct {
+	mu        sync.Mutex
+	resources map[string]pulumi.MockResourceArgs
+}
+
+func newMocks() *mocks {
+	return &mocks{resources: map[string]pulumi.MockResourceArgs{}}
+}
+
+func (m *mocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	m.mu.Lock()
+	m.resources[args.TypeToken+"::"+args.Name] = args
+	m.mu.Unlock()
+
+	outputs := args.Inputs.Copy()
+	if args.TypeToken == "kubernetes:core/v1:Service" {
+		outputs["status"] = resource.NewPropertyValue(map[string]interface{}{
+			"loadBalancer": map[string]interface{}{
+				"ingress": []interface{}{map[string]interface{}{"ip": "192.0.2.1"}},
+			},
+		})
+	}
+	return args.Name + "_id", outputs, nil
+}
+
+func (m *mocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return args.Args, nil
+}
+
+// resource returns the resource of type typ named name, failing the test if it wasn't
+// registered.
+func (m *mocks) resource(t *testing.T, typ, name string) pulumi.MockResourceArgs {
+	t.Helper()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	r, ok := m.resources[typ+"::"+name]
+	if !ok {
+		t.Fatalf("no %s named %q was registered", typ, name)
+	}
+	return r
+}
+
+// registered reports whether a resource of type typ named name was registered.
+func (m *mocks) registered(typ, name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.resources[typ+"::"+name]
+	return ok
+}
+
+// runMocked runs program against m.
+func runMocked(t *testing.T, m *mocks, program pulumi.RunFunc) {
+	t.Helper()
+	if err := pulumi.RunErr(program, pulumi.WithMocks("project", "stack", m)); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// maxNameLength leaves room for the 8 characters Pulumi appends to the names of the
+	// Namespace, Deployment and Service, within the 63 characters Kubernetes allows.
+	maxNameLength = 63 - 8
+	// maxPreDeployNameLength leaves room for the pre-deploy Job's longer "-pre-deploy-<hash>"
+	// suffix, so it only applies when a pre-deploy Job is set.
+	maxPreDeployNameLength = 63 - len("-pre-deploy-") - 8
+)
+
+// The name is used for the container and, with a suffix, for the Service, so it must be a
+// DNS-1035 label: the stricter of the rules for the resources it names.
+var validName = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// nameLimit returns the longest name allowed for an application that has a pre-deploy Job if
+// preDeployJob, and the reason for the limit.
+func nameLimit(preDeployJob bool) (int, string) {
+	if preDeployJob {
+		return maxPreDeployNameLength, fmt.Sprintf("%d characters are reserved for the pre-deploy Job's "+
+			"\"-pre-deploy-<hash>\" suffix", 63-maxPreDeployNameLength)
+	}
+	return maxNameLength, fmt.Sprintf("%d characters are reserved for the suffix Pulumi adds to "+
+		"the names of the resources it creates", 63-maxNameLength)
+}
+
+// validateName returns an error describing how to fix name if Kubernetes would reject it.
+// preDeployJob tells whether the application has a pre-deploy Job, whose name is longer.
+func validateName(name string, preDeployJob bool) error {
+	if limit, reason := nameLimit(preDeployJob); len(name) > limit {
+		return fmt.Errorf("name %q is %d characters long, it must be at most %d characters "+
+			"because Kubernetes names are limited to 63 and %s; "+
+			"shorten it or set sanitizeName to truncate it", name, len(name), limit, reason)
+	}
+	if !validName.MatchString(name) {
+		return fmt.Errorf("name %q must consist of lower case alphanumeric characters or '-', "+
+			"start with a letter and end with an alphanumeric character; "+
+			"rename it or set sanitizeName to derive a valid name, such as %q", name, sanitizeName(name, preDeployJob))
+	}
+	return nil
+}
+
+// sanitizeName derives a valid name from name: it is lowercased, runs of invalid characters
+// become '-', and names that are too long are truncated and given a hash of the original
+// name, so distinct names stay distinct. preDeployJob is as for validateName.
+func sanitizeName(name string, preDeployJob bool) string {
+	sanitized := invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	sanitized = strings.TrimLeft(sanitized, "-0123456789")
+	if sanitized == "" {
+		sanitized = "app"
+	}
+
+	if limit, _ := nameLimit(preDeployJob); len(sanitized) > limit {
+		hash := sha256.Sum256([]byte(name))
+		suffix := hex.EncodeToString(hash[:])[:8]
+		sanitized = strings.TrimRight(sanitized[:limit-len(suffix)-1], "-") + "-" + suffix
+	}
+	return strings.TrimRight(sanitized, "-")
+}
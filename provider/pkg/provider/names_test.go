@@ -0,0 +1,94 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		name         string
+		preDeployJob bool
+		err          string
+	}{
+		{name: "app"},
+		{name: "my-app-2"},
+		{name: strings.Repeat("a", 55)},
+		{name: strings.Repeat("a", 56), err: "is 56 characters long, it must be at most 55 characters"},
+		{name: strings.Repeat("a", 43), preDeployJob: true},
+		{name: strings.Repeat("a", 44), preDeployJob: true,
+			err: `it must be at most 43 characters because Kubernetes names are limited to 63 and 20 characters are reserved for the pre-deploy Job's "-pre-deploy-<hash>" suffix`},
+		{name: "123", err: `start with a letter and end with an alphanumeric character; rename it or set sanitizeName to derive a valid name, such as "app"`},
+		{name: "1app", err: `such as "app"`},
+		{name: "My_App", err: `such as "my-app"`},
+		{name: "app-", err: `such as "app"`},
+		{name: "app.web", err: `such as "app-web"`},
+	}
+	for _, tt := range tests {
+		err := validateName(tt.name, tt.preDeployJob)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("validateName(%q): unexpected error: %v", tt.name, err)
+		case tt.err != "" && err == nil:
+			t.Errorf("validateName(%q): expected an error containing %q", tt.name, tt.err)
+		case tt.err != "" && !strings.Contains(err.Error(), tt.err):
+			t.Errorf("validateName(%q): expected an error containing %q, got %q", tt.name, tt.err, err)
+		}
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	hash := func(name string) string {
+		sum := sha256.Sum256([]byte(name))
+		return hex.EncodeToString(sum[:])[:8]
+	}
+	long := strings.Repeat("a", 70)
+	tests := []struct {
+		name         string
+		preDeployJob bool
+		expected     string
+	}{
+		{name: "app", expected: "app"},
+		{name: "My_App", expected: "my-app"},
+		{name: "my app!!v2", expected: "my-app-v2"},
+		{name: "123", expected: "app"},
+		{name: "42-api", expected: "api"},
+		{name: "--app--", expected: "app"},
+		{name: "___", expected: "app"},
+		{name: strings.Repeat("a", 55), expected: strings.Repeat("a", 55)},
+		{name: long, expected: strings.Repeat("a", 46) + "-" + hash(long)},
+		{name: long, preDeployJob: true, expected: strings.Repeat("a", 34) + "-" + hash(long)},
+		{name: strings.Repeat("a", 45) + "-" + strings.Repeat("b", 20),
+			expected: strings.Repeat("a", 45) + "-" + hash(strings.Repeat("a", 45)+"-"+strings.Repeat("b", 20))},
+	}
+	for _, tt := range tests {
+		got := sanitizeName(tt.name, tt.preDeployJob)
+		if got != tt.expected {
+			t.Errorf("sanitizeName(%q, %v): expected %q, got %q", tt.name, tt.preDeployJob, tt.expected, got)
+		}
+		if err := validateName(got, tt.preDeployJob); err != nil {
+			t.Errorf("sanitizeName(%q, %v) is invalid: %v", tt.name, tt.preDeployJob, err)
+		}
+	}
+
+	// Long names that share a prefix stay distinct.
+	if a, b := sanitizeName(long+"-a", false), sanitizeName(long+"-b", false); a == b {
+		t.Errorf("expected distinct names, got %q for both", a)
+	}
+}
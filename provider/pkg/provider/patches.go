@@ -0,0 +1,320 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	mergePatch     = "merge"
+	strategicPatch = "strategic"
+)
+
+// patchableKinds are the kinds of resource the Deployment component creates.
+var patchableKinds = []string{
+	"Deployment", "HorizontalPodAutoscaler", "Ingress", "Job", "LimitRange", "Namespace", "PodDisruptionBudget",
+	"ResourceQuota", "Secret", "Service",
+}
+
+// strategicMergeKeys are the fields that identify list elements in a strategic merge patch,
+// in order of preference. They approximate the patch merge keys Kubernetes uses for the lists
+// the component creates, such as containers, env, volumes and ports.
+var strategicMergeKeys = []string{"name", "mountPath", "containerPort", "port"}
+
+// Patch modifies the arguments of every resource of one kind before it is registered.
+type Patch struct {
+	// How the patch is applied: `strategic` (the default) merges lists of named elements such as
+	// containers and env by key, `merge` applies a JSON merge patch that replaces lists.
+	Type *string `pulumi:"type"`
+	// The patch, in the shape of the Kubernetes resource. `null` removes a field.
+	Patch map[string]interface{} `pulumi:"patch" schema:"required,ref=pulumi.json#/Any"`
+}
+
+// patchProblems returns a problem, naming the offending path, for every invalid patch.
+func patchProblems(patches map[string]Patch) []string {
+	var problems []string
+	for _, kind := range sortedKeys(patches) {
+		path := fmt.Sprintf("patches.%s", kind)
+		if !contains(patchableKinds, kind) {
+			problems = append(problems, fmt.Sprintf("%s: unknown kind, must be one of %s",
+				path, strings.Join(patchableKinds, ", ")))
+			continue
+		}
+		patch := patches[kind]
+		if t := patchType(patch); t != mergePatch && t != strategicPatch {
+			problems = append(problems, fmt.Sprintf("%s.type: must be %q or %q, got %q",
+				path, mergePatch, strategicPatch, t))
+		}
+		if patch.Patch == nil {
+			problems = append(problems, fmt.Sprintf("%s.patch: must be an object", path))
+			continue
+		}
+		problems = append(problems, validatePatchValue(path+".patch", patch.Patch)...)
+	}
+	return problems
+}
+
+// validatePatchValue rejects patch directives, which are not supported.
+func validatePatchValue(path string, v interface{}) []string {
+	var problems []string
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			if strings.HasPrefix(k, "$") {
+				problems = append(problems, fmt.Sprintf("%s.%s: patch directives are not supported", path, k))
+				continue
+			}
+			problems = append(problems, validatePatchValue(path+"."+k, v[k])...)
+		}
+	case []interface{}:
+		for i, e := range v {
+			problems = append(problems, validatePatchValue(fmt.Sprintf("%s[%d]", path, i), e)...)
+		}
+	}
+	return problems
+}
+
+// patchTransformation returns a transformation that applies patches to the resources of the
+// matching kind. Only the top-level properties a patch mentions are patched, and they are only
+// known once their inputs are, so they show fewer details during previews. The other
+// properties are passed through unchanged.
+func patchTransformation(patches map[string]Patch) pulumi.ResourceTransformation {
+	return func(args *pulumi.ResourceTransformationArgs) *pulumi.ResourceTransformationResult {
+		kind := args.Type[strings.LastIndex(args.Type, ":")+1:]
+		patch, ok := patches[kind]
+		if !ok {
+			return nil
+		}
+		strategic := patchType(patch) == strategicPatch
+
+		props := pulumi.Map{}
+		inputs := propertyInputs(args.Props)
+		for k, input := range inputs {
+			if _, patched := patch.Patch[k]; !patched {
+				props[k] = input
+			}
+		}
+		for k, v := range patch.Patch {
+			if v == nil {
+				continue
+			}
+			k, v := k, v
+			input, ok := inputs[k]
+			if !ok {
+				props[k] = pulumi.Any(applyPatch(nil, v, strategic))
+				continue
+			}
+			props[k] = pulumi.ToOutput(input).ApplyT(func(original interface{}) interface{} {
+				return applyPatch(toPropertyValue(reflect.ValueOf(original)), v, strategic)
+			})
+		}
+
+		return &pulumi.ResourceTransformationResult{
+			Props: props,
+			Opts:  args.Opts,
+		}
+	}
+}
+
+// applyPatch applies patch to original. Objects are merged recursively and null removes a
+// field. In a strategic merge, lists whose elements share a merge key are merged element by
+// element; all other lists are replaced.
+func applyPatch(original, patch interface{}, strategic bool) interface{} {
+	switch p := patch.(type) {
+	case map[string]interface{}:
+		o, ok := original.(map[string]interface{})
+		if !ok {
+			o = map[string]interface{}{}
+		}
+		result := map[string]interface{}{}
+		for k, v := range o {
+			result[k] = v
+		}
+		for k, v := range p {
+			if v == nil {
+				delete(result, k)
+				continue
+			}
+			result[k] = applyPatch(result[k], v, strategic)
+		}
+		return result
+	case []interface{}:
+		if o, ok := original.([]interface{}); ok && strategic {
+			if key := mergeKey(o, p); key != "" {
+				return mergeList(o, p, key, strategic)
+			}
+		}
+		return p
+	default:
+		return p
+	}
+}
+
+// mergeKey returns the first strategic merge key shared by every element of both lists.
+func mergeKey(original, patch []interface{}) string {
+	elements := append(append([]interface{}{}, original...), patch...)
+	for _, key := range strategicMergeKeys {
+		shared := true
+		for _, e := range elements {
+			m, ok := e.(map[string]interface{})
+			if !ok || m[key] == nil {
+				shared = false
+				break
+			}
+		}
+		if shared {
+			return key
+		}
+	}
+	return ""
+}
+
+func mergeList(original, patch []interface{}, key string, strategic bool) []interface{} {
+	result := append([]interface{}{}, original...)
+	for _, p := range patch {
+		pm := p.(map[string]interface{})
+		merged := false
+		for i, o := range result {
+			if fmt.Sprint(o.(map[string]interface{})[key]) == fmt.Sprint(pm[key]) {
+				result[i] = applyPatch(o, p, strategic)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// toPropertyValue converts resolved resource arguments to plain maps and lists keyed by their
+// `pulumi:` tags, omitting unset values. Awaiting an input resolves unset lists and maps to
+// empty ones, so those are omitted as well.
+func toPropertyValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return toPropertyValue(v.Elem())
+	case reflect.Struct:
+		result := map[string]interface{}{}
+		for i := 0; i < v.NumField(); i++ {
+			tag := v.Type().Field(i).Tag.Get("pulumi")
+			if tag == "" || !v.Field(i).CanInterface() {
+				continue
+			}
+			if e := toPropertyValue(v.Field(i)); e != nil {
+				result[tag] = e
+			}
+		}
+		return result
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return nil
+		}
+		result := make([]interface{}, v.Len())
+		for i := range result {
+			result[i] = toPropertyValue(v.Index(i))
+		}
+		return result
+	case reflect.Map:
+		if v.Len() == 0 {
+			return nil
+		}
+		result := map[string]interface{}{}
+		for _, k := range v.MapKeys() {
+			if e := toPropertyValue(v.MapIndex(k)); e != nil {
+				result[k.String()] = e
+			}
+		}
+		return result
+	default:
+		return v.Interface()
+	}
+}
+
+// propertyInputs returns the set fields of the given resource arguments, keyed by the
+// `pulumi:` tags of the matching fields of their element type.
+func propertyInputs(props pulumi.Input) map[string]pulumi.Input {
+	inputs := map[string]pulumi.Input{}
+	if props == nil {
+		return inputs
+	}
+	t := props.ElementType()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	v := reflect.ValueOf(props)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return inputs
+		}
+		v = v.Elem()
+	}
+	if t.Kind() != reflect.Struct || v.Kind() != reflect.Struct {
+		return inputs
+	}
+	for i := 0; i < v.NumField(); i++ {
+		element, ok := t.FieldByName(v.Type().Field(i).Name)
+		tag := element.Tag.Get("pulumi")
+		if !ok || tag == "" || !v.Field(i).CanInterface() {
+			continue
+		}
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+			if f.IsNil() {
+				continue
+			}
+		}
+		if input, ok := f.Interface().(pulumi.Input); ok {
+			inputs[tag] = input
+		}
+	}
+	return inputs
+}
+
+func patchType(patch Patch) string {
+	if patch.Type == nil {
+		return strategicPatch
+	}
+	return *patch.Type
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type object = map[string]interface{}
+type list = []interface{}
+
+func TestApplyPatch(t *testing.T) {
+	containers := list{
+		object{"name": "app", "image": "nginx", "env": list{object{"name": "A", "value": "1"}}},
+		object{"name": "sidecar", "image": "envoy"},
+	}
+	tests := []struct {
+		name      string
+		original  interface{}
+		patch     interface{}
+		strategic bool
+		expected  interface{}
+	}{
+		{
+			name:     "objects merge recursively",
+			original: object{"metadata": object{"labels": object{"a": "1"}, "name": "app"}},
+			patch:    object{"metadata": object{"labels": object{"b": "2"}}},
+			expected: object{"metadata": object{"labels": object{"a": "1", "b": "2"}, "name": "app"}},
+		},
+		{
+			name:     "null deletes a field",
+			original: object{"metadata": object{"labels": object{"a": "1", "b": "2"}}},
+			patch:    object{"metadata": object{"labels": object{"a": nil}}},
+			expected: object{"metadata": object{"labels": object{"b": "2"}}},
+		},
+		{
+			name:     "null deletes a missing field",
+			original: object{"a": "1"},
+			patch:    object{"b": nil},
+			expected: object{"a": "1"},
+		},
+		{
+			name:     "merge patches replace lists",
+			original: object{"containers": containers},
+			patch:    object{"containers": list{object{"name": "app", "image": "nginx:2"}}},
+			expected: object{"containers": list{object{"name": "app", "image": "nginx:2"}}},
+		},
+		{
+			name:      "strategic patches merge lists by key",
+			original:  object{"containers": containers},
+			patch:     object{"containers": list{object{"name": "app", "image": "nginx:2"}}},
+			strategic: true,
+			expected: object{"containers": list{
+				object{"name": "app", "image": "nginx:2", "env": list{object{"name": "A", "value": "1"}}},
+				object{"name": "sidecar", "image": "envoy"},
+			}},
+		},
+		{
+			name:     "strategic patches merge nested lists",
+			original: object{"containers": containers},
+			patch: object{"containers": list{
+				object{"name": "app", "env": list{object{"name": "A", "value": "2"}, object{"name": "B", "value": "3"}}},
+			}},
+			strategic: true,
+			expected: object{"containers": list{
+				object{"name": "app", "image": "nginx", "env": list{
+					object{"name": "A", "value": "2"}, object{"name": "B", "value": "3"},
+				}},
+				object{"name": "sidecar", "image": "envoy"},
+			}},
+		},
+		{
+			name:      "strategic patches append new elements",
+			original:  object{"ports": list{object{"port": 80.0}}},
+			patch:     object{"ports": list{object{"port": 443.0}}},
+			strategic: true,
+			expected:  object{"ports": list{object{"port": 80.0}, object{"port": 443.0}}},
+		},
+		{
+			name:      "strategic patches replace lists without a merge key",
+			original:  object{"args": list{"--a"}},
+			patch:     object{"args": list{"--b"}},
+			strategic: true,
+			expected:  object{"args": list{"--b"}},
+		},
+		{
+			name:     "patches replace scalars",
+			original: object{"replicas": 3.0},
+			patch:    object{"replicas": 5.0},
+			expected: object{"replicas": 5.0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := applyPatch(tt.original, tt.patch, tt.strategic); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMergeKey(t *testing.T) {
+	tests := []struct {
+		original, patch list
+		expected        string
+	}{
+		{list{object{"name": "a"}}, list{object{"name": "b"}}, "name"},
+		{list{object{"mountPath": "/a", "name": "a"}}, list{object{"mountPath": "/b"}}, "mountPath"},
+		{list{object{"containerPort": 80.0}}, list{object{"containerPort": 81.0}}, "containerPort"},
+		{list{object{"name": "a"}}, list{object{"image": "b"}}, ""},
+		{list{"a"}, list{"b"}, ""},
+	}
+	for _, tt := range tests {
+		if got := mergeKey(tt.original, tt.patch); got != tt.expected {
+			t.Errorf("mergeKey(%v, %v): expected %q, got %q", tt.original, tt.patch, tt.expected, got)
+		}
+	}
+}
+
+func TestPatchProblems(t *testing.T) {
+	tests := []struct {
+		name     string
+		patches  map[string]Patch
+		expected []string
+	}{
+		{
+			name: "valid",
+			patches: map[string]Patch{
+				"Deployment": {Patch: object{"spec": object{"replicas": 2.0}}},
+				"Service":    {Type: stringPtr(mergePatch), Patch: object{"metadata": object{"labels": nil}}},
+			},
+		},
+		{
+			name:     "unknown kind",
+			patches:  map[string]Patch{"Pod": {Patch: object{}}},
+			expected: []string{"patches.Pod: unknown kind, must be one of Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret, Service"},
+		},
+		{
+			name:     "unknown type",
+			patches:  map[string]Patch{"Service": {Type: stringPtr("json"), Patch: object{}}},
+			expected: []string{`patches.Service.type: must be "merge" or "strategic", got "json"`},
+		},
+		{
+			name:     "missing patch",
+			patches:  map[string]Patch{"Service": {}},
+			expected: []string{"patches.Service.patch: must be an object"},
+		},
+		{
+			name: "directives",
+			patches: map[string]Patch{"Deployment": {Patch: object{
+				"$patch": "replace",
+				"spec": object{"template": object{"spec": object{"containers": list{
+					object{"name": "app", "$setElementOrder/env": list{}},
+				}}}},
+			}}},
+			expected: []string{
+				"patches.Deployment.patch.$patch: patch directives are not supported",
+				"patches.Deployment.patch.spec.template.spec.containers[0].$setElementOrder/env: patch directives are not supported",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := patchProblems(tt.patches); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestPatchTransformation checks that a patch only changes the properties it mentions: the
+// Secret's data stays secret and its patched metadata doesn't become secret.
+func TestPatchTransformation(t *testing.T) {
+	m := newMocks()
+	runMocked(t, m, func(ctx *pulumi.Context) error {
+		_, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+			Image:    pulumi.String("nginx"),
+			Port:     pulumi.Int(80),
+			Database: DatabaseConnectionArgs{ConnectionString: pulumi.String("postgres://db")},
+			Patches: map[string]Patch{
+				"Secret":     {Patch: object{"metadata": object{"labels": object{"team": "web"}}}},
+				"Deployment": {Patch: object{"spec": object{"replicas": 1.0, "revisionHistoryLimit": 2.0}}},
+			},
+		})
+		return err
+	})
+
+	secret := m.resource(t, "kubernetes:core/v1:Secret", "app-database").Inputs
+	if metadata := secret["metadata"]; metadata.ContainsSecrets() {
+		t.Errorf("expected the patched metadata not to be secret, got %v", metadata)
+	} else if got := field(metadata.Mappable(), "labels", "team"); got != "web" {
+		t.Errorf("expected the patched label, got %v", got)
+	}
+	if data := secret["stringData"]; !data.ContainsSecrets() {
+		t.Errorf("expected the secret's data to stay secret, got %v", data)
+	}
+
+	deployment := m.resource(t, deploymentType, "app").Inputs.Mappable()
+	if got := field(deployment, "spec", "replicas"); got != 1.0 {
+		t.Errorf("expected the patched replicas, got %v", got)
+	}
+	if got := field(deployment, "spec", "revisionHistoryLimit"); got != 2.0 {
+		t.Errorf("expected the added revisionHistoryLimit, got %v", got)
+	}
+	if got := field(deployment, "spec", "template", "spec", "containers", 0, "image"); got != "nginx" {
+		t.Errorf("expected the rest of the spec to be kept, got image %v", got)
+	}
+	if got := field(deployment, "metadata", "labels"); got == nil {
+		t.Errorf("expected the unpatched metadata to be kept")
+	}
+}
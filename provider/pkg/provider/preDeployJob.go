@@ -0,0 +1,118 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	batchv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/batch/v1"
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// PreDeployJob is a one-shot Job, such as a database migration, that must succeed before
+// the application is rolled out.
+type PreDeployJob struct {
+	// The image to run. Defaults to the application image.
+	Image *string `pulumi:"image"`
+	// The command to run.
+	Command []string `pulumi:"command"`
+	// Environment variables for the job, in addition to those of the application.
+	Env map[string]string `pulumi:"env"`
+	// How long the job may run before it is considered failed.
+	TimeoutSeconds *int `pulumi:"timeoutSeconds"`
+}
+
+// newPreDeployJob creates the Job described by job in the application's namespace. The Job's
+// name includes a hash of its image, so a new Job runs whenever the image changes. The job
+// inherits the environment and pod settings of the application.
+func newPreDeployJob(ctx *pulumi.Context, name string, job *PreDeployJob, image pulumi.StringInput,
+	pod podSettings, namespace *corev1.Namespace, metadata objectMetadata,
+	opts ...pulumi.ResourceOption) (*batchv1.Job, error) {
+	// The job's pods must not match the application's selectors, or the Service would route
+	// traffic to them.
+	labels := pulumi.StringMap{}
+	for k, v := range metadata.labels {
+		if _, ok := metadata.selectorLabels[k]; !ok {
+			labels[k] = v
+		}
+	}
+	labels["app.kubernetes.io/component"] = pulumi.String("pre-deploy")
+	labels[instanceNameLabel] = pulumi.String(name)
+
+	if job.Image != nil {
+		image = pulumi.String(*job.Image)
+	}
+
+	jobEnv := append(corev1.EnvVarArray{}, pod.env...)
+	keys := make([]string, 0, len(job.Env))
+	for k := range job.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		jobEnv = append(jobEnv, &corev1.EnvVarArgs{
+			Name:  pulumi.String(k),
+			Value: pulumi.String(job.Env[k]),
+		})
+	}
+
+	jobName := image.ToStringOutput().ApplyT(func(image string) string {
+		hash := sha256.Sum256([]byte(image))
+		return fmt.Sprintf("%s-pre-deploy-%s", name, hex.EncodeToString(hash[:])[:8])
+	}).(pulumi.StringOutput)
+
+	spec := &batchv1.JobSpecArgs{
+		Template: &corev1.PodTemplateSpecArgs{
+			Metadata: &metav1.ObjectMetaArgs{
+				Labels:      labels,
+				Annotations: pod.annotations,
+			},
+			Spec: &corev1.PodSpecArgs{
+				RestartPolicy:    pulumi.String("Never"),
+				ImagePullSecrets: pod.imagePullSecrets,
+				Containers: &corev1.ContainerArray{
+					&corev1.ContainerArgs{
+						Name:    pulumi.String("pre-deploy"),
+						Image:   image,
+						Command: pulumi.ToStringArray(job.Command),
+						Env:     jobEnv,
+					},
+				},
+			},
+		},
+	}
+
+	opts = append(opts, pulumi.Parent(namespace))
+	if job.TimeoutSeconds != nil {
+		spec.ActiveDeadlineSeconds = pulumi.Int(*job.TimeoutSeconds)
+		timeout := fmt.Sprintf("%ds", *job.TimeoutSeconds)
+		opts = append(opts, pulumi.Timeouts(&pulumi.CustomTimeouts{Create: timeout, Update: timeout}))
+	}
+
+	return batchv1.NewJob(ctx, fmt.Sprintf("%s-pre-deploy", name), &batchv1.JobArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Name:        jobName,
+			Namespace:   namespace.Metadata.Name().Elem(),
+			Labels:      labels,
+			Annotations: metadata.annotations,
+		},
+		Spec: spec,
+	}, opts...)
+}
@@ -0,0 +1,89 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Probe is an HTTP check the kubelet makes against the application container.
+type Probe struct {
+	// The path to request. Defaults to `/`.
+	Path *string `pulumi:"path"`
+	// The container port to request. Defaults to the application's port.
+	Port *int `pulumi:"port"`
+	// How long to wait after the container starts before the first check.
+	InitialDelaySeconds *int `pulumi:"initialDelaySeconds"`
+	// How often to check. Defaults to 10 seconds.
+	PeriodSeconds *int `pulumi:"periodSeconds"`
+	// How long a check may take. Defaults to 1 second.
+	TimeoutSeconds *int `pulumi:"timeoutSeconds"`
+	// How many consecutive checks must fail before the probe fails. Defaults to 3.
+	FailureThreshold *int `pulumi:"failureThreshold"`
+}
+
+// validate checks the probe's settings. input names the probe in errors.
+func (p *Probe) validate(input string) error {
+	if p.Path != nil && !strings.HasPrefix(*p.Path, "/") {
+		return fmt.Errorf("%s.path must start with '/', got %q", input, *p.Path)
+	}
+	if p.Port != nil && (*p.Port < 1 || *p.Port > 65535) {
+		return fmt.Errorf("%s.port must be between 1 and 65535, got %d", input, *p.Port)
+	}
+	if p.InitialDelaySeconds != nil && *p.InitialDelaySeconds < 0 {
+		return fmt.Errorf("%s.initialDelaySeconds must not be negative, got %d", input, *p.InitialDelaySeconds)
+	}
+	// A slice rather than a map, so the first invalid field is always the one reported.
+	for _, f := range []struct {
+		name  string
+		value *int
+	}{
+		{"periodSeconds", p.PeriodSeconds},
+		{"timeoutSeconds", p.TimeoutSeconds},
+		{"failureThreshold", p.FailureThreshold},
+	} {
+		if f.value != nil && *f.value < 1 {
+			return fmt.Errorf("%s.%s must be at least 1, got %d", input, f.name, *f.value)
+		}
+	}
+	return nil
+}
+
+// toProbe returns the container probe, which requests the probe's path from the container's
+// port unless the probe sets another.
+func (p *Probe) toProbe(containerPort pulumi.IntInput) *corev1.ProbeArgs {
+	path := "/"
+	if p.Path != nil {
+		path = *p.Path
+	}
+	var port pulumi.Input = containerPort
+	if p.Port != nil {
+		port = pulumi.Int(*p.Port)
+	}
+	return &corev1.ProbeArgs{
+		HttpGet: &corev1.HTTPGetActionArgs{
+			Path: pulumi.String(path),
+			Port: port,
+		},
+		InitialDelaySeconds: optionalInt(p.InitialDelaySeconds),
+		PeriodSeconds:       optionalInt(p.PeriodSeconds),
+		TimeoutSeconds:      optionalInt(p.TimeoutSeconds),
+		FailureThreshold:    optionalInt(p.FailureThreshold),
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import "testing"
+
+func TestProbeValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		probe Probe
+		err   string
+	}{
+		{name: "defaults"},
+		{name: "valid", probe: Probe{Path: stringPtr("/health"), Port: intPtr(8080), InitialDelaySeconds: intPtr(0),
+			PeriodSeconds: intPtr(5), TimeoutSeconds: intPtr(1), FailureThreshold: intPtr(3)}},
+		{name: "relative path", probe: Probe{Path: stringPtr("health")},
+			err: `readinessProbe.path must start with '/', got "health"`},
+		{name: "port", probe: Probe{Port: intPtr(70000)},
+			err: "readinessProbe.port must be between 1 and 65535, got 70000"},
+		{name: "initial delay", probe: Probe{InitialDelaySeconds: intPtr(-1)},
+			err: "readinessProbe.initialDelaySeconds must not be negative, got -1"},
+		{name: "failure threshold", probe: Probe{FailureThreshold: intPtr(0)},
+			err: "readinessProbe.failureThreshold must be at least 1, got 0"},
+		// The first invalid field is reported, whichever it is.
+		{name: "several", probe: Probe{PeriodSeconds: intPtr(0), TimeoutSeconds: intPtr(0), FailureThreshold: intPtr(0)},
+			err: "readinessProbe.periodSeconds must be at least 1, got 0"},
+		{name: "timeout and threshold", probe: Probe{TimeoutSeconds: intPtr(0), FailureThreshold: intPtr(-1)},
+			err: "readinessProbe.timeoutSeconds must be at least 1, got 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Repeat to catch a reported field that varies from run to run.
+			for i := 0; i < 20; i++ {
+				err := tt.probe.validate("readinessProbe")
+				switch {
+				case tt.err == "" && err != nil:
+					t.Fatalf("unexpected error: %v", err)
+				case tt.err != "" && (err == nil || err.Error() != tt.err):
+					t.Fatalf("expected %q, got %v", tt.err, err)
+				}
+			}
+		})
+	}
+}
@@ -17,24 +17,175 @@ package provider
 import (
 	"fmt"
 
+	"github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes"
 	appsv1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/apps/v1"
 	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
 	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
-	// "github.com/mrz1836/go-sanitize"
 )
 
 // The set of arguments for creating a ProductionApp component resource.
 type ProductionAppArgs struct {
-	Image pulumi.StringInput `pulumi:"image"`
-	Port  pulumi.IntInput    `pulumi:"port"`
+	// The image to deploy in your production application.
+	Image pulumi.StringInput `pulumi:"image" schema:"required"`
+	// The port your container listens on.
+	Port pulumi.IntInput `pulumi:"port" schema:"required"`
+	// A database to connect the application to. The connection string is injected as the
+	// `DATABASE_URL` environment variable from a Kubernetes secret.
+	Database DatabaseConnectionInput `pulumi:"database"`
+	// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment
+	// variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret.
+	Cache CacheConnectionInput `pulumi:"cache"`
+	// A one-shot job, such as a database migration, that must complete successfully before the
+	// application is rolled out. A new job runs whenever the image changes.
+	PreDeployJob *PreDeployJob `pulumi:"preDeployJob"`
+	// How the application's pods are stopped during rollouts. Because the application sits behind a
+	// load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint
+	// deregistration can propagate.
+	Shutdown *Shutdown `pulumi:"shutdown"`
+	// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a
+	// LimitRange that gives containers default requests and limits.
+	Quota *Quota `pulumi:"quota"`
+
+	// Credentials for pulling the application image from a private registry. Either `server`,
+	// `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in
+	// the application namespace, or the name of an existing secret.
+	RegistryCredentials RegistryCredentialsInput `pulumi:"registryCredentials"`
+
+	// Overrides the entrypoint of the application image.
+	Command pulumi.StringArrayInput `pulumi:"command"`
+	// Overrides the arguments of the application image.
+	Args pulumi.StringArrayInput `pulumi:"args"`
+	// The working directory of the application container.
+	WorkingDir pulumi.StringInput `pulumi:"workingDir"`
+	// Volumes available to the application container.
+	Volumes []Volume `pulumi:"volumes"`
+	// Where to mount `volumes` in the application container.
+	VolumeMounts []VolumeMount `pulumi:"volumeMounts"`
+	// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by
+	// default.
+	AllowHostPath *bool `pulumi:"allowHostPath" schema:"default=false"`
+
+	// Labels added to every resource the component creates. The labels that select the
+	// application's pods cannot be overridden.
+	Labels map[string]string `pulumi:"labels"`
+	// Annotations added to every resource the component creates.
+	Annotations map[string]string `pulumi:"annotations"`
+	// Annotations added to the application's pods.
+	PodAnnotations map[string]string `pulumi:"podAnnotations"`
+
+	// Patches applied to the arguments of the resources the component creates, keyed by resource
+	// kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace,
+	// PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of
+	// its kind.
+	Patches map[string]Patch `pulumi:"patches"`
+
+	// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid
+	// characters and truncating it with a hash suffix. By default, invalid names are rejected.
+	SanitizeName *bool `pulumi:"sanitizeName"`
+
+	// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread
+	// across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread
+	// across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the
+	// provider's `environment`; without one, three replicas are deployed.
+	Environment *string `pulumi:"environment" schema:"ref=Environment"`
+
+	// The contents of a kubeconfig file, or the path to one, to create the application's resources
+	// with. Defaults to the ambient Kubernetes provider.
+	Kubeconfig pulumi.StringInput `pulumi:"kubeconfig" schema:"secret"`
+	// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's
+	// current context.
+	Context pulumi.StringInput `pulumi:"context"`
+	// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service,
+	// named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`.
+	Clusters []Cluster `pulumi:"clusters"`
+
+	// How long to wait for the application to become ready.
+	Await *Await `pulumi:"await"`
+	// An HTTP check the application's URL must pass after it is deployed. Failing it fails the
+	// update. The application must be reachable from outside the cluster, through a
+	// `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled.
+	SmokeTest *SmokeTest `pulumi:"smokeTest"`
+
+	// The resource preset for the application's container, replacing the environment's resources.
+	Size *string `pulumi:"size" schema:"ref=Size"`
+	// Resource requests and limits for the application's container, overriding the ones the
+	// environment or size sets.
+	Resources *Resources `pulumi:"resources"`
+	// An HTTP check that must pass before the application's pods receive traffic.
+	ReadinessProbe *Probe `pulumi:"readinessProbe"`
+	// An HTTP check that restarts the application's container when it fails.
+	LivenessProbe *Probe `pulumi:"livenessProbe"`
+	// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of
+	// other Services is only reachable from inside the cluster, unless an ingress is set.
+	ServiceType *string `pulumi:"serviceType" schema:"default=LoadBalancer,ref=ServiceType"`
+	// An Ingress that routes a host name to the application. The url becomes the Ingress's.
+	Ingress *Ingress `pulumi:"ingress"`
+	// Scales the application with a HorizontalPodAutoscaler.
+	Autoscaling *Autoscaling `pulumi:"autoscaling"`
+}
+
+// The types of Service the application can be exposed through.
+const (
+	serviceTypeClusterIP    = "ClusterIP"
+	serviceTypeNodePort     = "NodePort"
+	serviceTypeLoadBalancer = "LoadBalancer"
+)
+
+// serviceType returns the type of the Service in front of the application, which is a
+// LoadBalancer unless serviceType says otherwise.
+func serviceType(serviceType *string) (string, error) {
+	if serviceType == nil {
+		return serviceTypeLoadBalancer, nil
+	}
+	switch *serviceType {
+	case serviceTypeClusterIP, serviceTypeNodePort, serviceTypeLoadBalancer:
+		return *serviceType, nil
+	}
+	return "", fmt.Errorf("unknown serviceType %q, must be one of %s, %s, %s", *serviceType,
+		serviceTypeClusterIP, serviceTypeNodePort, serviceTypeLoadBalancer)
+}
+
+// behindLoadBalancer reports whether traffic reaches the application through a load balancer
+// or an ingress controller, which keep routing to pods for a moment after they are removed
+// from the Service's endpoints.
+func behindLoadBalancer(serviceType string, ingress *Ingress) bool {
+	return serviceType == serviceTypeLoadBalancer || ingress != nil
 }
 
-// The ProductionApp component resource.
+// validateProbes checks the application's readiness and liveness probes.
+func validateProbes(args *ProductionAppArgs) error {
+	if args.ReadinessProbe != nil {
+		if err := args.ReadinessProbe.validate("readinessProbe"); err != nil {
+			return err
+		}
+	}
+	if args.LivenessProbe != nil {
+		if err := args.LivenessProbe.validate("livenessProbe"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProductionApp is an application deployed to Kubernetes in a namespace of its own, behind a
+// Service, with the availability settings its environment calls for.
 type ProductionApp struct {
 	pulumi.ResourceState
 
-	Url pulumi.StringOutput `pulumi:"url"`
+	// The URL from the generated service, in the first cluster when deployed to several.
+	Url pulumi.StringOutput `pulumi:"url" schema:"required"`
+	// The URL of the application in each cluster, keyed by cluster name.
+	Urls pulumi.StringMapOutput `pulumi:"urls" schema:"required"`
+	// Where the application runs, one workload per cluster.
+	Workloads WorkloadArrayOutput `pulumi:"workloads" schema:"required"`
+}
+
+// podSettings are the pod-level settings shared by every workload the component creates.
+type podSettings struct {
+	annotations      pulumi.StringMap
+	env              corev1.EnvVarArray
+	imagePullSecrets corev1.LocalObjectReferenceArray
 }
 
 // NewProductionPage creates a new ProductionApp component resource.
@@ -43,68 +194,311 @@ func NewProductionApp(ctx *pulumi.Context,
 	if args == nil {
 		args = &ProductionAppArgs{}
 	}
+	// Every input is checked before anything is registered, so that all of the problems are
+	// reported at once and none of them leaves a partial deployment behind.
+	if err := checkDeploymentArgs(ctx, name, args); err != nil {
+		return nil, err
+	}
+
+	// The Kubernetes resources are named after the component, so its name must be valid for
+	// them. The component itself keeps the name it was given.
+	componentName := name
+	if args.SanitizeName != nil && *args.SanitizeName {
+		name = sanitizeName(name, args.PreDeployJob != nil)
+	} else if err := validateName(name, args.PreDeployJob != nil); err != nil {
+		return nil, err
+	}
+
+	preset, err := lookupEnvironment(args.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterNames := make([]string, len(args.Clusters))
+	for i := range args.Clusters {
+		if clusterNames[i], err = validateCluster(i, args, name); err != nil {
+			return nil, err
+		}
+	}
 
-	var err error
 	component := &ProductionApp{}
 
-	err = ctx.RegisterComponentResource("productionapp:index:Deployment", name, component, opts...)
+	err = ctx.RegisterComponentResource("productionapp:index:Deployment", componentName, component, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	labels := pulumi.StringMap{
-		"app.kubernetes.io/app":        pulumi.String(name),
-		"app.production.instance/name": pulumi.String(name),
+	var url pulumi.StringOutput
+	urls := pulumi.StringMap{}
+	var workloads WorkloadArray
+	if len(args.Clusters) == 0 {
+		workload, err := deployApplication(ctx, component, name, args, preset, nil)
+		if err != nil {
+			return nil, err
+		}
+		url = workload.Url()
+		workloads = append(workloads, workload)
+	} else {
+		for i, cluster := range args.Clusters {
+			clusterArgs := *args
+			clusterArgs.Kubeconfig = cluster.Kubeconfig
+			clusterArgs.Context = cluster.Context
+			if cluster.Image != nil {
+				clusterArgs.Image = cluster.Image
+			}
+
+			workload, err := deployApplication(ctx, component, clusterNames[i], &clusterArgs, preset, &args.Clusters[i])
+			if err != nil {
+				return nil, fmt.Errorf("clusters[%d]: %v", i, err)
+			}
+			if i == 0 {
+				url = workload.Url()
+			}
+			urls[cluster.Name] = workload.Url()
+			workloads = append(workloads, workload)
+		}
+	}
+
+	component.Url = url
+	component.Urls = urls.ToStringMapOutput()
+	component.Workloads = workloads.ToWorkloadArrayOutput()
+
+	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
+		"url":       component.Url,
+		"urls":      component.Urls,
+		"workloads": component.Workloads,
+	}); err != nil {
+		return nil, err
+	}
+
+	return component, nil
+}
+
+// deployApplication creates the application's namespace and the resources in it, in the
+// cluster args select, and returns where the application runs there. cluster is nil unless
+// the application is deployed to several clusters.
+func deployApplication(ctx *pulumi.Context, component *ProductionApp, name string, args *ProductionAppArgs,
+	preset *environmentPreset, cluster *Cluster) (WorkloadOutput, error) {
+	metadata, err := newObjectMetadata(ctx, name, args)
+	if err != nil {
+		return WorkloadOutput{}, err
+	}
+
+	await, err := newAwaitSettings(args.Await)
+	if err != nil {
+		return WorkloadOutput{}, err
+	}
+	if err := validateProbes(args); err != nil {
+		return WorkloadOutput{}, err
+	}
+	svcType, err := serviceType(args.ServiceType)
+	if err != nil {
+		return WorkloadOutput{}, err
+	}
+	if args.SmokeTest != nil {
+		if err := args.SmokeTest.validate(svcType, args.Ingress, args.Await); err != nil {
+			return WorkloadOutput{}, err
+		}
+	}
+	resources, err := containerResources(preset, args.Size, args.Resources)
+	if err != nil {
+		return WorkloadOutput{}, err
+	}
+
+	// Every other resource is a child of the namespace, so the providers, aliases and patches
+	// registered here apply to all of them.
+	namespaceOpts := []pulumi.ResourceOption{
+		pulumi.Parent(component),
+		pulumi.Aliases(namespaceAliases(name)),
+	}
+	if args.Kubeconfig != nil || args.Context != nil {
+		k8sProvider, err := kubernetes.NewProvider(ctx, name, &kubernetes.ProviderArgs{
+			Kubeconfig: args.Kubeconfig,
+			Context:    args.Context,
+		}, pulumi.Parent(component))
+		if err != nil {
+			return WorkloadOutput{}, fmt.Errorf("error creating kubernetes provider: %v", err)
+		}
+		namespaceOpts = append(namespaceOpts, pulumi.Provider(k8sProvider))
+	}
+	if preset != nil && preset.ProtectNamespace {
+		namespaceOpts = append(namespaceOpts, pulumi.Protect(true))
+	}
+	if len(args.Patches) > 0 {
+		namespaceOpts = append(namespaceOpts,
+			pulumi.Transformations([]pulumi.ResourceTransformation{patchTransformation(args.Patches)}))
 	}
 
 	namespace, err := corev1.NewNamespace(ctx, name, &corev1.NamespaceArgs{
 		Metadata: &metav1.ObjectMetaArgs{
-			Labels: labels,
+			Labels:      metadata.labels,
+			Annotations: metadata.annotations,
 		},
-	})
+	}, namespaceOpts...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating namespace: %v", err)
+		return WorkloadOutput{}, fmt.Errorf("error creating namespace: %v", err)
 	}
 
-	_, err = appsv1.NewDeployment(ctx, name, &appsv1.DeploymentArgs{
-		Metadata: &metav1.ObjectMetaArgs{
-			Namespace: namespace.Metadata.Name().Elem(),
-			Labels:    labels,
+	var pod podSettings
+	if len(args.PodAnnotations) > 0 {
+		pod.annotations = pulumi.ToStringMap(args.PodAnnotations)
+	}
+
+	if args.RegistryCredentials != nil {
+		secretName, err := newImagePullSecret(ctx, name, args.RegistryCredentials, namespace, metadata)
+		if err != nil {
+			return WorkloadOutput{}, err
+		}
+		pod.imagePullSecrets = append(pod.imagePullSecrets, &corev1.LocalObjectReferenceArgs{
+			Name: secretName,
+		})
+	}
+
+	if args.Database != nil {
+		databaseUrl, err := newSecretEnvVar(ctx, fmt.Sprintf("%s-database", name), "DATABASE_URL",
+			args.Database.ToDatabaseConnectionOutput().ConnectionString(), namespace, metadata)
+		if err != nil {
+			return WorkloadOutput{}, fmt.Errorf("error creating database secret: %v", err)
+		}
+		pod.env = append(pod.env, databaseUrl)
+	}
+
+	if args.Cache != nil {
+		cache := args.Cache.ToCacheConnectionOutput()
+		redisUrl, err := newSecretEnvVar(ctx, fmt.Sprintf("%s-cache", name), "REDIS_URL",
+			cache.ConnectionString(), namespace, metadata)
+		if err != nil {
+			return WorkloadOutput{}, fmt.Errorf("error creating cache secret: %v", err)
+		}
+		pod.env = append(pod.env,
+			&corev1.EnvVarArgs{
+				Name:  pulumi.String("REDIS_HOST"),
+				Value: cache.Host(),
+			},
+			&corev1.EnvVarArgs{
+				Name:  pulumi.String("REDIS_PORT"),
+				Value: pulumi.Sprintf("%d", cache.Port()),
+			},
+			redisUrl,
+		)
+	}
+
+	lifecycle, terminationGracePeriod, err := shutdownSettings(args.Shutdown, behindLoadBalancer(svcType, args.Ingress))
+	if err != nil {
+		return WorkloadOutput{}, fmt.Errorf("error configuring shutdown: %v", err)
+	}
+
+	volumes, volumeMounts, err := podVolumes(args.Volumes, args.VolumeMounts,
+		args.AllowHostPath != nil && *args.AllowHostPath)
+	if err != nil {
+		return WorkloadOutput{}, fmt.Errorf("error configuring volumes: %v", err)
+	}
+
+	// Resources that must exist before the application's pods are created.
+	var dependencies []pulumi.Resource
+
+	if args.Quota != nil {
+		quota, err := newQuota(ctx, name, args.Quota, svcType, namespace, metadata)
+		if err != nil {
+			return WorkloadOutput{}, err
+		}
+		dependencies = append(dependencies, quota...)
+	}
+
+	if args.PreDeployJob != nil {
+		job, err := newPreDeployJob(ctx, name, args.PreDeployJob, args.Image, pod, namespace, metadata,
+			append(await.options(), pulumi.DependsOn(dependencies))...)
+		if err != nil {
+			return WorkloadOutput{}, fmt.Errorf("error creating pre-deploy job: %v", err)
+		}
+		dependencies = append(dependencies, job)
+	}
+
+	replicas := defaultReplicas
+	if preset != nil {
+		replicas = preset.Replicas
+	}
+	if cluster != nil && cluster.Replicas != nil {
+		replicas = *cluster.Replicas
+	}
+	scaling, err := autoscalingSettings(preset, replicas, args.Autoscaling)
+	if err != nil {
+		return WorkloadOutput{}, err
+	}
+	if scaling != nil {
+		replicas = scaling.minReplicas
+	}
+	container := &corev1.ContainerArgs{
+		Name:         pulumi.String(name),
+		Image:        args.Image,
+		Command:      args.Command,
+		Args:         args.Args,
+		WorkingDir:   args.WorkingDir,
+		Env:          pod.env,
+		Lifecycle:    lifecycle,
+		VolumeMounts: volumeMounts,
+		Ports: &corev1.ContainerPortArray{
+			&corev1.ContainerPortArgs{
+				ContainerPort: args.Port,
+			},
 		},
+	}
+	if resources != nil {
+		container.Resources = resources.toResourceRequirements()
+	}
+	if args.ReadinessProbe != nil {
+		container.ReadinessProbe = args.ReadinessProbe.toProbe(args.Port)
+	}
+	if args.LivenessProbe != nil {
+		container.LivenessProbe = args.LivenessProbe.toProbe(args.Port)
+	}
+	var affinity corev1.AffinityPtrInput
+	var minAvailable *string
+	deploymentOpts := append(await.options(), pulumi.Parent(namespace), pulumi.DependsOn(dependencies))
+	if preset != nil {
+		container.ImagePullPolicy = pulumi.String(preset.ImagePullPolicy)
+		if preset.AntiAffinity {
+			affinity = podAntiAffinity(metadata.selectorLabels)
+		}
+		minAvailable = preset.MinAvailable
+	}
+	// The autoscaler owns the number of replicas once the Deployment exists.
+	if scaling != nil {
+		deploymentOpts = append(deploymentOpts, pulumi.IgnoreChanges([]string{"spec.replicas"}))
+	}
+
+	deployment, err := appsv1.NewDeployment(ctx, name, &appsv1.DeploymentArgs{
+		Metadata: metadata.withAnnotations(await.deploymentAnnotations).objectMeta(namespace),
 		Spec: &appsv1.DeploymentSpecArgs{
 			Selector: &metav1.LabelSelectorArgs{
-				MatchLabels: labels,
+				MatchLabels: metadata.selectorLabels,
 			},
-			Replicas: pulumi.Int(3),
+			Replicas: pulumi.Int(replicas),
 			Template: &corev1.PodTemplateSpecArgs{
 				Metadata: &metav1.ObjectMetaArgs{
-					Labels: labels,
+					Labels:      metadata.labels,
+					Annotations: pod.annotations,
 				},
 				Spec: &corev1.PodSpecArgs{
-					Containers: &corev1.ContainerArray{
-						&corev1.ContainerArgs{
-							Name:  pulumi.String(name),
-							Image: args.Image,
-							Ports: &corev1.ContainerPortArray{
-								&corev1.ContainerPortArgs{
-									ContainerPort: args.Port,
-								},
-							},
-						},
-					},
+					TerminationGracePeriodSeconds: terminationGracePeriod,
+					ImagePullSecrets:              pod.imagePullSecrets,
+					Volumes:                       volumes,
+					Affinity:                      affinity,
+					Containers:                    &corev1.ContainerArray{container},
 				},
 			},
 		},
-	}, pulumi.Parent(namespace))
+	}, deploymentOpts...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating deployment: %v", err)
+		return WorkloadOutput{}, fmt.Errorf("error creating deployment: %v", err)
+	}
+
+	if err := newEnvironmentResources(ctx, name, minAvailable, scaling, deployment, namespace, metadata); err != nil {
+		return WorkloadOutput{}, err
 	}
 
 	service, err := corev1.NewService(ctx, name, &corev1.ServiceArgs{
-		Metadata: &metav1.ObjectMetaArgs{
-			Namespace: namespace.Metadata.Name().Elem(),
-			Labels:    labels,
-		},
+		Metadata: metadata.withAnnotations(await.serviceAnnotations).objectMeta(namespace),
 		Spec: &corev1.ServiceSpecArgs{
 			Ports: &corev1.ServicePortArray{
 				&corev1.ServicePortArgs{
@@ -112,32 +506,86 @@ func NewProductionApp(ctx *pulumi.Context,
 					TargetPort: args.Port,
 				},
 			},
-			Type:     pulumi.String("LoadBalancer"),
-			Selector: labels,
+			Type:     pulumi.String(svcType),
+			Selector: metadata.selectorLabels,
 		},
-	}, pulumi.Parent(namespace))
+	}, append(await.options(), pulumi.Parent(namespace))...)
 	if err != nil {
-		return nil, fmt.Errorf("error creating service: %v", err)
+		return WorkloadOutput{}, fmt.Errorf("error creating service: %v", err)
 	}
 
-	url := service.Status.ApplyT(func(status *corev1.ServiceStatus) string {
-		ingress := status.LoadBalancer.Ingress[0]
-		if ingress.Ip != nil {
-			return fmt.Sprintf("http://%s", *ingress.Ip)
-		} else if ingress.Hostname != nil {
-			return fmt.Sprintf("http://%s", *ingress.Hostname)
-		} else {
-			return "could not find ingress"
+	var url pulumi.StringOutput
+	checked := []pulumi.Resource{deployment, service}
+	switch {
+	case args.Ingress != nil:
+		ingress, err := newIngress(ctx, name, args.Ingress, service, namespace, metadata, await.options()...)
+		if err != nil {
+			return WorkloadOutput{}, err
 		}
-	}).(pulumi.StringOutput)
+		checked = append(checked, ingress)
+		url = pulumi.String(args.Ingress.url()).ToStringOutput()
+	case svcType == serviceTypeLoadBalancer:
+		url = service.Status.ApplyT(func(status *corev1.ServiceStatus) string {
+			// The load balancer may not be ready if the Service wasn't awaited.
+			if status == nil || status.LoadBalancer == nil || len(status.LoadBalancer.Ingress) == 0 {
+				return ""
+			}
+			ingress := status.LoadBalancer.Ingress[0]
+			if ingress.Ip != nil {
+				return fmt.Sprintf("http://%s", *ingress.Ip)
+			} else if ingress.Hostname != nil {
+				return fmt.Sprintf("http://%s", *ingress.Hostname)
+			} else {
+				return "could not find ingress"
+			}
+		}).(pulumi.StringOutput)
+	default:
+		// Other Services are only reachable from inside the cluster.
+		url = pulumi.Sprintf("http://%s.%s.svc.cluster.local",
+			service.Metadata.Name().Elem(), namespace.Metadata.Name().Elem())
+	}
 
-	component.Url = url
+	if args.SmokeTest != nil {
+		url = smokeTestOutput(ctx, smokeTestClient, args.SmokeTest, url, checked, component)
+	}
 
-	if err := ctx.RegisterResourceOutputs(component, pulumi.Map{
-		"url": url,
-	}); err != nil {
+	workload := WorkloadArgs{
+		Context:    args.Context,
+		Namespace:  namespace.Metadata.Name().Elem(),
+		Deployment: deployment.Metadata.Name().Elem(),
+		Url:        url,
+	}
+	if args.Kubeconfig != nil {
+		// The component's methods reach the cluster with the same kubeconfig.
+		workload.Kubeconfig = pulumi.ToSecret(args.Kubeconfig.ToStringOutput().ToStringPtrOutput()).(pulumi.StringPtrOutput)
+	}
+	if cluster != nil {
+		workload.Cluster = pulumi.String(cluster.Name)
+	}
+	return workload.ToWorkloadOutput(), nil
+}
+
+// newSecretEnvVar stores a sensitive value in a Secret in the application's namespace and
+// returns an environment variable that reads it.
+func newSecretEnvVar(ctx *pulumi.Context, name, key string, value pulumi.StringInput,
+	namespace *corev1.Namespace, metadata objectMetadata) (*corev1.EnvVarArgs, error) {
+	secret, err := corev1.NewSecret(ctx, name, &corev1.SecretArgs{
+		Metadata: metadata.objectMeta(namespace),
+		StringData: pulumi.StringMap{
+			key: value,
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
 		return nil, err
 	}
 
-	return component, nil
+	return &corev1.EnvVarArgs{
+		Name: pulumi.String(key),
+		ValueFrom: &corev1.EnvVarSourceArgs{
+			SecretKeyRef: &corev1.SecretKeySelectorArgs{
+				Name: secret.Metadata.Name(),
+				Key:  pulumi.String(key),
+			},
+		},
+	}, nil
 }
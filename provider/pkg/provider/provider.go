@@ -17,25 +17,97 @@ package provider
 import (
 	"github.com/pkg/errors"
 
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/provider"
 )
 
-func construct(ctx *pulumi.Context, typ, name string, inputs provider.ConstructInputs,
+// Component is a component resource the provider constructs, with the structs its inputs are
+// decoded into and its outputs are read from.
+type Component struct {
+	Token    string
+	Args     interface{}
+	Resource interface{}
+}
+
+// Components are the component resources the provider constructs. The provider's schema is
+// generated from their structs.
+var Components = []Component{
+	{Token: "productionapp:index:Deployment", Args: ProductionAppArgs{}, Resource: ProductionApp{}},
+	{Token: "productionapp:index:Database", Args: DatabaseArgs{}, Resource: Database{}},
+	{Token: "productionapp:index:Cache", Args: CacheArgs{}, Resource: Cache{}},
+}
+
+// Function is a function the provider implements, with the struct its inputs are decoded into.
+type Function struct {
+	Token  string
+	Inputs interface{}
+}
+
+// Functions are the functions whose inputs the provider's schema is generated from.
+var Functions = []Function{
+	{Token: "productionapp:index:validateArgs", Inputs: ValidateArgsArgs{}},
+}
+
+func construct(ctx *pulumi.Context, config Config, typ, name string, inputs provider.ConstructInputs,
 	options pulumi.ResourceOption) (*provider.ConstructResult, error) {
 	// TODO: Add support for additional component resources here.
 	switch typ {
 	case "productionapp:index:Deployment":
-		return constructStaticPage(ctx, name, inputs, options)
+		return constructStaticPage(ctx, config, name, inputs, options)
+	case "productionapp:index:Database":
+		return constructDatabase(ctx, name, inputs, options)
+	case "productionapp:index:Cache":
+		return constructCache(ctx, name, inputs, options)
 	default:
 		return nil, errors.Errorf("unknown resource type %s", typ)
 	}
 }
 
+func call(ctx *pulumi.Context, tok string, args provider.CallArgs) (*provider.CallResult, error) {
+	switch tok {
+	case "productionapp:index:Deployment/restart":
+		return callDeploymentMethod(ctx, args, callRestart)
+	case "productionapp:index:Deployment/getStatus":
+		return callDeploymentMethod(ctx, args, callGetStatus)
+	case "productionapp:index:Deployment/getKubectlCommands":
+		return callDeploymentMethod(ctx, args, callGetKubectlCommands)
+	default:
+		return nil, errors.Errorf("unknown method %s", tok)
+	}
+}
+
+// callDeploymentMethod is an implementation of Call for the Deployment component's methods. It
+// retrieves the component the method is called on from the `__self__` argument, rehydrated from
+// its state, and calls method with it.
+func callDeploymentMethod(ctx *pulumi.Context, args provider.CallArgs,
+	method func(*pulumi.Context, *ProductionApp) (*provider.CallResult, error)) (*provider.CallResult, error) {
+	self, err := args.Self()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting self")
+	}
+	component, ok := self.(*ProductionApp)
+	if !ok {
+		return nil, errors.Errorf("expected self to be a Deployment, got %T", self)
+	}
+	return method(ctx, component)
+}
+
+func invoke(config Config, tok string, inputs resource.PropertyMap) (resource.PropertyMap, error) {
+	switch tok {
+	case "productionapp:index:validateArgs":
+		return invokeValidateArgs(config, inputs)
+	case "productionapp:index:getPresets":
+		return invokeGetPresets()
+	default:
+		return nil, errors.Errorf("unknown function %s", tok)
+	}
+}
+
 // constructStaticPage is an implementation of Construct for the example StaticPage component.
 // It demonstrates converting the raw ConstructInputs to the component's args struct, creating
 // the component, and returning its URN and state (outputs).
-func constructStaticPage(ctx *pulumi.Context, name string, inputs provider.ConstructInputs,
+func constructStaticPage(ctx *pulumi.Context, config Config, name string, inputs provider.ConstructInputs,
 	options pulumi.ResourceOption) (*provider.ConstructResult, error) {
 
 	// Copy the raw inputs to StaticPageArgs. `inputs.CopyTo` uses the types and `pulumi:` tags
@@ -45,6 +117,9 @@ func constructStaticPage(ctx *pulumi.Context, name string, inputs provider.Const
 		return nil, errors.Wrap(err, "setting args")
 	}
 
+	// Apply the provider's defaults to the inputs left unset.
+	config.applyTo(args)
+
 	// Create the component resource.
 	staticPage, err := NewProductionApp(ctx, name, args, options)
 	if err != nil {
@@ -56,3 +131,37 @@ func constructStaticPage(ctx *pulumi.Context, name string, inputs provider.Const
 	// that is convertible to `pulumi.Input`.
 	return provider.NewConstructResult(staticPage)
 }
+
+// constructDatabase is an implementation of Construct for the Database component.
+func constructDatabase(ctx *pulumi.Context, name string, inputs provider.ConstructInputs,
+	options pulumi.ResourceOption) (*provider.ConstructResult, error) {
+
+	args := &DatabaseArgs{}
+	if err := inputs.CopyTo(args); err != nil {
+		return nil, errors.Wrap(err, "setting args")
+	}
+
+	database, err := NewDatabase(ctx, name, args, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating component")
+	}
+
+	return provider.NewConstructResult(database)
+}
+
+// constructCache is an implementation of Construct for the Cache component.
+func constructCache(ctx *pulumi.Context, name string, inputs provider.ConstructInputs,
+	options pulumi.ResourceOption) (*provider.ConstructResult, error) {
+
+	args := &CacheArgs{}
+	if err := inputs.CopyTo(args); err != nil {
+		return nil, errors.Wrap(err, "setting args")
+	}
+
+	cache, err := NewCache(ctx, name, args, options)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating component")
+	}
+
+	return provider.NewConstructResult(cache)
+}
@@ -0,0 +1,121 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Quota caps what the application may consume in its namespace.
+type Quota struct {
+	// The total CPU limit of all pods in the namespace, such as `4`.
+	Cpu *string `pulumi:"cpu"`
+	// The total memory limit of all pods in the namespace, such as `8Gi`.
+	Memory *string `pulumi:"memory"`
+	// The maximum number of pods in the namespace.
+	Pods *int `pulumi:"pods"`
+	// The maximum number of LoadBalancer services in the namespace. Must be at least 1.
+	LoadBalancers *int `pulumi:"loadBalancers"`
+	// The maximum number of persistent volume claims in the namespace.
+	PersistentVolumeClaims *int `pulumi:"persistentVolumeClaims"`
+	// Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi
+	// memory.
+	DefaultRequests map[string]string `pulumi:"defaultRequests"`
+	// Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi
+	// memory.
+	DefaultLimits map[string]string `pulumi:"defaultLimits"`
+}
+
+// defaultContainerResources are applied by the LimitRange to containers that don't set their own.
+var defaultContainerResources = resourcePreset{
+	Requests: map[string]string{"cpu": "100m", "memory": "128Mi"},
+	Limits:   map[string]string{"cpu": "500m", "memory": "512Mi"},
+}
+
+// validate checks the quota's settings for an application exposed through a Service of
+// serviceType.
+func (q *Quota) validate(serviceType string) error {
+	// A LoadBalancer Service counts against the quota.
+	if serviceType == serviceTypeLoadBalancer && q.LoadBalancers != nil && *q.LoadBalancers < 1 {
+		return fmt.Errorf("loadBalancers must be at least 1, got %d", *q.LoadBalancers)
+	}
+	return nil
+}
+
+// newQuota creates a ResourceQuota and a LimitRange in the application's namespace. Containers
+// must have limits set once cpu or memory are capped, so the LimitRange provides defaults.
+func newQuota(ctx *pulumi.Context, name string, quota *Quota, serviceType string, namespace *corev1.Namespace,
+	metadata objectMetadata) ([]pulumi.Resource, error) {
+	if err := quota.validate(serviceType); err != nil {
+		return nil, err
+	}
+
+	hard := map[string]string{}
+	if quota.Cpu != nil {
+		hard["limits.cpu"] = *quota.Cpu
+	}
+	if quota.Memory != nil {
+		hard["limits.memory"] = *quota.Memory
+	}
+	if quota.Pods != nil {
+		hard["pods"] = strconv.Itoa(*quota.Pods)
+	}
+	if quota.LoadBalancers != nil {
+		hard["services.loadbalancers"] = strconv.Itoa(*quota.LoadBalancers)
+	}
+	if quota.PersistentVolumeClaims != nil {
+		hard["persistentvolumeclaims"] = strconv.Itoa(*quota.PersistentVolumeClaims)
+	}
+
+	resourceQuota, err := corev1.NewResourceQuota(ctx, name, &corev1.ResourceQuotaArgs{
+		Metadata: metadata.objectMeta(namespace),
+		Spec: &corev1.ResourceQuotaSpecArgs{
+			Hard: pulumi.ToStringMap(hard),
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating resource quota: %v", err)
+	}
+
+	defaults := defaultContainerResources
+	if quota.DefaultRequests != nil {
+		defaults.Requests = quota.DefaultRequests
+	}
+	if quota.DefaultLimits != nil {
+		defaults.Limits = quota.DefaultLimits
+	}
+
+	limitRange, err := corev1.NewLimitRange(ctx, name, &corev1.LimitRangeArgs{
+		Metadata: metadata.objectMeta(namespace),
+		Spec: &corev1.LimitRangeSpecArgs{
+			Limits: corev1.LimitRangeItemArray{
+				&corev1.LimitRangeItemArgs{
+					Type:           pulumi.String("Container"),
+					DefaultRequest: pulumi.ToStringMap(defaults.Requests),
+					Default:        pulumi.ToStringMap(defaults.Limits),
+				},
+			},
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating limit range: %v", err)
+	}
+
+	return []pulumi.Resource{resourceQuota, limitRange}, nil
+}
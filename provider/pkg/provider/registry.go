@@ -0,0 +1,132 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// newImagePullSecret returns the name of the Secret the application's pods pull images with,
+// creating a kubernetes.io/dockerconfigjson Secret in the application's namespace unless the
+// credentials name an existing one.
+func newImagePullSecret(ctx *pulumi.Context, name string, credentials RegistryCredentialsInput,
+	namespace *corev1.Namespace, metadata objectMetadata) (pulumi.StringInput, error) {
+	args, ok := credentials.(RegistryCredentialsArgs)
+	if !ok {
+		return newImagePullSecretFromOutput(ctx, name, credentials.ToRegistryCredentialsOutput(), namespace, metadata), nil
+	}
+	if err := validateRegistryCredentials(args); err != nil {
+		return nil, err
+	}
+	if args.ExistingSecretName != nil {
+		return args.ExistingSecretName, nil
+	}
+
+	secret, err := newRegistrySecret(ctx, name, args.Server, args.Username, args.Password,
+		metadata.objectMeta(namespace), namespace)
+	if err != nil {
+		return nil, err
+	}
+	return secret.Metadata.Name().Elem(), nil
+}
+
+// newImagePullSecretFromOutput handles credentials that arrive as a single output, whose fields
+// aren't known until it resolves. The credentials are checked and the Secret is created once they
+// are, so the Secret isn't shown in a preview that doesn't know them. Its name can't be awaited
+// from within the apply, so it is named after the component rather than generated.
+func newImagePullSecretFromOutput(ctx *pulumi.Context, name string, credentials RegistryCredentialsOutput,
+	namespace *corev1.Namespace, metadata objectMetadata) pulumi.StringOutput {
+	secretName := credentials.ApplyT(func(v RegistryCredentials) (string, error) {
+		if err := registryCredentialsProblem(v.ExistingSecretName != "", v.Server != "", v.Username != "",
+			v.Password != ""); err != nil {
+			return "", err
+		}
+		if v.ExistingSecretName != "" {
+			return v.ExistingSecretName, nil
+		}
+
+		secretName := fmt.Sprintf("%s-registry", name)
+		meta := metadata.objectMeta(namespace)
+		meta.Name = pulumi.String(secretName)
+		_, err := newRegistrySecret(ctx, name, pulumi.String(v.Server), pulumi.String(v.Username),
+			pulumi.String(v.Password), meta, namespace)
+		return secretName, err
+	}).(pulumi.StringOutput)
+	// The password makes the whole output secret, but the Secret's name isn't.
+	return pulumi.Unsecret(secretName).(pulumi.StringOutput)
+}
+
+// newRegistrySecret creates the kubernetes.io/dockerconfigjson Secret for a registry.
+func newRegistrySecret(ctx *pulumi.Context, name string, server, username, password pulumi.StringInput,
+	meta *metav1.ObjectMetaArgs, namespace *corev1.Namespace) (*corev1.Secret, error) {
+	dockerConfig := pulumi.All(server, username, password).ApplyT(
+		func(v []interface{}) (string, error) {
+			server, username, password := v[0].(string), v[1].(string), v[2].(string)
+			config, err := json.Marshal(map[string]interface{}{
+				"auths": map[string]interface{}{
+					server: map[string]string{
+						"username": username,
+						"password": password,
+						"auth":     base64.StdEncoding.EncodeToString([]byte(username + ":" + password)),
+					},
+				},
+			})
+			return string(config), err
+		}).(pulumi.StringOutput)
+
+	secret, err := corev1.NewSecret(ctx, fmt.Sprintf("%s-registry", name), &corev1.SecretArgs{
+		Metadata: meta,
+		Type:     pulumi.String("kubernetes.io/dockerconfigjson"),
+		StringData: pulumi.StringMap{
+			".dockerconfigjson": pulumi.ToSecret(dockerConfig).(pulumi.StringOutput),
+		},
+	}, pulumi.Parent(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("error creating registry secret: %v", err)
+	}
+	return secret, nil
+}
+
+// validateRegistryCredentials checks which of the credentials' fields are set. `inputs.CopyTo`
+// decodes object inputs into RegistryCredentialsArgs, so they are known while the component is
+// constructed; credentials that arrive as an output are checked once they resolve.
+func validateRegistryCredentials(credentials RegistryCredentialsInput) error {
+	args, ok := credentials.(RegistryCredentialsArgs)
+	if !ok {
+		return nil
+	}
+	return registryCredentialsProblem(args.ExistingSecretName != nil, args.Server != nil, args.Username != nil,
+		args.Password != nil)
+}
+
+// registryCredentialsProblem reports a problem with which of the credentials' fields are set.
+func registryCredentialsProblem(existingSecretName, server, username, password bool) error {
+	if existingSecretName {
+		if server || username || password {
+			return fmt.Errorf("existingSecretName cannot be combined with server, username or password")
+		}
+		return nil
+	}
+	if !server || !username || !password {
+		return fmt.Errorf("server, username and password are required unless existingSecretName is set")
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func TestImagePullSecret(t *testing.T) {
+	const secretType = "kubernetes:core/v1:Secret"
+	credentials := RegistryCredentialsArgs{
+		Server:   pulumi.String("ghcr.io"),
+		Username: pulumi.String("me"),
+		Password: pulumi.ToSecret(pulumi.String("token")).(pulumi.StringOutput),
+	}
+	existing := RegistryCredentialsArgs{ExistingSecretName: pulumi.String("pull")}
+	tests := []struct {
+		name        string
+		credentials RegistryCredentialsInput
+		secret      bool
+		secretName  interface{}
+		err         string
+	}{
+		{name: "existing secret", credentials: existing, secretName: "pull"},
+		{name: "credentials", credentials: credentials, secret: true},
+		{name: "existing secret output", credentials: existing.ToRegistryCredentialsOutput(), secretName: "pull"},
+		{name: "credentials output", credentials: credentials.ToRegistryCredentialsOutput(), secret: true,
+			secretName: "app-registry"},
+		{
+			name: "invalid output",
+			credentials: RegistryCredentialsArgs{
+				Server:             pulumi.String("ghcr.io"),
+				ExistingSecretName: pulumi.String("pull"),
+			}.ToRegistryCredentialsOutput(),
+			err: "existingSecretName cannot be combined with server, username or password",
+		},
+		{
+			name:        "incomplete output",
+			credentials: RegistryCredentialsArgs{Server: pulumi.String("ghcr.io")}.ToRegistryCredentialsOutput(),
+			err:         "server, username and password are required unless existingSecretName is set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMocks()
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				_, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+					Image:               pulumi.String("ghcr.io/me/app"),
+					Port:                pulumi.Int(80),
+					RegistryCredentials: tt.credentials,
+				})
+				return err
+			}, pulumi.WithMocks("project", "stack", m))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := m.registered(secretType, "app-registry"); got != tt.secret {
+				t.Fatalf("expected a registry Secret to be created: %v, got %v", tt.secret, got)
+			}
+			if tt.secret {
+				secret := m.resource(t, secretType, "app-registry").Inputs
+				if got := secret["type"].Mappable(); got != "kubernetes.io/dockerconfigjson" {
+					t.Errorf("expected a dockerconfigjson Secret, got %v", got)
+				}
+				data := secret["stringData"]
+				if !data.IsSecret() {
+					t.Fatalf("expected the Secret's data to be secret, got %v", data)
+				}
+				config := data.SecretValue().Element.ObjectValue()[".dockerconfigjson"]
+				if got := config.StringValue(); !strings.Contains(got, `"ghcr.io":{"auth":"bWU6dG9rZW4="`) {
+					t.Errorf("unexpected docker config %s", got)
+				}
+			}
+
+			if tt.secretName != nil {
+				deployment := m.resource(t, deploymentType, "app").Inputs
+				pullSecrets := deployment["spec"].Mappable()
+				if got := field(pullSecrets, "template", "spec", "imagePullSecrets", 0, "name"); got != tt.secretName {
+					t.Errorf("expected pods to pull with %v, got %v", tt.secretName, got)
+				}
+				if deployment["spec"].ContainsSecrets() {
+					t.Errorf("expected the Deployment's spec not to be secret")
+				}
+			}
+		})
+	}
+}
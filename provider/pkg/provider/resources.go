@@ -0,0 +1,109 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// resourcePreset is a named set of container resource requests and limits.
+type resourcePreset struct {
+	Requests map[string]string
+	Limits   map[string]string
+}
+
+func (p resourcePreset) toResourceRequirements() *corev1.ResourceRequirementsArgs {
+	return &corev1.ResourceRequirementsArgs{
+		Requests: pulumi.ToStringMap(p.Requests),
+		Limits:   pulumi.ToStringMap(p.Limits),
+	}
+}
+
+// sizes are the resource presets a Cache or an application's container can be sized with.
+var sizes = map[string]resourcePreset{
+	"small": {
+		Requests: map[string]string{"cpu": "100m", "memory": "128Mi"},
+		Limits:   map[string]string{"cpu": "250m", "memory": "256Mi"},
+	},
+	"medium": {
+		Requests: map[string]string{"cpu": "250m", "memory": "512Mi"},
+		Limits:   map[string]string{"cpu": "500m", "memory": "1Gi"},
+	},
+	"large": {
+		Requests: map[string]string{"cpu": "500m", "memory": "2Gi"},
+		Limits:   map[string]string{"cpu": "1", "memory": "4Gi"},
+	},
+}
+
+// lookupSize returns the resource preset named size.
+func lookupSize(size string) (resourcePreset, error) {
+	preset, ok := sizes[size]
+	if !ok {
+		return preset, fmt.Errorf("unknown size %q, must be one of %s", size, strings.Join(sortedKeys(sizes), ", "))
+	}
+	return preset, nil
+}
+
+// Resources overrides the application container's resource requests and limits. Each entry
+// overrides the one the environment or size sets.
+type Resources struct {
+	// The resources requested, such as `cpu: 250m` or `memory: 512Mi`.
+	Requests map[string]string `pulumi:"requests"`
+	// The resource limits, such as `cpu: "1"` or `memory: 1Gi`.
+	Limits map[string]string `pulumi:"limits"`
+}
+
+// containerResources returns the application container's resource requests and limits: the
+// environment's, replaced by size's if it is set, with the ones resources sets overriding
+// them. It returns nil if none of them are set, leaving the container without any.
+func containerResources(preset *environmentPreset, size *string, resources *Resources) (*resourcePreset, error) {
+	var result *resourcePreset
+	if preset != nil {
+		result = &resourcePreset{Requests: preset.Resources.Requests, Limits: preset.Resources.Limits}
+	}
+	if size != nil {
+		sized, err := lookupSize(*size)
+		if err != nil {
+			return nil, err
+		}
+		result = &sized
+	}
+	if resources != nil {
+		if result == nil {
+			result = &resourcePreset{}
+		}
+		result = &resourcePreset{
+			Requests: mergeStrings(result.Requests, resources.Requests),
+			Limits:   mergeStrings(result.Limits, resources.Limits),
+		}
+	}
+	return result, nil
+}
+
+// mergeStrings returns a copy of base with the entries of overrides set.
+func mergeStrings(base, overrides map[string]string) map[string]string {
+	merged := map[string]string{}
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,81 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	metav1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/meta/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// passwordSecretKey is the key generated passwords are stored under.
+const passwordSecretKey = "password"
+
+const passwordAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// newPasswordSecret creates a Secret holding a generated password and returns the password as
+// a secret output. A new password is generated on every run, so changes to the secret data are
+// ignored and the value stored by the first deployment is read back from the secret.
+func newPasswordSecret(ctx *pulumi.Context, name string, namespace pulumi.StringInput,
+	labels pulumi.StringMap, opts ...pulumi.ResourceOption) (*corev1.Secret, pulumi.StringOutput, error) {
+	generated, err := generatePassword(24)
+	if err != nil {
+		return nil, pulumi.StringOutput{}, fmt.Errorf("error generating password: %v", err)
+	}
+
+	opts = append(opts, pulumi.IgnoreChanges([]string{"data"}))
+	secret, err := corev1.NewSecret(ctx, name, &corev1.SecretArgs{
+		Metadata: &metav1.ObjectMetaArgs{
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Type: pulumi.String("Opaque"),
+		Data: pulumi.StringMap{
+			passwordSecretKey: pulumi.String(base64.StdEncoding.EncodeToString([]byte(generated))),
+		},
+	}, opts...)
+	if err != nil {
+		return nil, pulumi.StringOutput{}, fmt.Errorf("error creating secret: %v", err)
+	}
+
+	password := pulumi.ToSecret(secret.Data.ApplyT(func(data map[string]string) (string, error) {
+		decoded, err := base64.StdEncoding.DecodeString(data[passwordSecretKey])
+		if err != nil {
+			return "", fmt.Errorf("error decoding password: %v", err)
+		}
+		return string(decoded), nil
+	})).(pulumi.StringOutput)
+
+	return secret, password, nil
+}
+
+// generatePassword returns a random alphanumeric password of the given length.
+func generatePassword(length int) (string, error) {
+	password := make([]byte, length)
+	max := big.NewInt(int64(len(passwordAlphabet)))
+	for i := range password {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+	return string(password), nil
+}
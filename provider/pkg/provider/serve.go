@@ -15,14 +15,116 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+
+	pbempty "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pulumi/pulumi/pkg/v3/resource/provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource/plugin"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/util/cmdutil"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	pulumiprovider "github.com/pulumi/pulumi/sdk/v3/go/pulumi/provider"
+	pulumirpc "github.com/pulumi/pulumi/sdk/v3/proto/go"
 )
 
 // Serve launches the gRPC server for the resource provider.
 func Serve(providerName, version string, schema []byte) {
+	// Method calls receive the component they are called on as a reference to rehydrate.
+	registerResourceModule(providerName, version)
+
 	// Start gRPC service.
-	if err := provider.ComponentMain(providerName, version, schema, construct); err != nil {
+	err := provider.Main(providerName, func(host *provider.HostClient) (pulumirpc.ResourceProviderServer, error) {
+		return &productionAppProvider{
+			host:    host,
+			name:    providerName,
+			version: version,
+			schema:  schema,
+		}, nil
+	})
+	if err != nil {
 		cmdutil.ExitError(err.Error())
 	}
 }
+
+// productionAppProvider is a component provider like the one `provider.ComponentMain` serves,
+// except that it is configurable.
+type productionAppProvider struct {
+	pulumirpc.UnimplementedResourceProviderServer
+
+	host    *provider.HostClient
+	name    string
+	version string
+	schema  []byte
+	config  Config
+}
+
+func (p *productionAppProvider) GetPluginInfo(context.Context, *pbempty.Empty) (*pulumirpc.PluginInfo, error) {
+	return &pulumirpc.PluginInfo{
+		Version: p.version,
+	}, nil
+}
+
+func (p *productionAppProvider) GetSchema(ctx context.Context,
+	req *pulumirpc.GetSchemaRequest) (*pulumirpc.GetSchemaResponse, error) {
+	if v := req.GetVersion(); v != 0 {
+		return nil, fmt.Errorf("unsupported schema version %d", v)
+	}
+	return &pulumirpc.GetSchemaResponse{Schema: string(p.schema)}, nil
+}
+
+func (p *productionAppProvider) Configure(ctx context.Context,
+	req *pulumirpc.ConfigureRequest) (*pulumirpc.ConfigureResponse, error) {
+	config, err := parseConfig(p.name, req.GetVariables())
+	if err != nil {
+		return nil, err
+	}
+	p.config = config
+
+	return &pulumirpc.ConfigureResponse{
+		AcceptSecrets:   true,
+		SupportsPreview: true,
+		AcceptResources: true,
+		AcceptOutputs:   true,
+	}, nil
+}
+
+func (p *productionAppProvider) Construct(ctx context.Context,
+	req *pulumirpc.ConstructRequest) (*pulumirpc.ConstructResponse, error) {
+	return pulumiprovider.Construct(ctx, req, p.host.EngineConn(),
+		func(ctx *pulumi.Context, typ, name string, inputs pulumiprovider.ConstructInputs,
+			options pulumi.ResourceOption) (*pulumiprovider.ConstructResult, error) {
+			return construct(ctx, p.config, typ, name, inputs, options)
+		})
+}
+
+func (p *productionAppProvider) Invoke(ctx context.Context,
+	req *pulumirpc.InvokeRequest) (*pulumirpc.InvokeResponse, error) {
+	label := fmt.Sprintf("%s.Invoke(%s)", p.name, req.GetTok())
+	inputs, err := plugin.UnmarshalProperties(req.GetArgs(), plugin.MarshalOptions{
+		Label:        label,
+		KeepUnknowns: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outputs, err := invoke(p.config, req.GetTok(), inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := plugin.MarshalProperties(outputs, plugin.MarshalOptions{Label: label})
+	if err != nil {
+		return nil, err
+	}
+	return &pulumirpc.InvokeResponse{Return: ret}, nil
+}
+
+func (p *productionAppProvider) Call(ctx context.Context,
+	req *pulumirpc.CallRequest) (*pulumirpc.CallResponse, error) {
+	return pulumiprovider.Call(ctx, req, p.host.EngineConn(), call)
+}
+
+func (p *productionAppProvider) Cancel(context.Context, *pbempty.Empty) (*pbempty.Empty, error) {
+	return &pbempty.Empty{}, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	// defaultPreStopSleepSeconds gives load balancers time to stop routing to a pod that is
+	// shutting down before the application receives SIGTERM.
+	defaultPreStopSleepSeconds = 10
+	// defaultShutdownSeconds is the time the application has to drain after SIGTERM.
+	defaultShutdownSeconds = 30
+)
+
+// Shutdown configures how the application's pods are stopped.
+type Shutdown struct {
+	// How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30
+	// seconds.
+	TerminationGracePeriodSeconds *int `pulumi:"terminationGracePeriodSeconds"`
+	// How long to sleep in the preStop hook before the application receives SIGTERM. Requires a
+	// `sleep` binary in the image. Set to 0 to disable the hook.
+	PreStopSleepSeconds *int `pulumi:"preStopSleepSeconds"`
+	// A command to run in the preStop hook instead of sleeping.
+	PreStopCommand []string `pulumi:"preStopCommand"`
+}
+
+// shutdownSettings returns the preStop lifecycle hook and termination grace period for the
+// application container. When the application sits behind a load balancer, pods sleep before
+// stopping by default so endpoint deregistration can propagate.
+func shutdownSettings(shutdown *Shutdown, behindLoadBalancer bool) (corev1.LifecyclePtrInput, pulumi.IntPtrInput, error) {
+	if shutdown == nil {
+		shutdown = &Shutdown{}
+	}
+	if shutdown.PreStopSleepSeconds != nil && len(shutdown.PreStopCommand) > 0 {
+		return nil, nil, fmt.Errorf("only one of preStopSleepSeconds and preStopCommand may be set")
+	}
+
+	sleep := 0
+	if shutdown.PreStopSleepSeconds != nil {
+		sleep = *shutdown.PreStopSleepSeconds
+	} else if behindLoadBalancer && len(shutdown.PreStopCommand) == 0 {
+		sleep = defaultPreStopSleepSeconds
+	}
+	if sleep < 0 {
+		return nil, nil, fmt.Errorf("preStopSleepSeconds must not be negative, got %d", sleep)
+	}
+
+	command := shutdown.PreStopCommand
+	if len(command) == 0 && sleep > 0 {
+		command = []string{"sleep", strconv.Itoa(sleep)}
+	}
+
+	var lifecycle corev1.LifecyclePtrInput
+	if len(command) > 0 {
+		lifecycle = &corev1.LifecycleArgs{
+			PreStop: &corev1.LifecycleHandlerArgs{
+				Exec: &corev1.ExecActionArgs{
+					Command: pulumi.ToStringArray(command),
+				},
+			},
+		}
+	}
+
+	// The grace period covers the preStop hook as well as the application's own shutdown, so
+	// the default grows with the sleep.
+	gracePeriod := sleep + defaultShutdownSeconds
+	if shutdown.TerminationGracePeriodSeconds != nil {
+		gracePeriod = *shutdown.TerminationGracePeriodSeconds
+		if gracePeriod <= sleep {
+			return nil, nil, fmt.Errorf("terminationGracePeriodSeconds (%d) must be longer than the preStop sleep (%d)",
+				gracePeriod, sleep)
+		}
+	}
+
+	return lifecycle, pulumi.IntPtr(gracePeriod), nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+const (
+	defaultSmokeTestPath            = "/"
+	defaultSmokeTestStatus          = http.StatusOK
+	defaultSmokeTestRetries         = 10
+	defaultSmokeTestIntervalSeconds = 5
+
+	smokeTestRequestTimeout = 10 * time.Second
+	// smokeTestMaxBody is how much of a response's body is searched for the expected text.
+	smokeTestMaxBody = 1 << 20
+)
+
+// SmokeTest is an HTTP GET request made to the application's URL once it is deployed. It is not
+// run during previews.
+type SmokeTest struct {
+	// The path to request. Defaults to `/`.
+	Path *string `pulumi:"path"`
+	// The status code the response must have. Defaults to 200.
+	ExpectedStatus *int `pulumi:"expectedStatus"`
+	// Text the response's body must contain.
+	BodyContains *string `pulumi:"bodyContains"`
+	// How many times to retry the request before failing. Defaults to 10.
+	Retries *int `pulumi:"retries"`
+	// How long to wait between attempts. Defaults to 5 seconds.
+	IntervalSeconds *int `pulumi:"intervalSeconds"`
+}
+
+// smokeTestClient makes the smoke tests' requests.
+var smokeTestClient = http.DefaultClient
+
+// validate checks the smoke test's settings. The test is run from the engine, so the application
+// must be reachable from outside the cluster, through a LoadBalancer Service or an ingress, and
+// its URL must be known once the Service is ready.
+func (t *SmokeTest) validate(serviceType string, ingress *Ingress, await *Await) error {
+	if !behindLoadBalancer(serviceType, ingress) {
+		return fmt.Errorf("smokeTest requires a LoadBalancer Service or an ingress, a %s Service "+
+			"is only reachable from inside the cluster", serviceType)
+	}
+	if await != nil && await.WaitForLoadBalancer != nil && !*await.WaitForLoadBalancer {
+		return fmt.Errorf("smokeTest requires await.waitForLoadBalancer, without it the application's " +
+			"URL may not be known when the test runs")
+	}
+	if t.Path != nil && !strings.HasPrefix(*t.Path, "/") {
+		return fmt.Errorf("smokeTest.path must start with '/', got %q", *t.Path)
+	}
+	if t.Retries != nil && *t.Retries < 0 {
+		return fmt.Errorf("smokeTest.retries must not be negative, got %d", *t.Retries)
+	}
+	if t.IntervalSeconds != nil && *t.IntervalSeconds < 0 {
+		return fmt.Errorf("smokeTest.intervalSeconds must not be negative, got %d", *t.IntervalSeconds)
+	}
+	return nil
+}
+
+// smokeTestOutput runs the smoke test against url once the application's resources are
+// ready, using client, resolving to url if it passes and failing the update with a diagnostic
+// otherwise. Previews don't run it.
+func smokeTestOutput(ctx *pulumi.Context, client *http.Client, test *SmokeTest, url pulumi.StringOutput,
+	ready []pulumi.Resource, resource pulumi.Resource) pulumi.StringOutput {
+	deps := []interface{}{url}
+	for _, r := range ready {
+		if custom, ok := r.(pulumi.CustomResource); ok {
+			deps = append(deps, custom.ID())
+		}
+	}
+	return pulumi.All(deps...).ApplyTWithContext(context.Background(), func(goCtx context.Context,
+		v []interface{}) (string, error) {
+		url := v[0].(string)
+		if ctx.DryRun() {
+			return url, nil
+		}
+		if err := runSmokeTest(goCtx, client, url, test); err != nil {
+			err = fmt.Errorf("smoke test failed: %v", err)
+			if logErr := ctx.Log.Error(err.Error(), &pulumi.LogArgs{Resource: resource}); logErr != nil {
+				return "", logErr
+			}
+			return "", err
+		}
+		return url, nil
+	}).(pulumi.StringOutput)
+}
+
+// runSmokeTest requests the test's path from url until the response matches or the retries are
+// exhausted, returning the last mismatch.
+func runSmokeTest(ctx context.Context, client *http.Client, url string, test *SmokeTest) error {
+	if url == "" {
+		return fmt.Errorf("the application has no URL, the load balancer may not be ready")
+	}
+
+	path, status := defaultSmokeTestPath, defaultSmokeTestStatus
+	retries, interval := defaultSmokeTestRetries, defaultSmokeTestIntervalSeconds
+	if test.Path != nil {
+		path = *test.Path
+	}
+	if test.ExpectedStatus != nil {
+		status = *test.ExpectedStatus
+	}
+	if test.Retries != nil {
+		retries = *test.Retries
+	}
+	if test.IntervalSeconds != nil {
+		interval = *test.IntervalSeconds
+	}
+	target := strings.TrimSuffix(url, "/") + path
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(interval) * time.Second):
+			}
+		}
+		if err = probe(ctx, client, target, status, test.BodyContains); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("GET %s: %v after %d attempts", target, err, retries+1)
+}
+
+// probe makes a single request to target and checks the response.
+func probe(ctx context.Context, client *http.Client, target string, status int, bodyContains *string) error {
+	ctx, cancel := context.WithTimeout(ctx, smokeTestRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != status {
+		return fmt.Errorf("expected status %d, got %d", status, resp.StatusCode)
+	}
+	if bodyContains != nil {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, smokeTestMaxBody))
+		if err != nil {
+			return fmt.Errorf("reading body: %v", err)
+		}
+		if !strings.Contains(string(body), *bodyContains) {
+			return fmt.Errorf("expected the body to contain %q", *bodyContains)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+func intPtr(i int) *int { return &i }
+
+func stringPtr(s string) *string { return &s }
+
+// smokeTestServer serves GET /health, answering the first failures requests with 503 and the
+// rest with status and body.
+func smokeTestServer(t *testing.T, failures int32, status int, body string) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/health" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if atomic.AddInt32(&requests, 1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestRunSmokeTest(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int32
+		status   int
+		body     string
+		test     SmokeTest
+		requests int32
+		err      string
+	}{
+		{
+			name:     "status mismatch",
+			status:   http.StatusNotFound,
+			test:     SmokeTest{Retries: intPtr(2)},
+			requests: 3,
+			err:      "expected status 200, got 404 after 3 attempts",
+		},
+		{
+			name:     "expected status",
+			status:   http.StatusNoContent,
+			test:     SmokeTest{ExpectedStatus: intPtr(http.StatusNoContent)},
+			requests: 1,
+		},
+		{
+			name:     "body mismatch",
+			status:   http.StatusOK,
+			body:     "starting",
+			test:     SmokeTest{BodyContains: stringPtr("ok"), Retries: intPtr(1)},
+			requests: 2,
+			err:      `expected the body to contain "ok" after 2 attempts`,
+		},
+		{
+			name:     "retry then success",
+			failures: 2,
+			status:   http.StatusOK,
+			body:     `{"status":"ok"}`,
+			test:     SmokeTest{BodyContains: stringPtr("ok"), Retries: intPtr(3)},
+			requests: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := smokeTestServer(t, tt.failures, tt.status, tt.body)
+			tt.test.Path = stringPtr("/health")
+			tt.test.IntervalSeconds = intPtr(0)
+
+			err := runSmokeTest(context.Background(), server.Client(), server.URL+"/", &tt.test)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.err != "" && err == nil:
+				t.Fatalf("expected an error containing %q", tt.err)
+			case tt.err != "" && !strings.Contains(err.Error(), tt.err):
+				t.Fatalf("expected an error containing %q, got %q", tt.err, err)
+			}
+			if got := atomic.LoadInt32(requests); got != tt.requests {
+				t.Errorf("expected %d requests, got %d", tt.requests, got)
+			}
+		})
+	}
+}
+
+func TestRunSmokeTestCancelled(t *testing.T) {
+	server, requests := smokeTestServer(t, 1000, http.StatusOK, "")
+	test := &SmokeTest{Path: stringPtr("/health"), Retries: intPtr(10), IntervalSeconds: intPtr(60)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- runSmokeTest(ctx, server.Client(), server.URL, test) }()
+	for atomic.LoadInt32(requests) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected the smoke test to be cancelled, got %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("the smoke test didn't stop when cancelled")
+	}
+	if got := atomic.LoadInt32(requests); got != 1 {
+		t.Errorf("expected 1 request, got %d", got)
+	}
+}
+
+func TestSmokeTestValidate(t *testing.T) {
+	test := &SmokeTest{}
+	if err := test.validate(serviceTypeLoadBalancer, nil, nil); err != nil {
+		t.Errorf("unexpected error for a LoadBalancer Service: %v", err)
+	}
+	if err := test.validate(serviceTypeClusterIP, &Ingress{}, nil); err != nil {
+		t.Errorf("unexpected error for an ingress: %v", err)
+	}
+	for _, svcType := range []string{serviceTypeClusterIP, serviceTypeNodePort} {
+		if err := test.validate(svcType, nil, nil); err == nil {
+			t.Errorf("expected an error for a %s Service without an ingress", svcType)
+		}
+	}
+	noWait := &Await{WaitForLoadBalancer: pulumi.BoolRef(false)}
+	if err := test.validate(serviceTypeLoadBalancer, nil, noWait); err == nil {
+		t.Errorf("expected an error when the load balancer isn't awaited")
+	}
+}
+
+// TestSmokeTestDeployment runs a Deployment's smoke test against a server standing in for its
+// load balancer.
+func TestSmokeTestDeployment(t *testing.T) {
+	tests := []struct {
+		name     string
+		dryRun   bool
+		status   int
+		requests int32
+		err      string
+	}{
+		{name: "pass", status: http.StatusOK, requests: 1},
+		{name: "fail", status: http.StatusInternalServerError, requests: 1,
+			err: "smoke test failed: GET http://192.0.2.1/health: expected status 200, got 500 after 1 attempts"},
+		{name: "preview", dryRun: true, status: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, requests := smokeTestServer(t, 0, tt.status, "")
+			// Requests to the load balancer's address reach the server instead.
+			client := &http.Client{Transport: &http.Transport{
+				DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+				},
+			}}
+			defer func(c *http.Client) { smokeTestClient = c }(smokeTestClient)
+			smokeTestClient = client
+
+			var url string
+			err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+				app, err := NewProductionApp(ctx, "app", &ProductionAppArgs{
+					Image: pulumi.String("nginx"),
+					Port:  pulumi.Int(80),
+					SmokeTest: &SmokeTest{
+						Path:    stringPtr("/health"),
+						Retries: intPtr(0),
+					},
+				})
+				if err != nil {
+					return err
+				}
+				app.Url.ApplyT(func(u string) string {
+					url = u
+					return u
+				})
+				return nil
+			}, pulumi.WithMocks("project", "stack", newMocks()), func(info *pulumi.RunInfo) {
+				info.DryRun = tt.dryRun
+			})
+
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.err != "" && err == nil:
+				t.Fatalf("expected an error containing %q", tt.err)
+			case tt.err != "" && !strings.Contains(err.Error(), tt.err):
+				t.Fatalf("expected an error containing %q, got %q", tt.err, err)
+			}
+			if tt.err == "" && url != "http://192.0.2.1" {
+				t.Errorf("expected the application's URL, got %q", url)
+			}
+			if got := atomic.LoadInt32(requests); got != tt.requests {
+				t.Errorf("expected %d requests, got %d", tt.requests, got)
+			}
+		})
+	}
+}
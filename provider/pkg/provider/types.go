@@ -0,0 +1,314 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Object types that may carry secrets or outputs from other resources need the same
+// Input/Output plumbing the generated SDKs have, so that `inputs.CopyTo` can decode
+// them without losing secretness or dependencies.
+
+// DatabaseConnection describes how an application connects to a database.
+type DatabaseConnection struct {
+	// The connection string for the database, such as the `connectionString` output of a
+	// `Database`.
+	ConnectionString string `pulumi:"connectionString" schema:"required,secret"`
+}
+
+type DatabaseConnectionInput interface {
+	pulumi.Input
+
+	ToDatabaseConnectionOutput() DatabaseConnectionOutput
+	ToDatabaseConnectionOutputWithContext(context.Context) DatabaseConnectionOutput
+}
+
+type DatabaseConnectionArgs struct {
+	ConnectionString pulumi.StringInput `pulumi:"connectionString"`
+}
+
+func (DatabaseConnectionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DatabaseConnection)(nil)).Elem()
+}
+
+func (i DatabaseConnectionArgs) ToDatabaseConnectionOutput() DatabaseConnectionOutput {
+	return i.ToDatabaseConnectionOutputWithContext(context.Background())
+}
+
+func (i DatabaseConnectionArgs) ToDatabaseConnectionOutputWithContext(ctx context.Context) DatabaseConnectionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseConnectionOutput)
+}
+
+type DatabaseConnectionOutput struct{ *pulumi.OutputState }
+
+func (DatabaseConnectionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DatabaseConnection)(nil)).Elem()
+}
+
+func (o DatabaseConnectionOutput) ToDatabaseConnectionOutput() DatabaseConnectionOutput {
+	return o
+}
+
+func (o DatabaseConnectionOutput) ToDatabaseConnectionOutputWithContext(ctx context.Context) DatabaseConnectionOutput {
+	return o
+}
+
+func (o DatabaseConnectionOutput) ConnectionString() pulumi.StringOutput {
+	return o.ApplyT(func(v DatabaseConnection) string { return v.ConnectionString }).(pulumi.StringOutput)
+}
+
+// CacheConnection describes how an application connects to a cache.
+type CacheConnection struct {
+	// The hostname of the cache, such as the `host` output of a `Cache`.
+	Host string `pulumi:"host" schema:"required"`
+	// The port of the cache, such as the `port` output of a `Cache`.
+	Port int `pulumi:"port" schema:"required"`
+	// The connection string for the cache, such as the `connectionString` output of a `Cache`.
+	ConnectionString string `pulumi:"connectionString" schema:"required,secret"`
+}
+
+type CacheConnectionInput interface {
+	pulumi.Input
+
+	ToCacheConnectionOutput() CacheConnectionOutput
+	ToCacheConnectionOutputWithContext(context.Context) CacheConnectionOutput
+}
+
+type CacheConnectionArgs struct {
+	Host             pulumi.StringInput `pulumi:"host"`
+	Port             pulumi.IntInput    `pulumi:"port"`
+	ConnectionString pulumi.StringInput `pulumi:"connectionString"`
+}
+
+func (CacheConnectionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CacheConnection)(nil)).Elem()
+}
+
+func (i CacheConnectionArgs) ToCacheConnectionOutput() CacheConnectionOutput {
+	return i.ToCacheConnectionOutputWithContext(context.Background())
+}
+
+func (i CacheConnectionArgs) ToCacheConnectionOutputWithContext(ctx context.Context) CacheConnectionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CacheConnectionOutput)
+}
+
+type CacheConnectionOutput struct{ *pulumi.OutputState }
+
+func (CacheConnectionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CacheConnection)(nil)).Elem()
+}
+
+func (o CacheConnectionOutput) ToCacheConnectionOutput() CacheConnectionOutput {
+	return o
+}
+
+func (o CacheConnectionOutput) ToCacheConnectionOutputWithContext(ctx context.Context) CacheConnectionOutput {
+	return o
+}
+
+func (o CacheConnectionOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v CacheConnection) string { return v.Host }).(pulumi.StringOutput)
+}
+
+func (o CacheConnectionOutput) Port() pulumi.IntOutput {
+	return o.ApplyT(func(v CacheConnection) int { return v.Port }).(pulumi.IntOutput)
+}
+
+func (o CacheConnectionOutput) ConnectionString() pulumi.StringOutput {
+	return o.ApplyT(func(v CacheConnection) string { return v.ConnectionString }).(pulumi.StringOutput)
+}
+
+// RegistryCredentials are the credentials the application's pods pull images with.
+type RegistryCredentials struct {
+	// The registry server, such as `ghcr.io`.
+	Server string `pulumi:"server"`
+	// The username to authenticate with.
+	Username string `pulumi:"username"`
+	// The password or token to authenticate with.
+	Password string `pulumi:"password" schema:"secret"`
+	// The name of an existing image pull secret in the application namespace, instead of `server`,
+	// `username` and `password`.
+	ExistingSecretName string `pulumi:"existingSecretName"`
+}
+
+type RegistryCredentialsInput interface {
+	pulumi.Input
+
+	ToRegistryCredentialsOutput() RegistryCredentialsOutput
+	ToRegistryCredentialsOutputWithContext(context.Context) RegistryCredentialsOutput
+}
+
+type RegistryCredentialsArgs struct {
+	Server             pulumi.StringInput `pulumi:"server"`
+	Username           pulumi.StringInput `pulumi:"username"`
+	Password           pulumi.StringInput `pulumi:"password"`
+	ExistingSecretName pulumi.StringInput `pulumi:"existingSecretName"`
+}
+
+func (RegistryCredentialsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*RegistryCredentials)(nil)).Elem()
+}
+
+func (i RegistryCredentialsArgs) ToRegistryCredentialsOutput() RegistryCredentialsOutput {
+	return i.ToRegistryCredentialsOutputWithContext(context.Background())
+}
+
+func (i RegistryCredentialsArgs) ToRegistryCredentialsOutputWithContext(ctx context.Context) RegistryCredentialsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RegistryCredentialsOutput)
+}
+
+type RegistryCredentialsOutput struct{ *pulumi.OutputState }
+
+func (RegistryCredentialsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RegistryCredentials)(nil)).Elem()
+}
+
+func (o RegistryCredentialsOutput) ToRegistryCredentialsOutput() RegistryCredentialsOutput {
+	return o
+}
+
+func (o RegistryCredentialsOutput) ToRegistryCredentialsOutputWithContext(ctx context.Context) RegistryCredentialsOutput {
+	return o
+}
+
+func (o RegistryCredentialsOutput) Server() pulumi.StringOutput {
+	return o.ApplyT(func(v RegistryCredentials) string { return v.Server }).(pulumi.StringOutput)
+}
+
+func (o RegistryCredentialsOutput) Username() pulumi.StringOutput {
+	return o.ApplyT(func(v RegistryCredentials) string { return v.Username }).(pulumi.StringOutput)
+}
+
+func (o RegistryCredentialsOutput) Password() pulumi.StringOutput {
+	return o.ApplyT(func(v RegistryCredentials) string { return v.Password }).(pulumi.StringOutput)
+}
+
+func (o RegistryCredentialsOutput) ExistingSecretName() pulumi.StringOutput {
+	return o.ApplyT(func(v RegistryCredentials) string { return v.ExistingSecretName }).(pulumi.StringOutput)
+}
+
+// Workload is where the application runs in one cluster.
+type Workload struct {
+	// The name of the cluster, when the application is deployed to several.
+	Cluster *string `pulumi:"cluster"`
+	// The kubeconfig context the application was deployed with.
+	Context *string `pulumi:"context"`
+	// The contents of the kubeconfig file, or the path to it, the application was deployed with.
+	Kubeconfig *string `pulumi:"kubeconfig" schema:"secret"`
+	// The namespace the application runs in.
+	Namespace string `pulumi:"namespace" schema:"required"`
+	// The name of the application's Deployment.
+	Deployment string `pulumi:"deployment" schema:"required"`
+	// The URL of the application's Service.
+	Url string `pulumi:"url" schema:"required"`
+}
+
+type WorkloadInput interface {
+	pulumi.Input
+
+	ToWorkloadOutput() WorkloadOutput
+	ToWorkloadOutputWithContext(context.Context) WorkloadOutput
+}
+
+type WorkloadArgs struct {
+	Cluster    pulumi.StringPtrInput `pulumi:"cluster"`
+	Context    pulumi.StringPtrInput `pulumi:"context"`
+	Kubeconfig pulumi.StringPtrInput `pulumi:"kubeconfig"`
+	Namespace  pulumi.StringInput    `pulumi:"namespace"`
+	Deployment pulumi.StringInput    `pulumi:"deployment"`
+	Url        pulumi.StringInput    `pulumi:"url"`
+}
+
+func (WorkloadArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Workload)(nil)).Elem()
+}
+
+func (i WorkloadArgs) ToWorkloadOutput() WorkloadOutput {
+	return i.ToWorkloadOutputWithContext(context.Background())
+}
+
+func (i WorkloadArgs) ToWorkloadOutputWithContext(ctx context.Context) WorkloadOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WorkloadOutput)
+}
+
+type WorkloadOutput struct{ *pulumi.OutputState }
+
+func (WorkloadOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Workload)(nil)).Elem()
+}
+
+func (o WorkloadOutput) ToWorkloadOutput() WorkloadOutput {
+	return o
+}
+
+func (o WorkloadOutput) ToWorkloadOutputWithContext(ctx context.Context) WorkloadOutput {
+	return o
+}
+
+func (o WorkloadOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v Workload) string { return v.Url }).(pulumi.StringOutput)
+}
+
+type WorkloadArrayInput interface {
+	pulumi.Input
+
+	ToWorkloadArrayOutput() WorkloadArrayOutput
+	ToWorkloadArrayOutputWithContext(context.Context) WorkloadArrayOutput
+}
+
+type WorkloadArray []WorkloadInput
+
+func (WorkloadArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Workload)(nil)).Elem()
+}
+
+func (i WorkloadArray) ToWorkloadArrayOutput() WorkloadArrayOutput {
+	return i.ToWorkloadArrayOutputWithContext(context.Background())
+}
+
+func (i WorkloadArray) ToWorkloadArrayOutputWithContext(ctx context.Context) WorkloadArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(WorkloadArrayOutput)
+}
+
+type WorkloadArrayOutput struct{ *pulumi.OutputState }
+
+func (WorkloadArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Workload)(nil)).Elem()
+}
+
+func (o WorkloadArrayOutput) ToWorkloadArrayOutput() WorkloadArrayOutput {
+	return o
+}
+
+func (o WorkloadArrayOutput) ToWorkloadArrayOutputWithContext(ctx context.Context) WorkloadArrayOutput {
+	return o
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseConnectionInput)(nil)).Elem(), DatabaseConnectionArgs{})
+	pulumi.RegisterOutputType(DatabaseConnectionOutput{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CacheConnectionInput)(nil)).Elem(), CacheConnectionArgs{})
+	pulumi.RegisterOutputType(CacheConnectionOutput{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RegistryCredentialsInput)(nil)).Elem(), RegistryCredentialsArgs{})
+	pulumi.RegisterOutputType(RegistryCredentialsOutput{})
+	pulumi.RegisterInputType(reflect.TypeOf((*WorkloadInput)(nil)).Elem(), WorkloadArgs{})
+	pulumi.RegisterOutputType(WorkloadOutput{})
+	pulumi.RegisterInputType(reflect.TypeOf((*WorkloadArrayInput)(nil)).Elem(), WorkloadArray{})
+	pulumi.RegisterOutputType(WorkloadArrayOutput{})
+}
@@ -0,0 +1,43 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// optionalString converts an optional plain input to a pulumi input, leaving it unset when nil.
+func optionalString(v *string) pulumi.StringPtrInput {
+	if v == nil {
+		return nil
+	}
+	return pulumi.StringPtr(*v)
+}
+
+// optionalBool converts an optional plain input to a pulumi input, leaving it unset when nil.
+func optionalBool(v *bool) pulumi.BoolPtrInput {
+	if v == nil {
+		return nil
+	}
+	return pulumi.BoolPtr(*v)
+}
+
+// optionalInt converts an optional plain input to a pulumi input, leaving it unset when nil.
+func optionalInt(v *int) pulumi.IntPtrInput {
+	if v == nil {
+		return nil
+	}
+	return pulumi.IntPtr(*v)
+}
@@ -0,0 +1,159 @@
+// Copyright 2016-2021, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+
+	corev1 "github.com/pulumi/pulumi-kubernetes/sdk/v3/go/kubernetes/core/v1"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Volume is a volume that can be mounted into the application container. Exactly one of
+// `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+type Volume struct {
+	// The name of the volume, referenced by `volumeMounts`.
+	Name string `pulumi:"name" schema:"required"`
+	// A scratch directory that lives as long as the pod.
+	EmptyDir *EmptyDirVolume `pulumi:"emptyDir"`
+	// The contents of a ConfigMap.
+	ConfigMap *ConfigMapVolume `pulumi:"configMap"`
+	// The contents of a Secret.
+	Secret *SecretVolume `pulumi:"secret"`
+	// A path on the node. Requires `allowHostPath`.
+	HostPath *HostPathVolume `pulumi:"hostPath"`
+}
+
+// EmptyDirVolume is a scratch directory that lives as long as the pod.
+type EmptyDirVolume struct {
+	// The maximum size of the directory, such as `1Gi`.
+	SizeLimit *string `pulumi:"sizeLimit"`
+	// Set to `Memory` to back the directory with tmpfs.
+	Medium *string `pulumi:"medium"`
+}
+
+// ConfigMapVolume is a volume populated from a ConfigMap.
+type ConfigMapVolume struct {
+	// The name of the ConfigMap in the application namespace.
+	Name string `pulumi:"name" schema:"required"`
+}
+
+// SecretVolume is a volume populated from a Secret.
+type SecretVolume struct {
+	// The name of the Secret in the application namespace.
+	SecretName string `pulumi:"secretName" schema:"required"`
+}
+
+// HostPathVolume is a volume backed by a path on the node.
+type HostPathVolume struct {
+	// The path on the node.
+	Path string `pulumi:"path" schema:"required"`
+	// The type of the path, such as `Directory`.
+	Type *string `pulumi:"type"`
+}
+
+// VolumeMount mounts one of the application's volumes into its container.
+type VolumeMount struct {
+	// The name of the volume to mount.
+	Name string `pulumi:"name" schema:"required"`
+	// Where to mount the volume.
+	MountPath string `pulumi:"mountPath" schema:"required"`
+	// A path within the volume to mount instead of its root.
+	SubPath *string `pulumi:"subPath"`
+	// Whether to mount the volume read-only.
+	ReadOnly *bool `pulumi:"readOnly"`
+}
+
+// podVolumes converts the component's volumes and mounts to their Kubernetes equivalents,
+// validating that each volume has exactly one source, that every mount refers to a volume
+// and that hostPath volumes have been explicitly allowed.
+func podVolumes(volumes []Volume, mounts []VolumeMount,
+	allowHostPath bool) (corev1.VolumeArrayInput, corev1.VolumeMountArrayInput, error) {
+	if len(volumes) == 0 && len(mounts) == 0 {
+		return nil, nil, nil
+	}
+
+	names := map[string]bool{}
+	podVolumes := corev1.VolumeArray{}
+	for i, v := range volumes {
+		if v.Name == "" {
+			return nil, nil, fmt.Errorf("volumes[%d]: name is required", i)
+		}
+		if names[v.Name] {
+			return nil, nil, fmt.Errorf("volumes[%d]: duplicate volume name %q", i, v.Name)
+		}
+		names[v.Name] = true
+
+		volume := &corev1.VolumeArgs{
+			Name: pulumi.String(v.Name),
+		}
+		sources := 0
+		if v.EmptyDir != nil {
+			sources++
+			if v.EmptyDir.Medium != nil && *v.EmptyDir.Medium != "" && *v.EmptyDir.Medium != "Memory" {
+				return nil, nil, fmt.Errorf("volumes[%d]: emptyDir medium must be empty or \"Memory\", got %q",
+					i, *v.EmptyDir.Medium)
+			}
+			volume.EmptyDir = &corev1.EmptyDirVolumeSourceArgs{
+				SizeLimit: optionalString(v.EmptyDir.SizeLimit),
+				Medium:    optionalString(v.EmptyDir.Medium),
+			}
+		}
+		if v.ConfigMap != nil {
+			sources++
+			volume.ConfigMap = &corev1.ConfigMapVolumeSourceArgs{
+				Name: pulumi.String(v.ConfigMap.Name),
+			}
+		}
+		if v.Secret != nil {
+			sources++
+			volume.Secret = &corev1.SecretVolumeSourceArgs{
+				SecretName: pulumi.String(v.Secret.SecretName),
+			}
+		}
+		if v.HostPath != nil {
+			sources++
+			if !allowHostPath {
+				return nil, nil, fmt.Errorf("volumes[%d]: hostPath volumes are denied unless allowHostPath is set", i)
+			}
+			volume.HostPath = &corev1.HostPathVolumeSourceArgs{
+				Path: pulumi.String(v.HostPath.Path),
+				Type: optionalString(v.HostPath.Type),
+			}
+		}
+		if sources != 1 {
+			return nil, nil, fmt.Errorf("volumes[%d]: exactly one of emptyDir, configMap, secret or hostPath must be set", i)
+		}
+		podVolumes = append(podVolumes, volume)
+	}
+
+	volumeMounts := corev1.VolumeMountArray{}
+	for i, m := range mounts {
+		if !names[m.Name] {
+			return nil, nil, fmt.Errorf("volumeMounts[%d]: unknown volume %q", i, m.Name)
+		}
+		if m.MountPath == "" {
+			return nil, nil, fmt.Errorf("volumeMounts[%d]: mountPath is required", i)
+		}
+		volumeMounts = append(volumeMounts, &corev1.VolumeMountArgs{
+			Name:      pulumi.String(m.Name),
+			MountPath: pulumi.String(m.MountPath),
+			SubPath:   optionalString(m.SubPath),
+			ReadOnly:  optionalBool(m.ReadOnly),
+		})
+	}
+
+	return podVolumes, volumeMounts, nil
+}
@@ -0,0 +1,100 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp
+{
+    /// <summary>
+    /// A Redis cache running in the cluster.
+    /// </summary>
+    [ProductionappResourceType("productionapp:index:Cache")]
+    public partial class Cache : Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// A redis:// connection string for the cache
+        /// </summary>
+        [Output("connectionString")]
+        public Output<string> ConnectionString { get; private set; } = null!;
+
+        /// <summary>
+        /// The in-cluster hostname of the cache
+        /// </summary>
+        [Output("host")]
+        public Output<string> Host { get; private set; } = null!;
+
+        /// <summary>
+        /// The generated password for the cache, when authentication is enabled
+        /// </summary>
+        [Output("password")]
+        public Output<string?> Password { get; private set; } = null!;
+
+        /// <summary>
+        /// The port the cache listens on
+        /// </summary>
+        [Output("port")]
+        public Output<int> Port { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Cache resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Cache(string name, CacheArgs? args = null, ComponentResourceOptions? options = null)
+            : base("productionapp:index:Cache", name, args ?? new CacheArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "connectionString",
+                    "password",
+                },
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class CacheArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Whether to require a generated password to connect to the cache
+        /// </summary>
+        [Input("enableAuth")]
+        public bool? EnableAuth { get; set; }
+
+        /// <summary>
+        /// The Redis image to run
+        /// </summary>
+        [Input("image")]
+        public Input<string>? Image { get; set; }
+
+        /// <summary>
+        /// The resource preset for the cache
+        /// </summary>
+        [Input("size")]
+        public Pulumi.Productionapp.Size? Size { get; set; }
+
+        public CacheArgs()
+        {
+            EnableAuth = false;
+            Image = "redis:6";
+            Size = Pulumi.Productionapp.Size.Small;
+        }
+    }
+}
@@ -0,0 +1,66 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Immutable;
+
+namespace Pulumi.Productionapp
+{
+    public static class Config
+    {
+        [System.Diagnostics.CodeAnalysis.SuppressMessage("Microsoft.Design", "IDE1006", Justification = 
+        "Double underscore prefix used to avoid conflicts with variable names.")]
+        private sealed class __Value<T>
+        {
+            private readonly Func<T> _getter;
+            private T _value = default!;
+            private bool _set;
+
+            public __Value(Func<T> getter)
+            {
+                _getter = getter;
+            }
+
+            public T Get() => _set ? _value : _getter();
+
+            public void Set(T value)
+            {
+                _value = value;
+                _set = true;
+            }
+        }
+
+        private static readonly Pulumi.Config __config = new Pulumi.Config("productionapp");
+
+        private static readonly __Value<ImmutableDictionary<string, string>?> _defaultLabels = new __Value<ImmutableDictionary<string, string>?>(() => __config.GetObject<ImmutableDictionary<string, string>>("defaultLabels"));
+        /// <summary>
+        /// Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+        /// </summary>
+        public static ImmutableDictionary<string, string>? DefaultLabels
+        {
+            get => _defaultLabels.Get();
+            set => _defaultLabels.Set(value);
+        }
+
+        private static readonly __Value<string?> _defaultRegistry = new __Value<string?>(() => __config.Get("defaultRegistry"));
+        /// <summary>
+        /// A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+        /// </summary>
+        public static string? DefaultRegistry
+        {
+            get => _defaultRegistry.Get();
+            set => _defaultRegistry.Set(value);
+        }
+
+        private static readonly __Value<string?> _environment = new __Value<string?>(() => __config.Get("environment"));
+        /// <summary>
+        /// The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+        /// </summary>
+        public static string? Environment
+        {
+            get => _environment.Get();
+            set => _environment.Set(value);
+        }
+
+    }
+}
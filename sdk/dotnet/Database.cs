@@ -0,0 +1,125 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp
+{
+    /// <summary>
+    /// A Postgres database running in the cluster, intended for development environments.
+    /// </summary>
+    [ProductionappResourceType("productionapp:index:Database")]
+    public partial class Database : Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// A postgresql:// connection string for the database
+        /// </summary>
+        [Output("connectionString")]
+        public Output<string> ConnectionString { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the database
+        /// </summary>
+        [Output("databaseName")]
+        public Output<string> DatabaseName { get; private set; } = null!;
+
+        /// <summary>
+        /// The in-cluster hostname of the database
+        /// </summary>
+        [Output("host")]
+        public Output<string> Host { get; private set; } = null!;
+
+        /// <summary>
+        /// The generated password for the database user
+        /// </summary>
+        [Output("password")]
+        public Output<string> Password { get; private set; } = null!;
+
+        /// <summary>
+        /// The port the database listens on
+        /// </summary>
+        [Output("port")]
+        public Output<int> Port { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the database user
+        /// </summary>
+        [Output("username")]
+        public Output<string> Username { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Database resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Database(string name, DatabaseArgs? args = null, ComponentResourceOptions? options = null)
+            : base("productionapp:index:Database", name, args ?? new DatabaseArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "connectionString",
+                    "password",
+                },
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class DatabaseArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of the database to create
+        /// </summary>
+        [Input("databaseName")]
+        public Input<string>? DatabaseName { get; set; }
+
+        /// <summary>
+        /// The Postgres image to run
+        /// </summary>
+        [Input("image")]
+        public Input<string>? Image { get; set; }
+
+        /// <summary>
+        /// The storage class for the persistent volume. Uses the cluster default when unset
+        /// </summary>
+        [Input("storageClassName")]
+        public Input<string>? StorageClassName { get; set; }
+
+        /// <summary>
+        /// The size of the persistent volume backing the database
+        /// </summary>
+        [Input("storageSize")]
+        public Input<string>? StorageSize { get; set; }
+
+        /// <summary>
+        /// The name of the database user to create
+        /// </summary>
+        [Input("username")]
+        public Input<string>? Username { get; set; }
+
+        public DatabaseArgs()
+        {
+            DatabaseName = "app";
+            Image = "postgres:14";
+            StorageSize = "1Gi";
+            Username = "app";
+        }
+    }
+}
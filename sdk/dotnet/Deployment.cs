@@ -9,15 +9,30 @@ using Pulumi.Serialization;
 
 namespace Pulumi.Productionapp
 {
+    /// <summary>
+    /// An application deployed to Kubernetes in a namespace of its own, behind a Service, with the availability settings its environment calls for.
+    /// </summary>
     [ProductionappResourceType("productionapp:index:Deployment")]
     public partial class Deployment : Pulumi.ComponentResource
     {
         /// <summary>
-        /// The URL from the generated service
+        /// The URL from the generated service, in the first cluster when deployed to several
         /// </summary>
         [Output("url")]
         public Output<string> Url { get; private set; } = null!;
 
+        /// <summary>
+        /// The URL of the application in each cluster, keyed by cluster name
+        /// </summary>
+        [Output("urls")]
+        public Output<ImmutableDictionary<string, string>> Urls { get; private set; } = null!;
+
+        /// <summary>
+        /// Where the application runs, one workload per cluster
+        /// </summary>
+        [Output("workloads")]
+        public Output<ImmutableArray<Outputs.Workload>> Workloads { get; private set; } = null!;
+
 
         /// <summary>
         /// Create a Deployment resource with the given unique name, arguments, and options.
@@ -42,24 +57,371 @@ namespace Pulumi.Productionapp
             merged.Id = id ?? merged.Id;
             return merged;
         }
+
+        /// <summary>
+        /// Returns kubectl commands for inspecting and operating the application. Commands for a workload deployed with the contents of a kubeconfig read them from the file named by `kubeconfig`, which the workload's `kubeconfig` must be saved to.
+        /// </summary>
+        public Pulumi.Output<DeploymentGetKubectlCommandsResult> GetKubectlCommands()
+            => Pulumi.Deployment.Instance.Call<DeploymentGetKubectlCommandsResult>("productionapp:index:Deployment/getKubectlCommands", CallArgs.Empty, this);
+
+        /// <summary>
+        /// Reads the live status of the application's Deployments with kubectl, using the kubeconfig and context each Deployment was deployed with.
+        /// </summary>
+        public Pulumi.Output<DeploymentGetStatusResult> GetStatus()
+            => Pulumi.Deployment.Instance.Call<DeploymentGetStatusResult>("productionapp:index:Deployment/getStatus", CallArgs.Empty, this);
+
+        /// <summary>
+        /// Restarts the application's pods with a rolling update, by bumping the `kubectl.kubernetes.io/restartedAt` annotation on the pod template of each of its Deployments. Runs kubectl with the kubeconfig and context each Deployment was deployed with. Previews don't restart anything.
+        /// </summary>
+        public Pulumi.Output<DeploymentRestartResult> Restart()
+            => Pulumi.Deployment.Instance.Call<DeploymentRestartResult>("productionapp:index:Deployment/restart", CallArgs.Empty, this);
     }
 
     public sealed class DeploymentArgs : Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+        /// </summary>
+        [Input("allowHostPath")]
+        public bool? AllowHostPath { get; set; }
+
+        [Input("annotations")]
+        private Dictionary<string, string>? _annotations;
+
+        /// <summary>
+        /// Annotations added to every resource the component creates
+        /// </summary>
+        public Dictionary<string, string> Annotations
+        {
+            get => _annotations ?? (_annotations = new Dictionary<string, string>());
+            set => _annotations = value;
+        }
+
+        [Input("args")]
+        private InputList<string>? _args;
+
+        /// <summary>
+        /// Overrides the arguments of the application image
+        /// </summary>
+        public InputList<string> Args
+        {
+            get => _args ?? (_args = new InputList<string>());
+            set => _args = value;
+        }
+
+        /// <summary>
+        /// Scales the application with a HorizontalPodAutoscaler
+        /// </summary>
+        [Input("autoscaling")]
+        public Inputs.AutoscalingArgs? Autoscaling { get; set; }
+
+        /// <summary>
+        /// How long to wait for the application to become ready
+        /// </summary>
+        [Input("await")]
+        public Inputs.AwaitArgs? Await { get; set; }
+
+        /// <summary>
+        /// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+        /// </summary>
+        [Input("cache")]
+        public Input<Inputs.CacheConnectionArgs>? Cache { get; set; }
+
+        [Input("clusters")]
+        private List<Inputs.ClusterArgs>? _clusters;
+
+        /// <summary>
+        /// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+        /// </summary>
+        public List<Inputs.ClusterArgs> Clusters
+        {
+            get => _clusters ?? (_clusters = new List<Inputs.ClusterArgs>());
+            set => _clusters = value;
+        }
+
+        [Input("command")]
+        private InputList<string>? _command;
+
+        /// <summary>
+        /// Overrides the entrypoint of the application image
+        /// </summary>
+        public InputList<string> Command
+        {
+            get => _command ?? (_command = new InputList<string>());
+            set => _command = value;
+        }
+
+        /// <summary>
+        /// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+        /// </summary>
+        [Input("context")]
+        public Input<string>? Context { get; set; }
+
+        /// <summary>
+        /// A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+        /// </summary>
+        [Input("database")]
+        public Input<Inputs.DatabaseConnectionArgs>? Database { get; set; }
+
+        /// <summary>
+        /// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+        /// </summary>
+        [Input("environment")]
+        public Pulumi.Productionapp.Environment? Environment { get; set; }
+
         /// <summary>
         /// The image to deploy in your production application
         /// </summary>
         [Input("image", required: true)]
         public Input<string> Image { get; set; } = null!;
 
+        /// <summary>
+        /// An Ingress that routes a host name to the application. The url becomes the Ingress's
+        /// </summary>
+        [Input("ingress")]
+        public Inputs.IngressArgs? Ingress { get; set; }
+
+        [Input("kubeconfig")]
+        private Input<string>? _kubeconfig;
+
+        /// <summary>
+        /// The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+        /// </summary>
+        public Input<string>? Kubeconfig
+        {
+            get => _kubeconfig;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _kubeconfig = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("labels")]
+        private Dictionary<string, string>? _labels;
+
+        /// <summary>
+        /// Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+        /// </summary>
+        public Dictionary<string, string> Labels
+        {
+            get => _labels ?? (_labels = new Dictionary<string, string>());
+            set => _labels = value;
+        }
+
+        /// <summary>
+        /// An HTTP check that restarts the application's container when it fails
+        /// </summary>
+        [Input("livenessProbe")]
+        public Inputs.ProbeArgs? LivenessProbe { get; set; }
+
+        [Input("patches")]
+        private Dictionary<string, Inputs.PatchArgs>? _patches;
+
+        /// <summary>
+        /// Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+        /// </summary>
+        public Dictionary<string, Inputs.PatchArgs> Patches
+        {
+            get => _patches ?? (_patches = new Dictionary<string, Inputs.PatchArgs>());
+            set => _patches = value;
+        }
+
+        [Input("podAnnotations")]
+        private Dictionary<string, string>? _podAnnotations;
+
+        /// <summary>
+        /// Annotations added to the application's pods
+        /// </summary>
+        public Dictionary<string, string> PodAnnotations
+        {
+            get => _podAnnotations ?? (_podAnnotations = new Dictionary<string, string>());
+            set => _podAnnotations = value;
+        }
+
         /// <summary>
         /// The port your container listens on
         /// </summary>
         [Input("port", required: true)]
         public Input<int> Port { get; set; } = null!;
 
+        /// <summary>
+        /// A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+        /// </summary>
+        [Input("preDeployJob")]
+        public Inputs.PreDeployJobArgs? PreDeployJob { get; set; }
+
+        /// <summary>
+        /// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+        /// </summary>
+        [Input("quota")]
+        public Inputs.QuotaArgs? Quota { get; set; }
+
+        /// <summary>
+        /// An HTTP check that must pass before the application's pods receive traffic
+        /// </summary>
+        [Input("readinessProbe")]
+        public Inputs.ProbeArgs? ReadinessProbe { get; set; }
+
+        /// <summary>
+        /// Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+        /// </summary>
+        [Input("registryCredentials")]
+        public Input<Inputs.RegistryCredentialsArgs>? RegistryCredentials { get; set; }
+
+        /// <summary>
+        /// Resource requests and limits for the application's container, overriding the ones the environment or size sets
+        /// </summary>
+        [Input("resources")]
+        public Inputs.ResourcesArgs? Resources { get; set; }
+
+        /// <summary>
+        /// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+        /// </summary>
+        [Input("sanitizeName")]
+        public bool? SanitizeName { get; set; }
+
+        /// <summary>
+        /// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+        /// </summary>
+        [Input("serviceType")]
+        public Pulumi.Productionapp.ServiceType? ServiceType { get; set; }
+
+        /// <summary>
+        /// How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+        /// </summary>
+        [Input("shutdown")]
+        public Inputs.ShutdownArgs? Shutdown { get; set; }
+
+        /// <summary>
+        /// The resource preset for the application's container, replacing the environment's resources
+        /// </summary>
+        [Input("size")]
+        public Pulumi.Productionapp.Size? Size { get; set; }
+
+        /// <summary>
+        /// An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+        /// </summary>
+        [Input("smokeTest")]
+        public Inputs.SmokeTestArgs? SmokeTest { get; set; }
+
+        [Input("volumeMounts")]
+        private List<Inputs.VolumeMountArgs>? _volumeMounts;
+
+        /// <summary>
+        /// Where to mount `volumes` in the application container
+        /// </summary>
+        public List<Inputs.VolumeMountArgs> VolumeMounts
+        {
+            get => _volumeMounts ?? (_volumeMounts = new List<Inputs.VolumeMountArgs>());
+            set => _volumeMounts = value;
+        }
+
+        [Input("volumes")]
+        private List<Inputs.VolumeArgs>? _volumes;
+
+        /// <summary>
+        /// Volumes available to the application container
+        /// </summary>
+        public List<Inputs.VolumeArgs> Volumes
+        {
+            get => _volumes ?? (_volumes = new List<Inputs.VolumeArgs>());
+            set => _volumes = value;
+        }
+
+        /// <summary>
+        /// The working directory of the application container
+        /// </summary>
+        [Input("workingDir")]
+        public Input<string>? WorkingDir { get; set; }
+
         public DeploymentArgs()
         {
+            AllowHostPath = false;
+            ServiceType = Pulumi.Productionapp.ServiceType.LoadBalancer;
+        }
+    }
+
+    /// <summary>
+    /// The results of the <see cref="Deployment.GetKubectlCommands"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class DeploymentGetKubectlCommandsResult
+    {
+        /// <summary>
+        /// The commands for each of the application's workloads
+        /// </summary>
+        public readonly ImmutableArray<Outputs.KubectlCommands> Commands;
+
+        [OutputConstructor]
+        private DeploymentGetKubectlCommandsResult(ImmutableArray<Outputs.KubectlCommands> commands)
+        {
+            Commands = commands;
+        }
+    }
+
+    /// <summary>
+    /// The results of the <see cref="Deployment.GetStatus"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class DeploymentGetStatusResult
+    {
+        /// <summary>
+        /// The number of available pods, across all clusters
+        /// </summary>
+        public readonly int AvailableReplicas;
+        /// <summary>
+        /// The status in each cluster, keyed by cluster name, when the application is deployed to several
+        /// </summary>
+        public readonly ImmutableDictionary<string, Outputs.ReplicaStatus> Clusters;
+        /// <summary>
+        /// The number of ready pods, across all clusters
+        /// </summary>
+        public readonly int ReadyReplicas;
+        /// <summary>
+        /// The number of pods, across all clusters
+        /// </summary>
+        public readonly int Replicas;
+        /// <summary>
+        /// The number of pods running the latest pod template, across all clusters
+        /// </summary>
+        public readonly int UpdatedReplicas;
+
+        [OutputConstructor]
+        private DeploymentGetStatusResult(
+            int availableReplicas,
+
+            ImmutableDictionary<string, Outputs.ReplicaStatus> clusters,
+
+            int readyReplicas,
+
+            int replicas,
+
+            int updatedReplicas)
+        {
+            AvailableReplicas = availableReplicas;
+            Clusters = clusters;
+            ReadyReplicas = readyReplicas;
+            Replicas = replicas;
+            UpdatedReplicas = updatedReplicas;
+        }
+    }
+
+    /// <summary>
+    /// The results of the <see cref="Deployment.Restart"/> method.
+    /// </summary>
+    [OutputType]
+    public sealed class DeploymentRestartResult
+    {
+        /// <summary>
+        /// When the application was restarted, in RFC 3339 format. Empty during previews
+        /// </summary>
+        public readonly string RestartedAt;
+
+        [OutputConstructor]
+        private DeploymentRestartResult(string restartedAt)
+        {
+            RestartedAt = restartedAt;
         }
     }
 }
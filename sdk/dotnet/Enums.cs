@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.ComponentModel;
+using Pulumi;
+
+namespace Pulumi.Productionapp
+{
+    /// <summary>
+    /// An environment tier, which selects replicas, resources and availability settings.
+    /// </summary>
+    [EnumType]
+    public readonly struct Environment : IEquatable<Environment>
+    {
+        private readonly string _value;
+
+        private Environment(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// One small replica
+        /// </summary>
+        public static Environment Dev { get; } = new Environment("dev");
+        /// <summary>
+        /// Two replicas spread across nodes with a PodDisruptionBudget
+        /// </summary>
+        public static Environment Staging { get; } = new Environment("staging");
+        /// <summary>
+        /// Three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace
+        /// </summary>
+        public static Environment Prod { get; } = new Environment("prod");
+
+        public static bool operator ==(Environment left, Environment right) => left.Equals(right);
+        public static bool operator !=(Environment left, Environment right) => !left.Equals(right);
+
+        public static explicit operator string(Environment value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is Environment other && Equals(other);
+        public bool Equals(Environment other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    /// <summary>
+    /// The type of Service the application is exposed through.
+    /// </summary>
+    [EnumType]
+    public readonly struct ServiceType : IEquatable<ServiceType>
+    {
+        private readonly string _value;
+
+        private ServiceType(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Only reachable from inside the cluster
+        /// </summary>
+        public static ServiceType ClusterIP { get; } = new ServiceType("ClusterIP");
+        /// <summary>
+        /// Reachable on a port of every node
+        /// </summary>
+        public static ServiceType NodePort { get; } = new ServiceType("NodePort");
+        /// <summary>
+        /// Reachable through a cloud load balancer
+        /// </summary>
+        public static ServiceType LoadBalancer { get; } = new ServiceType("LoadBalancer");
+
+        public static bool operator ==(ServiceType left, ServiceType right) => left.Equals(right);
+        public static bool operator !=(ServiceType left, ServiceType right) => !left.Equals(right);
+
+        public static explicit operator string(ServiceType value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ServiceType other && Equals(other);
+        public bool Equals(ServiceType other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    /// <summary>
+    /// A preset of container resource requests and limits.
+    /// </summary>
+    [EnumType]
+    public readonly struct Size : IEquatable<Size>
+    {
+        private readonly string _value;
+
+        private Size(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// 100m CPU and 128Mi memory requested, limited to 250m and 256Mi
+        /// </summary>
+        public static Size Small { get; } = new Size("small");
+        /// <summary>
+        /// 250m CPU and 512Mi memory requested, limited to 500m and 1Gi
+        /// </summary>
+        public static Size Medium { get; } = new Size("medium");
+        /// <summary>
+        /// 500m CPU and 2Gi memory requested, limited to 1 CPU and 4Gi
+        /// </summary>
+        public static Size Large { get; } = new Size("large");
+
+        public static bool operator ==(Size left, Size right) => left.Equals(right);
+        public static bool operator !=(Size left, Size right) => !left.Equals(right);
+
+        public static explicit operator string(Size value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is Size other && Equals(other);
+        public bool Equals(Size other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+}
@@ -0,0 +1,44 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp
+{
+    public static class GetPresets
+    {
+        /// <summary>
+        /// Returns the environment presets a Deployment can be deployed with and the sizes a Cache or a Deployment's container can have.
+        /// </summary>
+        public static Task<GetPresetsResult> InvokeAsync(InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<GetPresetsResult>("productionapp:index:getPresets", InvokeArgs.Empty, options.WithDefaults());
+    }
+
+
+    [OutputType]
+    public sealed class GetPresetsResult
+    {
+        /// <summary>
+        /// The environment presets, keyed by the name `environment` selects them with
+        /// </summary>
+        public readonly ImmutableDictionary<string, Outputs.EnvironmentPreset> Environments;
+        /// <summary>
+        /// The sizes, keyed by the name `size` selects them with
+        /// </summary>
+        public readonly ImmutableDictionary<string, Outputs.ResourcePreset> Sizes;
+
+        [OutputConstructor]
+        private GetPresetsResult(
+            ImmutableDictionary<string, Outputs.EnvironmentPreset> environments,
+
+            ImmutableDictionary<string, Outputs.ResourcePreset> sizes)
+        {
+            Environments = environments;
+            Sizes = sizes;
+        }
+    }
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+    /// </summary>
+    public sealed class AutoscalingArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The most replicas to scale to. Defaults to the environment's maximum, or 10
+        /// </summary>
+        [Input("maxReplicas")]
+        public int? MaxReplicas { get; set; }
+
+        /// <summary>
+        /// The fewest replicas to scale to. Defaults to the application's replicas
+        /// </summary>
+        [Input("minReplicas")]
+        public int? MinReplicas { get; set; }
+
+        /// <summary>
+        /// The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+        /// </summary>
+        [Input("targetCpuUtilizationPercentage")]
+        public int? TargetCpuUtilizationPercentage { get; set; }
+
+        public AutoscalingArgs()
+        {
+        }
+    }
+}
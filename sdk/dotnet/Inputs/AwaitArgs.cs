@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Controls how long Pulumi waits for the application to become ready.
+    /// </summary>
+    public sealed class AwaitArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// How long to wait for the application's resources to be created. Defaults to 10 minutes
+        /// </summary>
+        [Input("createTimeoutSeconds")]
+        public int? CreateTimeoutSeconds { get; set; }
+
+        /// <summary>
+        /// Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+        /// </summary>
+        [Input("skipAwait")]
+        public bool? SkipAwait { get; set; }
+
+        /// <summary>
+        /// How long to wait for the application's resources to be updated. Defaults to 10 minutes
+        /// </summary>
+        [Input("updateTimeoutSeconds")]
+        public int? UpdateTimeoutSeconds { get; set; }
+
+        /// <summary>
+        /// Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+        /// </summary>
+        [Input("waitForLoadBalancer")]
+        public bool? WaitForLoadBalancer { get; set; }
+
+        public AwaitArgs()
+        {
+        }
+    }
+}
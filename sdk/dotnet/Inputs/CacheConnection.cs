@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Describes how an application connects to a cache.
+    /// </summary>
+    public sealed class CacheConnection : Pulumi.InvokeArgs
+    {
+        [Input("connectionString", required: true)]
+        private string? _connectionString;
+
+        /// <summary>
+        /// The connection string for the cache, such as the `connectionString` output of a `Cache`
+        /// </summary>
+        public string? ConnectionString
+        {
+            get => _connectionString;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connectionString = Output.Tuple<string?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The hostname of the cache, such as the `host` output of a `Cache`
+        /// </summary>
+        [Input("host", required: true)]
+        public string Host { get; set; } = null!;
+
+        /// <summary>
+        /// The port of the cache, such as the `port` output of a `Cache`
+        /// </summary>
+        [Input("port", required: true)]
+        public int Port { get; set; }
+
+        public CacheConnection()
+        {
+        }
+    }
+}
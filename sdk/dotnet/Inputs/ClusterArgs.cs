@@ -0,0 +1,62 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// One of the clusters the application is deployed to, with overrides for the application's settings there.
+    /// </summary>
+    public sealed class ClusterArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The kubeconfig context of the cluster
+        /// </summary>
+        [Input("context")]
+        public Input<string>? Context { get; set; }
+
+        /// <summary>
+        /// The image to deploy to the cluster, overriding `image`
+        /// </summary>
+        [Input("image")]
+        public Input<string>? Image { get; set; }
+
+        [Input("kubeconfig")]
+        private Input<string>? _kubeconfig;
+
+        /// <summary>
+        /// The contents of a kubeconfig file, or the path to one, for the cluster
+        /// </summary>
+        public Input<string>? Kubeconfig
+        {
+            get => _kubeconfig;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _kubeconfig = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        /// <summary>
+        /// The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+        /// </summary>
+        [Input("replicas")]
+        public int? Replicas { get; set; }
+
+        public ClusterArgs()
+        {
+        }
+    }
+}
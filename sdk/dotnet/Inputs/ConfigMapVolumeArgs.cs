@@ -0,0 +1,28 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// A volume populated from a ConfigMap.
+    /// </summary>
+    public sealed class ConfigMapVolumeArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of the ConfigMap in the application namespace
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        public ConfigMapVolumeArgs()
+        {
+        }
+    }
+}
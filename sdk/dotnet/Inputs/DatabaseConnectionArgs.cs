@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Describes how an application connects to a database.
+    /// </summary>
+    public sealed class DatabaseConnectionArgs : Pulumi.ResourceArgs
+    {
+        [Input("connectionString", required: true)]
+        private Input<string>? _connectionString;
+
+        /// <summary>
+        /// The connection string for the database, such as the `connectionString` output of a `Database`
+        /// </summary>
+        public Input<string>? ConnectionString
+        {
+            get => _connectionString;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _connectionString = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        public DatabaseConnectionArgs()
+        {
+        }
+    }
+}
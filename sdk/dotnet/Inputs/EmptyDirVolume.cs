@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// A scratch directory that lives as long as the pod.
+    /// </summary>
+    public sealed class EmptyDirVolume : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Set to `Memory` to back the directory with tmpfs
+        /// </summary>
+        [Input("medium")]
+        public string? Medium { get; set; }
+
+        /// <summary>
+        /// The maximum size of the directory, such as `1Gi`
+        /// </summary>
+        [Input("sizeLimit")]
+        public string? SizeLimit { get; set; }
+
+        public EmptyDirVolume()
+        {
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// A volume backed by a path on the node.
+    /// </summary>
+    public sealed class HostPathVolume : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The path on the node
+        /// </summary>
+        [Input("path", required: true)]
+        public string Path { get; set; } = null!;
+
+        /// <summary>
+        /// The type of the path, such as `Directory`
+        /// </summary>
+        [Input("type")]
+        public string? Type { get; set; }
+
+        public HostPathVolume()
+        {
+        }
+    }
+}
@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Routes a host name to the application's Service.
+    /// </summary>
+    public sealed class Ingress : Pulumi.InvokeArgs
+    {
+        [Input("annotations")]
+        private Dictionary<string, string>? _annotations;
+
+        /// <summary>
+        /// Annotations added to the Ingress, such as the controller's settings
+        /// </summary>
+        public Dictionary<string, string> Annotations
+        {
+            get => _annotations ?? (_annotations = new Dictionary<string, string>());
+            set => _annotations = value;
+        }
+
+        /// <summary>
+        /// The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+        /// </summary>
+        [Input("className")]
+        public string? ClassName { get; set; }
+
+        /// <summary>
+        /// The host name to route, such as `app.example.com`
+        /// </summary>
+        [Input("host", required: true)]
+        public string Host { get; set; } = null!;
+
+        /// <summary>
+        /// The path prefix to route. Defaults to `/`
+        /// </summary>
+        [Input("path")]
+        public string? Path { get; set; }
+
+        /// <summary>
+        /// A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+        /// </summary>
+        [Input("tlsSecretName")]
+        public string? TlsSecretName { get; set; }
+
+        public Ingress()
+        {
+        }
+    }
+}
@@ -0,0 +1,34 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Modifies the arguments of every resource of one kind before it is registered.
+    /// </summary>
+    public sealed class PatchArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The patch, in the shape of the Kubernetes resource. `null` removes a field
+        /// </summary>
+        [Input("patch", required: true)]
+        public object Patch { get; set; } = null!;
+
+        /// <summary>
+        /// How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+        /// </summary>
+        [Input("type")]
+        public string? Type { get; set; }
+
+        public PatchArgs()
+        {
+        }
+    }
+}
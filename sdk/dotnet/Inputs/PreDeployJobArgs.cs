@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+    /// </summary>
+    public sealed class PreDeployJobArgs : Pulumi.ResourceArgs
+    {
+        [Input("command")]
+        private List<string>? _command;
+
+        /// <summary>
+        /// The command to run
+        /// </summary>
+        public List<string> Command
+        {
+            get => _command ?? (_command = new List<string>());
+            set => _command = value;
+        }
+
+        [Input("env")]
+        private Dictionary<string, string>? _env;
+
+        /// <summary>
+        /// Environment variables for the job, in addition to those of the application
+        /// </summary>
+        public Dictionary<string, string> Env
+        {
+            get => _env ?? (_env = new Dictionary<string, string>());
+            set => _env = value;
+        }
+
+        /// <summary>
+        /// The image to run. Defaults to the application image
+        /// </summary>
+        [Input("image")]
+        public string? Image { get; set; }
+
+        /// <summary>
+        /// How long the job may run before it is considered failed
+        /// </summary>
+        [Input("timeoutSeconds")]
+        public int? TimeoutSeconds { get; set; }
+
+        public PreDeployJobArgs()
+        {
+        }
+    }
+}
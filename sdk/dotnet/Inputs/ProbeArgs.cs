@@ -0,0 +1,58 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// An HTTP check the kubelet makes against the application container.
+    /// </summary>
+    public sealed class ProbeArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// How many consecutive checks must fail before the probe fails. Defaults to 3
+        /// </summary>
+        [Input("failureThreshold")]
+        public int? FailureThreshold { get; set; }
+
+        /// <summary>
+        /// How long to wait after the container starts before the first check
+        /// </summary>
+        [Input("initialDelaySeconds")]
+        public int? InitialDelaySeconds { get; set; }
+
+        /// <summary>
+        /// The path to request. Defaults to `/`
+        /// </summary>
+        [Input("path")]
+        public string? Path { get; set; }
+
+        /// <summary>
+        /// How often to check. Defaults to 10 seconds
+        /// </summary>
+        [Input("periodSeconds")]
+        public int? PeriodSeconds { get; set; }
+
+        /// <summary>
+        /// The container port to request. Defaults to the application's port
+        /// </summary>
+        [Input("port")]
+        public int? Port { get; set; }
+
+        /// <summary>
+        /// How long a check may take. Defaults to 1 second
+        /// </summary>
+        [Input("timeoutSeconds")]
+        public int? TimeoutSeconds { get; set; }
+
+        public ProbeArgs()
+        {
+        }
+    }
+}
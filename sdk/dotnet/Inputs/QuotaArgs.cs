@@ -0,0 +1,76 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Caps what the application may consume in its namespace.
+    /// </summary>
+    public sealed class QuotaArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The total CPU limit of all pods in the namespace, such as `4`
+        /// </summary>
+        [Input("cpu")]
+        public string? Cpu { get; set; }
+
+        [Input("defaultLimits")]
+        private Dictionary<string, string>? _defaultLimits;
+
+        /// <summary>
+        /// Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+        /// </summary>
+        public Dictionary<string, string> DefaultLimits
+        {
+            get => _defaultLimits ?? (_defaultLimits = new Dictionary<string, string>());
+            set => _defaultLimits = value;
+        }
+
+        [Input("defaultRequests")]
+        private Dictionary<string, string>? _defaultRequests;
+
+        /// <summary>
+        /// Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+        /// </summary>
+        public Dictionary<string, string> DefaultRequests
+        {
+            get => _defaultRequests ?? (_defaultRequests = new Dictionary<string, string>());
+            set => _defaultRequests = value;
+        }
+
+        /// <summary>
+        /// The maximum number of LoadBalancer services in the namespace. Must be at least 1
+        /// </summary>
+        [Input("loadBalancers")]
+        public int? LoadBalancers { get; set; }
+
+        /// <summary>
+        /// The total memory limit of all pods in the namespace, such as `8Gi`
+        /// </summary>
+        [Input("memory")]
+        public string? Memory { get; set; }
+
+        /// <summary>
+        /// The maximum number of persistent volume claims in the namespace
+        /// </summary>
+        [Input("persistentVolumeClaims")]
+        public int? PersistentVolumeClaims { get; set; }
+
+        /// <summary>
+        /// The maximum number of pods in the namespace
+        /// </summary>
+        [Input("pods")]
+        public int? Pods { get; set; }
+
+        public QuotaArgs()
+        {
+        }
+    }
+}
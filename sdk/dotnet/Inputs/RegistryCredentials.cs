@@ -0,0 +1,56 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// The credentials the application's pods pull images with.
+    /// </summary>
+    public sealed class RegistryCredentials : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+        /// </summary>
+        [Input("existingSecretName")]
+        public string? ExistingSecretName { get; set; }
+
+        [Input("password")]
+        private string? _password;
+
+        /// <summary>
+        /// The password or token to authenticate with
+        /// </summary>
+        public string? Password
+        {
+            get => _password;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _password = Output.Tuple<string?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The registry server, such as `ghcr.io`
+        /// </summary>
+        [Input("server")]
+        public string? Server { get; set; }
+
+        /// <summary>
+        /// The username to authenticate with
+        /// </summary>
+        [Input("username")]
+        public string? Username { get; set; }
+
+        public RegistryCredentials()
+        {
+        }
+    }
+}
@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+    /// </summary>
+    public sealed class Resources : Pulumi.InvokeArgs
+    {
+        [Input("limits")]
+        private Dictionary<string, string>? _limits;
+
+        /// <summary>
+        /// The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+        /// </summary>
+        public Dictionary<string, string> Limits
+        {
+            get => _limits ?? (_limits = new Dictionary<string, string>());
+            set => _limits = value;
+        }
+
+        [Input("requests")]
+        private Dictionary<string, string>? _requests;
+
+        /// <summary>
+        /// The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+        /// </summary>
+        public Dictionary<string, string> Requests
+        {
+            get => _requests ?? (_requests = new Dictionary<string, string>());
+            set => _requests = value;
+        }
+
+        public Resources()
+        {
+        }
+    }
+}
@@ -0,0 +1,28 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// A volume populated from a Secret.
+    /// </summary>
+    public sealed class SecretVolume : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the Secret in the application namespace
+        /// </summary>
+        [Input("secretName", required: true)]
+        public string SecretName { get; set; } = null!;
+
+        public SecretVolume()
+        {
+        }
+    }
+}
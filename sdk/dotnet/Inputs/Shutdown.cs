@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Configures how the application's pods are stopped.
+    /// </summary>
+    public sealed class Shutdown : Pulumi.InvokeArgs
+    {
+        [Input("preStopCommand")]
+        private List<string>? _preStopCommand;
+
+        /// <summary>
+        /// A command to run in the preStop hook instead of sleeping
+        /// </summary>
+        public List<string> PreStopCommand
+        {
+            get => _preStopCommand ?? (_preStopCommand = new List<string>());
+            set => _preStopCommand = value;
+        }
+
+        /// <summary>
+        /// How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+        /// </summary>
+        [Input("preStopSleepSeconds")]
+        public int? PreStopSleepSeconds { get; set; }
+
+        /// <summary>
+        /// How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+        /// </summary>
+        [Input("terminationGracePeriodSeconds")]
+        public int? TerminationGracePeriodSeconds { get; set; }
+
+        public Shutdown()
+        {
+        }
+    }
+}
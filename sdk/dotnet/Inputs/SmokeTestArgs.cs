@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+    /// </summary>
+    public sealed class SmokeTestArgs : Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Text the response's body must contain
+        /// </summary>
+        [Input("bodyContains")]
+        public string? BodyContains { get; set; }
+
+        /// <summary>
+        /// The status code the response must have. Defaults to 200
+        /// </summary>
+        [Input("expectedStatus")]
+        public int? ExpectedStatus { get; set; }
+
+        /// <summary>
+        /// How long to wait between attempts. Defaults to 5 seconds
+        /// </summary>
+        [Input("intervalSeconds")]
+        public int? IntervalSeconds { get; set; }
+
+        /// <summary>
+        /// The path to request. Defaults to `/`
+        /// </summary>
+        [Input("path")]
+        public string? Path { get; set; }
+
+        /// <summary>
+        /// How many times to retry the request before failing. Defaults to 10
+        /// </summary>
+        [Input("retries")]
+        public int? Retries { get; set; }
+
+        public SmokeTestArgs()
+        {
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+    /// </summary>
+    public sealed class Volume : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The contents of a ConfigMap
+        /// </summary>
+        [Input("configMap")]
+        public Inputs.ConfigMapVolume? ConfigMap { get; set; }
+
+        /// <summary>
+        /// A scratch directory that lives as long as the pod
+        /// </summary>
+        [Input("emptyDir")]
+        public Inputs.EmptyDirVolume? EmptyDir { get; set; }
+
+        /// <summary>
+        /// A path on the node. Requires `allowHostPath`
+        /// </summary>
+        [Input("hostPath")]
+        public Inputs.HostPathVolume? HostPath { get; set; }
+
+        /// <summary>
+        /// The name of the volume, referenced by `volumeMounts`
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        /// <summary>
+        /// The contents of a Secret
+        /// </summary>
+        [Input("secret")]
+        public Inputs.SecretVolume? Secret { get; set; }
+
+        public Volume()
+        {
+        }
+    }
+}
@@ -0,0 +1,46 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Inputs
+{
+
+    /// <summary>
+    /// Mounts one of the application's volumes into its container.
+    /// </summary>
+    public sealed class VolumeMount : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Where to mount the volume
+        /// </summary>
+        [Input("mountPath", required: true)]
+        public string MountPath { get; set; } = null!;
+
+        /// <summary>
+        /// The name of the volume to mount
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        /// <summary>
+        /// Whether to mount the volume read-only
+        /// </summary>
+        [Input("readOnly")]
+        public bool? ReadOnly { get; set; }
+
+        /// <summary>
+        /// A path within the volume to mount instead of its root
+        /// </summary>
+        [Input("subPath")]
+        public string? SubPath { get; set; }
+
+        public VolumeMount()
+        {
+        }
+    }
+}
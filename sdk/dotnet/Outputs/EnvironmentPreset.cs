@@ -0,0 +1,80 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Outputs
+{
+
+    /// <summary>
+    /// The defaults a Deployment gets in one environment tier.
+    /// </summary>
+    [OutputType]
+    public sealed class EnvironmentPreset
+    {
+        /// <summary>
+        /// Whether the pods are spread across nodes
+        /// </summary>
+        public readonly bool AntiAffinity;
+        /// <summary>
+        /// The application container's image pull policy
+        /// </summary>
+        public readonly string ImagePullPolicy;
+        /// <summary>
+        /// The number of pods the HorizontalPodAutoscaler scales up to. Unset if there is no HorizontalPodAutoscaler
+        /// </summary>
+        public readonly int? MaxReplicas;
+        /// <summary>
+        /// The minimum number or percentage of pods the PodDisruptionBudget keeps available. Unset if there is no PodDisruptionBudget
+        /// </summary>
+        public readonly string? MinAvailable;
+        /// <summary>
+        /// Whether the namespace is protected from deletion
+        /// </summary>
+        public readonly bool ProtectNamespace;
+        /// <summary>
+        /// The number of pods
+        /// </summary>
+        public readonly int Replicas;
+        /// <summary>
+        /// The application container's resource requests and limits
+        /// </summary>
+        public readonly Outputs.ResourcePreset Resources;
+        /// <summary>
+        /// The CPU utilization the HorizontalPodAutoscaler scales at. Unset if there is no HorizontalPodAutoscaler
+        /// </summary>
+        public readonly int? TargetCpuUtilizationPercentage;
+
+        [OutputConstructor]
+        private EnvironmentPreset(
+            bool antiAffinity,
+
+            string imagePullPolicy,
+
+            int? maxReplicas,
+
+            string? minAvailable,
+
+            bool protectNamespace,
+
+            int replicas,
+
+            Outputs.ResourcePreset resources,
+
+            int? targetCpuUtilizationPercentage)
+        {
+            AntiAffinity = antiAffinity;
+            ImagePullPolicy = imagePullPolicy;
+            MaxReplicas = maxReplicas;
+            MinAvailable = minAvailable;
+            ProtectNamespace = protectNamespace;
+            Replicas = replicas;
+            Resources = resources;
+            TargetCpuUtilizationPercentage = targetCpuUtilizationPercentage;
+        }
+    }
+}
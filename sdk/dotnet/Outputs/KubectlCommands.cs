@@ -0,0 +1,73 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Outputs
+{
+
+    /// <summary>
+    /// kubectl commands for one of the application's workloads.
+    /// </summary>
+    [OutputType]
+    public sealed class KubectlCommands
+    {
+        /// <summary>
+        /// The name of the cluster, when the application is deployed to several
+        /// </summary>
+        public readonly string? Cluster;
+        /// <summary>
+        /// Describes the application's Deployment
+        /// </summary>
+        public readonly string Describe;
+        /// <summary>
+        /// Lists the application's pods
+        /// </summary>
+        public readonly string GetPods;
+        /// <summary>
+        /// The kubeconfig file the commands use, when the application was deployed with a kubeconfig
+        /// </summary>
+        public readonly string? Kubeconfig;
+        /// <summary>
+        /// Follows the application's logs
+        /// </summary>
+        public readonly string Logs;
+        /// <summary>
+        /// Restarts the application's pods
+        /// </summary>
+        public readonly string Restart;
+        /// <summary>
+        /// Waits for the application's rollout to finish
+        /// </summary>
+        public readonly string RolloutStatus;
+
+        [OutputConstructor]
+        private KubectlCommands(
+            string? cluster,
+
+            string describe,
+
+            string getPods,
+
+            string? kubeconfig,
+
+            string logs,
+
+            string restart,
+
+            string rolloutStatus)
+        {
+            Cluster = cluster;
+            Describe = describe;
+            GetPods = getPods;
+            Kubeconfig = kubeconfig;
+            Logs = logs;
+            Restart = restart;
+            RolloutStatus = rolloutStatus;
+        }
+    }
+}
@@ -0,0 +1,52 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Outputs
+{
+
+    /// <summary>
+    /// The number of a Deployment's pods in each state.
+    /// </summary>
+    [OutputType]
+    public sealed class ReplicaStatus
+    {
+        /// <summary>
+        /// The number of available pods
+        /// </summary>
+        public readonly int AvailableReplicas;
+        /// <summary>
+        /// The number of ready pods
+        /// </summary>
+        public readonly int ReadyReplicas;
+        /// <summary>
+        /// The number of pods
+        /// </summary>
+        public readonly int Replicas;
+        /// <summary>
+        /// The number of pods running the latest pod template
+        /// </summary>
+        public readonly int UpdatedReplicas;
+
+        [OutputConstructor]
+        private ReplicaStatus(
+            int availableReplicas,
+
+            int readyReplicas,
+
+            int replicas,
+
+            int updatedReplicas)
+        {
+            AvailableReplicas = availableReplicas;
+            ReadyReplicas = readyReplicas;
+            Replicas = replicas;
+            UpdatedReplicas = updatedReplicas;
+        }
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Outputs
+{
+
+    /// <summary>
+    /// Container resource requests and limits.
+    /// </summary>
+    [OutputType]
+    public sealed class ResourcePreset
+    {
+        /// <summary>
+        /// The resource limits, keyed by resource name
+        /// </summary>
+        public readonly ImmutableDictionary<string, string> Limits;
+        /// <summary>
+        /// The resources requested, keyed by resource name
+        /// </summary>
+        public readonly ImmutableDictionary<string, string> Requests;
+
+        [OutputConstructor]
+        private ResourcePreset(
+            ImmutableDictionary<string, string> limits,
+
+            ImmutableDictionary<string, string> requests)
+        {
+            Limits = limits;
+            Requests = requests;
+        }
+    }
+}
@@ -0,0 +1,66 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp.Outputs
+{
+
+    /// <summary>
+    /// Where the application runs in one cluster.
+    /// </summary>
+    [OutputType]
+    public sealed class Workload
+    {
+        /// <summary>
+        /// The name of the cluster, when the application is deployed to several
+        /// </summary>
+        public readonly string? Cluster;
+        /// <summary>
+        /// The kubeconfig context the application was deployed with
+        /// </summary>
+        public readonly string? Context;
+        /// <summary>
+        /// The name of the application's Deployment
+        /// </summary>
+        public readonly string Deployment;
+        /// <summary>
+        /// The contents of the kubeconfig file, or the path to it, the application was deployed with
+        /// </summary>
+        public readonly string? Kubeconfig;
+        /// <summary>
+        /// The namespace the application runs in
+        /// </summary>
+        public readonly string Namespace;
+        /// <summary>
+        /// The URL of the application's Service
+        /// </summary>
+        public readonly string Url;
+
+        [OutputConstructor]
+        private Workload(
+            string? cluster,
+
+            string? context,
+
+            string deployment,
+
+            string? kubeconfig,
+
+            string @namespace,
+
+            string url)
+        {
+            Cluster = cluster;
+            Context = context;
+            Deployment = deployment;
+            Kubeconfig = kubeconfig;
+            Namespace = @namespace;
+            Url = url;
+        }
+    }
+}
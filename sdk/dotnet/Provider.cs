@@ -9,6 +9,9 @@ using Pulumi.Serialization;
 
 namespace Pulumi.Productionapp
 {
+    /// <summary>
+    /// The provider type for the productionapp package. Its configuration sets organisation-wide defaults for the components it creates.
+    /// </summary>
     [ProductionappResourceType("pulumi:providers:productionapp")]
     public partial class Provider : Pulumi.ProviderResource
     {
@@ -39,6 +42,30 @@ namespace Pulumi.Productionapp
 
     public sealed class ProviderArgs : Pulumi.ResourceArgs
     {
+        [Input("defaultLabels", json: true)]
+        private InputMap<string>? _defaultLabels;
+
+        /// <summary>
+        /// Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+        /// </summary>
+        public InputMap<string> DefaultLabels
+        {
+            get => _defaultLabels ?? (_defaultLabels = new InputMap<string>());
+            set => _defaultLabels = value;
+        }
+
+        /// <summary>
+        /// A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+        /// </summary>
+        [Input("defaultRegistry")]
+        public Input<string>? DefaultRegistry { get; set; }
+
+        /// <summary>
+        /// The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+        /// </summary>
+        [Input("environment")]
+        public Input<string>? Environment { get; set; }
+
         public ProviderArgs()
         {
         }
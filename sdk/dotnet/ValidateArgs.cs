@@ -0,0 +1,581 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.Productionapp
+{
+    public static class ValidateArgs
+    {
+        /// <summary>
+        /// Checks a Deployment's name and inputs without creating anything, applying the provider's configuration as constructing it would.
+        /// </summary>
+        public static Task<ValidateArgsResult> InvokeAsync(ValidateArgsArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.InvokeAsync<ValidateArgsResult>("productionapp:index:validateArgs", args ?? new ValidateArgsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Checks a Deployment's name and inputs without creating anything, applying the provider's configuration as constructing it would.
+        /// </summary>
+        public static Output<ValidateArgsResult> Invoke(ValidateArgsInvokeArgs args, InvokeOptions? options = null)
+            => Pulumi.Deployment.Instance.Invoke<ValidateArgsResult>("productionapp:index:validateArgs", args ?? new ValidateArgsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class ValidateArgsArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+        /// </summary>
+        [Input("allowHostPath")]
+        public bool? AllowHostPath { get; set; }
+
+        [Input("annotations")]
+        private Dictionary<string, string>? _annotations;
+
+        /// <summary>
+        /// Annotations added to every resource the component creates
+        /// </summary>
+        public Dictionary<string, string> Annotations
+        {
+            get => _annotations ?? (_annotations = new Dictionary<string, string>());
+            set => _annotations = value;
+        }
+
+        [Input("args")]
+        private List<string>? _args;
+
+        /// <summary>
+        /// Overrides the arguments of the application image
+        /// </summary>
+        public List<string> Args
+        {
+            get => _args ?? (_args = new List<string>());
+            set => _args = value;
+        }
+
+        /// <summary>
+        /// Scales the application with a HorizontalPodAutoscaler
+        /// </summary>
+        [Input("autoscaling")]
+        public Inputs.Autoscaling? Autoscaling { get; set; }
+
+        /// <summary>
+        /// How long to wait for the application to become ready
+        /// </summary>
+        [Input("await")]
+        public Inputs.Await? Await { get; set; }
+
+        /// <summary>
+        /// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+        /// </summary>
+        [Input("cache")]
+        public Inputs.CacheConnection? Cache { get; set; }
+
+        [Input("clusters")]
+        private List<Inputs.Cluster>? _clusters;
+
+        /// <summary>
+        /// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+        /// </summary>
+        public List<Inputs.Cluster> Clusters
+        {
+            get => _clusters ?? (_clusters = new List<Inputs.Cluster>());
+            set => _clusters = value;
+        }
+
+        [Input("command")]
+        private List<string>? _command;
+
+        /// <summary>
+        /// Overrides the entrypoint of the application image
+        /// </summary>
+        public List<string> Command
+        {
+            get => _command ?? (_command = new List<string>());
+            set => _command = value;
+        }
+
+        /// <summary>
+        /// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+        /// </summary>
+        [Input("context")]
+        public string? Context { get; set; }
+
+        /// <summary>
+        /// A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+        /// </summary>
+        [Input("database")]
+        public Inputs.DatabaseConnection? Database { get; set; }
+
+        /// <summary>
+        /// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+        /// </summary>
+        [Input("environment")]
+        public Pulumi.Productionapp.Environment? Environment { get; set; }
+
+        /// <summary>
+        /// The image to deploy in your production application
+        /// </summary>
+        [Input("image")]
+        public string? Image { get; set; }
+
+        /// <summary>
+        /// An Ingress that routes a host name to the application. The url becomes the Ingress's
+        /// </summary>
+        [Input("ingress")]
+        public Inputs.Ingress? Ingress { get; set; }
+
+        [Input("kubeconfig")]
+        private string? _kubeconfig;
+
+        /// <summary>
+        /// The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+        /// </summary>
+        public string? Kubeconfig
+        {
+            get => _kubeconfig;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _kubeconfig = Output.Tuple<string?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("labels")]
+        private Dictionary<string, string>? _labels;
+
+        /// <summary>
+        /// Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+        /// </summary>
+        public Dictionary<string, string> Labels
+        {
+            get => _labels ?? (_labels = new Dictionary<string, string>());
+            set => _labels = value;
+        }
+
+        /// <summary>
+        /// An HTTP check that restarts the application's container when it fails
+        /// </summary>
+        [Input("livenessProbe")]
+        public Inputs.Probe? LivenessProbe { get; set; }
+
+        /// <summary>
+        /// The name the Deployment would be given
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        [Input("patches")]
+        private Dictionary<string, Inputs.Patch>? _patches;
+
+        /// <summary>
+        /// Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+        /// </summary>
+        public Dictionary<string, Inputs.Patch> Patches
+        {
+            get => _patches ?? (_patches = new Dictionary<string, Inputs.Patch>());
+            set => _patches = value;
+        }
+
+        [Input("podAnnotations")]
+        private Dictionary<string, string>? _podAnnotations;
+
+        /// <summary>
+        /// Annotations added to the application's pods
+        /// </summary>
+        public Dictionary<string, string> PodAnnotations
+        {
+            get => _podAnnotations ?? (_podAnnotations = new Dictionary<string, string>());
+            set => _podAnnotations = value;
+        }
+
+        /// <summary>
+        /// The port your container listens on
+        /// </summary>
+        [Input("port")]
+        public int? Port { get; set; }
+
+        /// <summary>
+        /// A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+        /// </summary>
+        [Input("preDeployJob")]
+        public Inputs.PreDeployJob? PreDeployJob { get; set; }
+
+        /// <summary>
+        /// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+        /// </summary>
+        [Input("quota")]
+        public Inputs.Quota? Quota { get; set; }
+
+        /// <summary>
+        /// An HTTP check that must pass before the application's pods receive traffic
+        /// </summary>
+        [Input("readinessProbe")]
+        public Inputs.Probe? ReadinessProbe { get; set; }
+
+        /// <summary>
+        /// Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+        /// </summary>
+        [Input("registryCredentials")]
+        public Inputs.RegistryCredentials? RegistryCredentials { get; set; }
+
+        /// <summary>
+        /// Resource requests and limits for the application's container, overriding the ones the environment or size sets
+        /// </summary>
+        [Input("resources")]
+        public Inputs.Resources? Resources { get; set; }
+
+        /// <summary>
+        /// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+        /// </summary>
+        [Input("sanitizeName")]
+        public bool? SanitizeName { get; set; }
+
+        /// <summary>
+        /// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+        /// </summary>
+        [Input("serviceType")]
+        public Pulumi.Productionapp.ServiceType? ServiceType { get; set; }
+
+        /// <summary>
+        /// How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+        /// </summary>
+        [Input("shutdown")]
+        public Inputs.Shutdown? Shutdown { get; set; }
+
+        /// <summary>
+        /// The resource preset for the application's container, replacing the environment's resources
+        /// </summary>
+        [Input("size")]
+        public Pulumi.Productionapp.Size? Size { get; set; }
+
+        /// <summary>
+        /// An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+        /// </summary>
+        [Input("smokeTest")]
+        public Inputs.SmokeTest? SmokeTest { get; set; }
+
+        [Input("volumeMounts")]
+        private List<Inputs.VolumeMount>? _volumeMounts;
+
+        /// <summary>
+        /// Where to mount `volumes` in the application container
+        /// </summary>
+        public List<Inputs.VolumeMount> VolumeMounts
+        {
+            get => _volumeMounts ?? (_volumeMounts = new List<Inputs.VolumeMount>());
+            set => _volumeMounts = value;
+        }
+
+        [Input("volumes")]
+        private List<Inputs.Volume>? _volumes;
+
+        /// <summary>
+        /// Volumes available to the application container
+        /// </summary>
+        public List<Inputs.Volume> Volumes
+        {
+            get => _volumes ?? (_volumes = new List<Inputs.Volume>());
+            set => _volumes = value;
+        }
+
+        /// <summary>
+        /// The working directory of the application container
+        /// </summary>
+        [Input("workingDir")]
+        public string? WorkingDir { get; set; }
+
+        public ValidateArgsArgs()
+        {
+        }
+    }
+
+    public sealed class ValidateArgsInvokeArgs : Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+        /// </summary>
+        [Input("allowHostPath")]
+        public Input<bool>? AllowHostPath { get; set; }
+
+        [Input("annotations")]
+        private InputMap<string>? _annotations;
+
+        /// <summary>
+        /// Annotations added to every resource the component creates
+        /// </summary>
+        public InputMap<string> Annotations
+        {
+            get => _annotations ?? (_annotations = new InputMap<string>());
+            set => _annotations = value;
+        }
+
+        [Input("args")]
+        private InputList<string>? _args;
+
+        /// <summary>
+        /// Overrides the arguments of the application image
+        /// </summary>
+        public InputList<string> Args
+        {
+            get => _args ?? (_args = new InputList<string>());
+            set => _args = value;
+        }
+
+        /// <summary>
+        /// Scales the application with a HorizontalPodAutoscaler
+        /// </summary>
+        [Input("autoscaling")]
+        public Input<Inputs.AutoscalingArgs>? Autoscaling { get; set; }
+
+        /// <summary>
+        /// How long to wait for the application to become ready
+        /// </summary>
+        [Input("await")]
+        public Input<Inputs.AwaitArgs>? Await { get; set; }
+
+        /// <summary>
+        /// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+        /// </summary>
+        [Input("cache")]
+        public Input<Inputs.CacheConnectionArgs>? Cache { get; set; }
+
+        [Input("clusters")]
+        private InputList<Inputs.ClusterArgs>? _clusters;
+
+        /// <summary>
+        /// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+        /// </summary>
+        public InputList<Inputs.ClusterArgs> Clusters
+        {
+            get => _clusters ?? (_clusters = new InputList<Inputs.ClusterArgs>());
+            set => _clusters = value;
+        }
+
+        [Input("command")]
+        private InputList<string>? _command;
+
+        /// <summary>
+        /// Overrides the entrypoint of the application image
+        /// </summary>
+        public InputList<string> Command
+        {
+            get => _command ?? (_command = new InputList<string>());
+            set => _command = value;
+        }
+
+        /// <summary>
+        /// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+        /// </summary>
+        [Input("context")]
+        public Input<string>? Context { get; set; }
+
+        /// <summary>
+        /// A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+        /// </summary>
+        [Input("database")]
+        public Input<Inputs.DatabaseConnectionArgs>? Database { get; set; }
+
+        /// <summary>
+        /// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+        /// </summary>
+        [Input("environment")]
+        public Input<Pulumi.Productionapp.Environment>? Environment { get; set; }
+
+        /// <summary>
+        /// The image to deploy in your production application
+        /// </summary>
+        [Input("image")]
+        public Input<string>? Image { get; set; }
+
+        /// <summary>
+        /// An Ingress that routes a host name to the application. The url becomes the Ingress's
+        /// </summary>
+        [Input("ingress")]
+        public Input<Inputs.IngressArgs>? Ingress { get; set; }
+
+        [Input("kubeconfig")]
+        private Input<string>? _kubeconfig;
+
+        /// <summary>
+        /// The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+        /// </summary>
+        public Input<string>? Kubeconfig
+        {
+            get => _kubeconfig;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _kubeconfig = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("labels")]
+        private InputMap<string>? _labels;
+
+        /// <summary>
+        /// Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+        /// </summary>
+        public InputMap<string> Labels
+        {
+            get => _labels ?? (_labels = new InputMap<string>());
+            set => _labels = value;
+        }
+
+        /// <summary>
+        /// An HTTP check that restarts the application's container when it fails
+        /// </summary>
+        [Input("livenessProbe")]
+        public Input<Inputs.ProbeArgs>? LivenessProbe { get; set; }
+
+        /// <summary>
+        /// The name the Deployment would be given
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        [Input("patches")]
+        private InputMap<Inputs.PatchArgs>? _patches;
+
+        /// <summary>
+        /// Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+        /// </summary>
+        public InputMap<Inputs.PatchArgs> Patches
+        {
+            get => _patches ?? (_patches = new InputMap<Inputs.PatchArgs>());
+            set => _patches = value;
+        }
+
+        [Input("podAnnotations")]
+        private InputMap<string>? _podAnnotations;
+
+        /// <summary>
+        /// Annotations added to the application's pods
+        /// </summary>
+        public InputMap<string> PodAnnotations
+        {
+            get => _podAnnotations ?? (_podAnnotations = new InputMap<string>());
+            set => _podAnnotations = value;
+        }
+
+        /// <summary>
+        /// The port your container listens on
+        /// </summary>
+        [Input("port")]
+        public Input<int>? Port { get; set; }
+
+        /// <summary>
+        /// A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+        /// </summary>
+        [Input("preDeployJob")]
+        public Input<Inputs.PreDeployJobArgs>? PreDeployJob { get; set; }
+
+        /// <summary>
+        /// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+        /// </summary>
+        [Input("quota")]
+        public Input<Inputs.QuotaArgs>? Quota { get; set; }
+
+        /// <summary>
+        /// An HTTP check that must pass before the application's pods receive traffic
+        /// </summary>
+        [Input("readinessProbe")]
+        public Input<Inputs.ProbeArgs>? ReadinessProbe { get; set; }
+
+        /// <summary>
+        /// Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+        /// </summary>
+        [Input("registryCredentials")]
+        public Input<Inputs.RegistryCredentialsArgs>? RegistryCredentials { get; set; }
+
+        /// <summary>
+        /// Resource requests and limits for the application's container, overriding the ones the environment or size sets
+        /// </summary>
+        [Input("resources")]
+        public Input<Inputs.ResourcesArgs>? Resources { get; set; }
+
+        /// <summary>
+        /// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+        /// </summary>
+        [Input("sanitizeName")]
+        public Input<bool>? SanitizeName { get; set; }
+
+        /// <summary>
+        /// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+        /// </summary>
+        [Input("serviceType")]
+        public Input<Pulumi.Productionapp.ServiceType>? ServiceType { get; set; }
+
+        /// <summary>
+        /// How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+        /// </summary>
+        [Input("shutdown")]
+        public Input<Inputs.ShutdownArgs>? Shutdown { get; set; }
+
+        /// <summary>
+        /// The resource preset for the application's container, replacing the environment's resources
+        /// </summary>
+        [Input("size")]
+        public Input<Pulumi.Productionapp.Size>? Size { get; set; }
+
+        /// <summary>
+        /// An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+        /// </summary>
+        [Input("smokeTest")]
+        public Input<Inputs.SmokeTestArgs>? SmokeTest { get; set; }
+
+        [Input("volumeMounts")]
+        private InputList<Inputs.VolumeMountArgs>? _volumeMounts;
+
+        /// <summary>
+        /// Where to mount `volumes` in the application container
+        /// </summary>
+        public InputList<Inputs.VolumeMountArgs> VolumeMounts
+        {
+            get => _volumeMounts ?? (_volumeMounts = new InputList<Inputs.VolumeMountArgs>());
+            set => _volumeMounts = value;
+        }
+
+        [Input("volumes")]
+        private InputList<Inputs.VolumeArgs>? _volumes;
+
+        /// <summary>
+        /// Volumes available to the application container
+        /// </summary>
+        public InputList<Inputs.VolumeArgs> Volumes
+        {
+            get => _volumes ?? (_volumes = new InputList<Inputs.VolumeArgs>());
+            set => _volumes = value;
+        }
+
+        /// <summary>
+        /// The working directory of the application container
+        /// </summary>
+        [Input("workingDir")]
+        public Input<string>? WorkingDir { get; set; }
+
+        public ValidateArgsInvokeArgs()
+        {
+        }
+    }
+
+
+    [OutputType]
+    public sealed class ValidateArgsResult
+    {
+        /// <summary>
+        /// Every problem with the inputs, naming the offending input. Empty if they are valid
+        /// </summary>
+        public readonly ImmutableArray<string> Problems;
+
+        [OutputConstructor]
+        private ValidateArgsResult(ImmutableArray<string> problems)
+        {
+            Problems = problems;
+        }
+    }
+}
@@ -0,0 +1,211 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package productionapp
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A Redis cache running in the cluster.
+type Cache struct {
+	pulumi.ResourceState
+
+	// A redis:// connection string for the cache
+	ConnectionString pulumi.StringOutput `pulumi:"connectionString"`
+	// The in-cluster hostname of the cache
+	Host pulumi.StringOutput `pulumi:"host"`
+	// The generated password for the cache, when authentication is enabled
+	Password pulumi.StringPtrOutput `pulumi:"password"`
+	// The port the cache listens on
+	Port pulumi.IntOutput `pulumi:"port"`
+}
+
+// NewCache registers a new resource with the given unique name, arguments, and options.
+func NewCache(ctx *pulumi.Context,
+	name string, args *CacheArgs, opts ...pulumi.ResourceOption) (*Cache, error) {
+	if args == nil {
+		args = &CacheArgs{}
+	}
+
+	if isZero(args.EnableAuth) {
+		enableAuth_ := false
+		args.EnableAuth = &enableAuth_
+	}
+	if isZero(args.Image) {
+		args.Image = pulumi.StringPtr("redis:6")
+	}
+	if isZero(args.Size) {
+		size_ := Size("small")
+		args.Size = &size_
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"connectionString",
+		"password",
+	})
+	opts = append(opts, secrets)
+	var resource Cache
+	err := ctx.RegisterRemoteComponentResource("productionapp:index:Cache", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type cacheArgs struct {
+	// Whether to require a generated password to connect to the cache
+	EnableAuth *bool `pulumi:"enableAuth"`
+	// The Redis image to run
+	Image *string `pulumi:"image"`
+	// The resource preset for the cache
+	Size *Size `pulumi:"size"`
+}
+
+// The set of arguments for constructing a Cache resource.
+type CacheArgs struct {
+	// Whether to require a generated password to connect to the cache
+	EnableAuth *bool
+	// The Redis image to run
+	Image pulumi.StringPtrInput
+	// The resource preset for the cache
+	Size *Size
+}
+
+func (CacheArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*cacheArgs)(nil)).Elem()
+}
+
+type CacheInput interface {
+	pulumi.Input
+
+	ToCacheOutput() CacheOutput
+	ToCacheOutputWithContext(ctx context.Context) CacheOutput
+}
+
+func (*Cache) ElementType() reflect.Type {
+	return reflect.TypeOf((**Cache)(nil)).Elem()
+}
+
+func (i *Cache) ToCacheOutput() CacheOutput {
+	return i.ToCacheOutputWithContext(context.Background())
+}
+
+func (i *Cache) ToCacheOutputWithContext(ctx context.Context) CacheOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CacheOutput)
+}
+
+// CacheArrayInput is an input type that accepts CacheArray and CacheArrayOutput values.
+// You can construct a concrete instance of `CacheArrayInput` via:
+//
+//	CacheArray{ CacheArgs{...} }
+type CacheArrayInput interface {
+	pulumi.Input
+
+	ToCacheArrayOutput() CacheArrayOutput
+	ToCacheArrayOutputWithContext(context.Context) CacheArrayOutput
+}
+
+type CacheArray []CacheInput
+
+func (CacheArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Cache)(nil)).Elem()
+}
+
+func (i CacheArray) ToCacheArrayOutput() CacheArrayOutput {
+	return i.ToCacheArrayOutputWithContext(context.Background())
+}
+
+func (i CacheArray) ToCacheArrayOutputWithContext(ctx context.Context) CacheArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CacheArrayOutput)
+}
+
+// CacheMapInput is an input type that accepts CacheMap and CacheMapOutput values.
+// You can construct a concrete instance of `CacheMapInput` via:
+//
+//	CacheMap{ "key": CacheArgs{...} }
+type CacheMapInput interface {
+	pulumi.Input
+
+	ToCacheMapOutput() CacheMapOutput
+	ToCacheMapOutputWithContext(context.Context) CacheMapOutput
+}
+
+type CacheMap map[string]CacheInput
+
+func (CacheMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Cache)(nil)).Elem()
+}
+
+func (i CacheMap) ToCacheMapOutput() CacheMapOutput {
+	return i.ToCacheMapOutputWithContext(context.Background())
+}
+
+func (i CacheMap) ToCacheMapOutputWithContext(ctx context.Context) CacheMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CacheMapOutput)
+}
+
+type CacheOutput struct{ *pulumi.OutputState }
+
+func (CacheOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Cache)(nil)).Elem()
+}
+
+func (o CacheOutput) ToCacheOutput() CacheOutput {
+	return o
+}
+
+func (o CacheOutput) ToCacheOutputWithContext(ctx context.Context) CacheOutput {
+	return o
+}
+
+type CacheArrayOutput struct{ *pulumi.OutputState }
+
+func (CacheArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Cache)(nil)).Elem()
+}
+
+func (o CacheArrayOutput) ToCacheArrayOutput() CacheArrayOutput {
+	return o
+}
+
+func (o CacheArrayOutput) ToCacheArrayOutputWithContext(ctx context.Context) CacheArrayOutput {
+	return o
+}
+
+func (o CacheArrayOutput) Index(i pulumi.IntInput) CacheOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Cache {
+		return vs[0].([]*Cache)[vs[1].(int)]
+	}).(CacheOutput)
+}
+
+type CacheMapOutput struct{ *pulumi.OutputState }
+
+func (CacheMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Cache)(nil)).Elem()
+}
+
+func (o CacheMapOutput) ToCacheMapOutput() CacheMapOutput {
+	return o
+}
+
+func (o CacheMapOutput) ToCacheMapOutputWithContext(ctx context.Context) CacheMapOutput {
+	return o
+}
+
+func (o CacheMapOutput) MapIndex(k pulumi.StringInput) CacheOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Cache {
+		return vs[0].(map[string]*Cache)[vs[1].(string)]
+	}).(CacheOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*CacheInput)(nil)).Elem(), &Cache{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CacheArrayInput)(nil)).Elem(), CacheArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CacheMapInput)(nil)).Elem(), CacheMap{})
+	pulumi.RegisterOutputType(CacheOutput{})
+	pulumi.RegisterOutputType(CacheArrayOutput{})
+	pulumi.RegisterOutputType(CacheMapOutput{})
+}
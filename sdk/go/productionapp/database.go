@@ -0,0 +1,224 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package productionapp
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A Postgres database running in the cluster, intended for development environments.
+type Database struct {
+	pulumi.ResourceState
+
+	// A postgresql:// connection string for the database
+	ConnectionString pulumi.StringOutput `pulumi:"connectionString"`
+	// The name of the database
+	DatabaseName pulumi.StringOutput `pulumi:"databaseName"`
+	// The in-cluster hostname of the database
+	Host pulumi.StringOutput `pulumi:"host"`
+	// The generated password for the database user
+	Password pulumi.StringOutput `pulumi:"password"`
+	// The port the database listens on
+	Port pulumi.IntOutput `pulumi:"port"`
+	// The name of the database user
+	Username pulumi.StringOutput `pulumi:"username"`
+}
+
+// NewDatabase registers a new resource with the given unique name, arguments, and options.
+func NewDatabase(ctx *pulumi.Context,
+	name string, args *DatabaseArgs, opts ...pulumi.ResourceOption) (*Database, error) {
+	if args == nil {
+		args = &DatabaseArgs{}
+	}
+
+	if isZero(args.DatabaseName) {
+		args.DatabaseName = pulumi.StringPtr("app")
+	}
+	if isZero(args.Image) {
+		args.Image = pulumi.StringPtr("postgres:14")
+	}
+	if isZero(args.StorageSize) {
+		args.StorageSize = pulumi.StringPtr("1Gi")
+	}
+	if isZero(args.Username) {
+		args.Username = pulumi.StringPtr("app")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"connectionString",
+		"password",
+	})
+	opts = append(opts, secrets)
+	var resource Database
+	err := ctx.RegisterRemoteComponentResource("productionapp:index:Database", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+type databaseArgs struct {
+	// The name of the database to create
+	DatabaseName *string `pulumi:"databaseName"`
+	// The Postgres image to run
+	Image *string `pulumi:"image"`
+	// The storage class for the persistent volume. Uses the cluster default when unset
+	StorageClassName *string `pulumi:"storageClassName"`
+	// The size of the persistent volume backing the database
+	StorageSize *string `pulumi:"storageSize"`
+	// The name of the database user to create
+	Username *string `pulumi:"username"`
+}
+
+// The set of arguments for constructing a Database resource.
+type DatabaseArgs struct {
+	// The name of the database to create
+	DatabaseName pulumi.StringPtrInput
+	// The Postgres image to run
+	Image pulumi.StringPtrInput
+	// The storage class for the persistent volume. Uses the cluster default when unset
+	StorageClassName pulumi.StringPtrInput
+	// The size of the persistent volume backing the database
+	StorageSize pulumi.StringPtrInput
+	// The name of the database user to create
+	Username pulumi.StringPtrInput
+}
+
+func (DatabaseArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*databaseArgs)(nil)).Elem()
+}
+
+type DatabaseInput interface {
+	pulumi.Input
+
+	ToDatabaseOutput() DatabaseOutput
+	ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput
+}
+
+func (*Database) ElementType() reflect.Type {
+	return reflect.TypeOf((**Database)(nil)).Elem()
+}
+
+func (i *Database) ToDatabaseOutput() DatabaseOutput {
+	return i.ToDatabaseOutputWithContext(context.Background())
+}
+
+func (i *Database) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseOutput)
+}
+
+// DatabaseArrayInput is an input type that accepts DatabaseArray and DatabaseArrayOutput values.
+// You can construct a concrete instance of `DatabaseArrayInput` via:
+//
+//	DatabaseArray{ DatabaseArgs{...} }
+type DatabaseArrayInput interface {
+	pulumi.Input
+
+	ToDatabaseArrayOutput() DatabaseArrayOutput
+	ToDatabaseArrayOutputWithContext(context.Context) DatabaseArrayOutput
+}
+
+type DatabaseArray []DatabaseInput
+
+func (DatabaseArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Database)(nil)).Elem()
+}
+
+func (i DatabaseArray) ToDatabaseArrayOutput() DatabaseArrayOutput {
+	return i.ToDatabaseArrayOutputWithContext(context.Background())
+}
+
+func (i DatabaseArray) ToDatabaseArrayOutputWithContext(ctx context.Context) DatabaseArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseArrayOutput)
+}
+
+// DatabaseMapInput is an input type that accepts DatabaseMap and DatabaseMapOutput values.
+// You can construct a concrete instance of `DatabaseMapInput` via:
+//
+//	DatabaseMap{ "key": DatabaseArgs{...} }
+type DatabaseMapInput interface {
+	pulumi.Input
+
+	ToDatabaseMapOutput() DatabaseMapOutput
+	ToDatabaseMapOutputWithContext(context.Context) DatabaseMapOutput
+}
+
+type DatabaseMap map[string]DatabaseInput
+
+func (DatabaseMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Database)(nil)).Elem()
+}
+
+func (i DatabaseMap) ToDatabaseMapOutput() DatabaseMapOutput {
+	return i.ToDatabaseMapOutputWithContext(context.Background())
+}
+
+func (i DatabaseMap) ToDatabaseMapOutputWithContext(ctx context.Context) DatabaseMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseMapOutput)
+}
+
+type DatabaseOutput struct{ *pulumi.OutputState }
+
+func (DatabaseOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Database)(nil)).Elem()
+}
+
+func (o DatabaseOutput) ToDatabaseOutput() DatabaseOutput {
+	return o
+}
+
+func (o DatabaseOutput) ToDatabaseOutputWithContext(ctx context.Context) DatabaseOutput {
+	return o
+}
+
+type DatabaseArrayOutput struct{ *pulumi.OutputState }
+
+func (DatabaseArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*Database)(nil)).Elem()
+}
+
+func (o DatabaseArrayOutput) ToDatabaseArrayOutput() DatabaseArrayOutput {
+	return o
+}
+
+func (o DatabaseArrayOutput) ToDatabaseArrayOutputWithContext(ctx context.Context) DatabaseArrayOutput {
+	return o
+}
+
+func (o DatabaseArrayOutput) Index(i pulumi.IntInput) DatabaseOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *Database {
+		return vs[0].([]*Database)[vs[1].(int)]
+	}).(DatabaseOutput)
+}
+
+type DatabaseMapOutput struct{ *pulumi.OutputState }
+
+func (DatabaseMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*Database)(nil)).Elem()
+}
+
+func (o DatabaseMapOutput) ToDatabaseMapOutput() DatabaseMapOutput {
+	return o
+}
+
+func (o DatabaseMapOutput) ToDatabaseMapOutputWithContext(ctx context.Context) DatabaseMapOutput {
+	return o
+}
+
+func (o DatabaseMapOutput) MapIndex(k pulumi.StringInput) DatabaseOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *Database {
+		return vs[0].(map[string]*Database)[vs[1].(string)]
+	}).(DatabaseOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseInput)(nil)).Elem(), &Database{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseArrayInput)(nil)).Elem(), DatabaseArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseMapInput)(nil)).Elem(), DatabaseMap{})
+	pulumi.RegisterOutputType(DatabaseOutput{})
+	pulumi.RegisterOutputType(DatabaseArrayOutput{})
+	pulumi.RegisterOutputType(DatabaseMapOutput{})
+}
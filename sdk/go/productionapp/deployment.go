@@ -11,11 +11,16 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// An application deployed to Kubernetes in a namespace of its own, behind a Service, with the availability settings its environment calls for.
 type Deployment struct {
 	pulumi.ResourceState
 
-	// The URL from the generated service
+	// The URL from the generated service, in the first cluster when deployed to several
 	Url pulumi.StringOutput `pulumi:"url"`
+	// The URL of the application in each cluster, keyed by cluster name
+	Urls pulumi.StringMapOutput `pulumi:"urls"`
+	// Where the application runs, one workload per cluster
+	Workloads WorkloadArrayOutput `pulumi:"workloads"`
 }
 
 // NewDeployment registers a new resource with the given unique name, arguments, and options.
@@ -31,6 +36,17 @@ func NewDeployment(ctx *pulumi.Context,
 	if args.Port == nil {
 		return nil, errors.New("invalid value for required argument 'Port'")
 	}
+	if isZero(args.AllowHostPath) {
+		allowHostPath_ := false
+		args.AllowHostPath = &allowHostPath_
+	}
+	if isZero(args.ServiceType) {
+		serviceType_ := ServiceType("LoadBalancer")
+		args.ServiceType = &serviceType_
+	}
+	if args.Kubeconfig != nil {
+		args.Kubeconfig = pulumi.ToSecret(args.Kubeconfig).(pulumi.StringPtrOutput)
+	}
 	var resource Deployment
 	err := ctx.RegisterRemoteComponentResource("productionapp:index:Deployment", name, args, &resource, opts...)
 	if err != nil {
@@ -40,24 +56,247 @@ func NewDeployment(ctx *pulumi.Context,
 }
 
 type deploymentArgs struct {
+	// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+	AllowHostPath *bool `pulumi:"allowHostPath"`
+	// Annotations added to every resource the component creates
+	Annotations map[string]string `pulumi:"annotations"`
+	// Overrides the arguments of the application image
+	Args []string `pulumi:"args"`
+	// Scales the application with a HorizontalPodAutoscaler
+	Autoscaling *Autoscaling `pulumi:"autoscaling"`
+	// How long to wait for the application to become ready
+	Await *Await `pulumi:"await"`
+	// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+	Cache *CacheConnection `pulumi:"cache"`
+	// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+	Clusters []Cluster `pulumi:"clusters"`
+	// Overrides the entrypoint of the application image
+	Command []string `pulumi:"command"`
+	// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+	Context *string `pulumi:"context"`
+	// A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+	Database *DatabaseConnection `pulumi:"database"`
+	// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+	Environment *Environment `pulumi:"environment"`
 	// The image to deploy in your production application
 	Image string `pulumi:"image"`
+	// An Ingress that routes a host name to the application. The url becomes the Ingress's
+	Ingress *Ingress `pulumi:"ingress"`
+	// The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+	Kubeconfig *string `pulumi:"kubeconfig"`
+	// Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+	Labels map[string]string `pulumi:"labels"`
+	// An HTTP check that restarts the application's container when it fails
+	LivenessProbe *Probe `pulumi:"livenessProbe"`
+	// Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+	Patches map[string]Patch `pulumi:"patches"`
+	// Annotations added to the application's pods
+	PodAnnotations map[string]string `pulumi:"podAnnotations"`
 	// The port your container listens on
 	Port int `pulumi:"port"`
+	// A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+	PreDeployJob *PreDeployJob `pulumi:"preDeployJob"`
+	// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+	Quota *Quota `pulumi:"quota"`
+	// An HTTP check that must pass before the application's pods receive traffic
+	ReadinessProbe *Probe `pulumi:"readinessProbe"`
+	// Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+	RegistryCredentials *RegistryCredentials `pulumi:"registryCredentials"`
+	// Resource requests and limits for the application's container, overriding the ones the environment or size sets
+	Resources *Resources `pulumi:"resources"`
+	// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+	SanitizeName *bool `pulumi:"sanitizeName"`
+	// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+	ServiceType *ServiceType `pulumi:"serviceType"`
+	// How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+	Shutdown *Shutdown `pulumi:"shutdown"`
+	// The resource preset for the application's container, replacing the environment's resources
+	Size *Size `pulumi:"size"`
+	// An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+	SmokeTest *SmokeTest `pulumi:"smokeTest"`
+	// Where to mount `volumes` in the application container
+	VolumeMounts []VolumeMount `pulumi:"volumeMounts"`
+	// Volumes available to the application container
+	Volumes []Volume `pulumi:"volumes"`
+	// The working directory of the application container
+	WorkingDir *string `pulumi:"workingDir"`
 }
 
 // The set of arguments for constructing a Deployment resource.
 type DeploymentArgs struct {
+	// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+	AllowHostPath *bool
+	// Annotations added to every resource the component creates
+	Annotations map[string]string
+	// Overrides the arguments of the application image
+	Args pulumi.StringArrayInput
+	// Scales the application with a HorizontalPodAutoscaler
+	Autoscaling *Autoscaling
+	// How long to wait for the application to become ready
+	Await *Await
+	// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+	Cache CacheConnectionPtrInput
+	// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+	Clusters []Cluster
+	// Overrides the entrypoint of the application image
+	Command pulumi.StringArrayInput
+	// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+	Context pulumi.StringPtrInput
+	// A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+	Database DatabaseConnectionPtrInput
+	// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+	Environment *Environment
 	// The image to deploy in your production application
 	Image pulumi.StringInput
+	// An Ingress that routes a host name to the application. The url becomes the Ingress's
+	Ingress *Ingress
+	// The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+	Kubeconfig pulumi.StringPtrInput
+	// Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+	Labels map[string]string
+	// An HTTP check that restarts the application's container when it fails
+	LivenessProbe *Probe
+	// Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+	Patches map[string]Patch
+	// Annotations added to the application's pods
+	PodAnnotations map[string]string
 	// The port your container listens on
 	Port pulumi.IntInput
+	// A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+	PreDeployJob *PreDeployJob
+	// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+	Quota *Quota
+	// An HTTP check that must pass before the application's pods receive traffic
+	ReadinessProbe *Probe
+	// Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+	RegistryCredentials RegistryCredentialsPtrInput
+	// Resource requests and limits for the application's container, overriding the ones the environment or size sets
+	Resources *Resources
+	// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+	SanitizeName *bool
+	// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+	ServiceType *ServiceType
+	// How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+	Shutdown *Shutdown
+	// The resource preset for the application's container, replacing the environment's resources
+	Size *Size
+	// An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+	SmokeTest *SmokeTest
+	// Where to mount `volumes` in the application container
+	VolumeMounts []VolumeMount
+	// Volumes available to the application container
+	Volumes []Volume
+	// The working directory of the application container
+	WorkingDir pulumi.StringPtrInput
 }
 
 func (DeploymentArgs) ElementType() reflect.Type {
 	return reflect.TypeOf((*deploymentArgs)(nil)).Elem()
 }
 
+// Returns kubectl commands for inspecting and operating the application. Commands for a workload deployed with the contents of a kubeconfig read them from the file named by `kubeconfig`, which the workload's `kubeconfig` must be saved to.
+func (r *Deployment) GetKubectlCommands(ctx *pulumi.Context) (DeploymentGetKubectlCommandsResultOutput, error) {
+	out, err := ctx.Call("productionapp:index:Deployment/getKubectlCommands", nil, DeploymentGetKubectlCommandsResultOutput{}, r)
+	if err != nil {
+		return DeploymentGetKubectlCommandsResultOutput{}, err
+	}
+	return out.(DeploymentGetKubectlCommandsResultOutput), nil
+}
+
+type DeploymentGetKubectlCommandsResult struct {
+	// The commands for each of the application's workloads
+	Commands []KubectlCommands `pulumi:"commands"`
+}
+
+type DeploymentGetKubectlCommandsResultOutput struct{ *pulumi.OutputState }
+
+func (DeploymentGetKubectlCommandsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DeploymentGetKubectlCommandsResult)(nil)).Elem()
+}
+
+// The commands for each of the application's workloads
+func (o DeploymentGetKubectlCommandsResultOutput) Commands() KubectlCommandsArrayOutput {
+	return o.ApplyT(func(v DeploymentGetKubectlCommandsResult) []KubectlCommands { return v.Commands }).(KubectlCommandsArrayOutput)
+}
+
+// Reads the live status of the application's Deployments with kubectl, using the kubeconfig and context each Deployment was deployed with.
+func (r *Deployment) GetStatus(ctx *pulumi.Context) (DeploymentGetStatusResultOutput, error) {
+	out, err := ctx.Call("productionapp:index:Deployment/getStatus", nil, DeploymentGetStatusResultOutput{}, r)
+	if err != nil {
+		return DeploymentGetStatusResultOutput{}, err
+	}
+	return out.(DeploymentGetStatusResultOutput), nil
+}
+
+type DeploymentGetStatusResult struct {
+	// The number of available pods, across all clusters
+	AvailableReplicas int `pulumi:"availableReplicas"`
+	// The status in each cluster, keyed by cluster name, when the application is deployed to several
+	Clusters map[string]ReplicaStatus `pulumi:"clusters"`
+	// The number of ready pods, across all clusters
+	ReadyReplicas int `pulumi:"readyReplicas"`
+	// The number of pods, across all clusters
+	Replicas int `pulumi:"replicas"`
+	// The number of pods running the latest pod template, across all clusters
+	UpdatedReplicas int `pulumi:"updatedReplicas"`
+}
+
+type DeploymentGetStatusResultOutput struct{ *pulumi.OutputState }
+
+func (DeploymentGetStatusResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DeploymentGetStatusResult)(nil)).Elem()
+}
+
+// The number of available pods, across all clusters
+func (o DeploymentGetStatusResultOutput) AvailableReplicas() pulumi.IntOutput {
+	return o.ApplyT(func(v DeploymentGetStatusResult) int { return v.AvailableReplicas }).(pulumi.IntOutput)
+}
+
+// The status in each cluster, keyed by cluster name, when the application is deployed to several
+func (o DeploymentGetStatusResultOutput) Clusters() ReplicaStatusMapOutput {
+	return o.ApplyT(func(v DeploymentGetStatusResult) map[string]ReplicaStatus { return v.Clusters }).(ReplicaStatusMapOutput)
+}
+
+// The number of ready pods, across all clusters
+func (o DeploymentGetStatusResultOutput) ReadyReplicas() pulumi.IntOutput {
+	return o.ApplyT(func(v DeploymentGetStatusResult) int { return v.ReadyReplicas }).(pulumi.IntOutput)
+}
+
+// The number of pods, across all clusters
+func (o DeploymentGetStatusResultOutput) Replicas() pulumi.IntOutput {
+	return o.ApplyT(func(v DeploymentGetStatusResult) int { return v.Replicas }).(pulumi.IntOutput)
+}
+
+// The number of pods running the latest pod template, across all clusters
+func (o DeploymentGetStatusResultOutput) UpdatedReplicas() pulumi.IntOutput {
+	return o.ApplyT(func(v DeploymentGetStatusResult) int { return v.UpdatedReplicas }).(pulumi.IntOutput)
+}
+
+// Restarts the application's pods with a rolling update, by bumping the `kubectl.kubernetes.io/restartedAt` annotation on the pod template of each of its Deployments. Runs kubectl with the kubeconfig and context each Deployment was deployed with. Previews don't restart anything.
+func (r *Deployment) Restart(ctx *pulumi.Context) (DeploymentRestartResultOutput, error) {
+	out, err := ctx.Call("productionapp:index:Deployment/restart", nil, DeploymentRestartResultOutput{}, r)
+	if err != nil {
+		return DeploymentRestartResultOutput{}, err
+	}
+	return out.(DeploymentRestartResultOutput), nil
+}
+
+type DeploymentRestartResult struct {
+	// When the application was restarted, in RFC 3339 format. Empty during previews
+	RestartedAt string `pulumi:"restartedAt"`
+}
+
+type DeploymentRestartResultOutput struct{ *pulumi.OutputState }
+
+func (DeploymentRestartResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DeploymentRestartResult)(nil)).Elem()
+}
+
+// When the application was restarted, in RFC 3339 format. Empty during previews
+func (o DeploymentRestartResultOutput) RestartedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v DeploymentRestartResult) string { return v.RestartedAt }).(pulumi.StringOutput)
+}
+
 type DeploymentInput interface {
 	pulumi.Input
 
@@ -80,7 +319,7 @@ func (i *Deployment) ToDeploymentOutputWithContext(ctx context.Context) Deployme
 // DeploymentArrayInput is an input type that accepts DeploymentArray and DeploymentArrayOutput values.
 // You can construct a concrete instance of `DeploymentArrayInput` via:
 //
-//          DeploymentArray{ DeploymentArgs{...} }
+//	DeploymentArray{ DeploymentArgs{...} }
 type DeploymentArrayInput interface {
 	pulumi.Input
 
@@ -105,7 +344,7 @@ func (i DeploymentArray) ToDeploymentArrayOutputWithContext(ctx context.Context)
 // DeploymentMapInput is an input type that accepts DeploymentMap and DeploymentMapOutput values.
 // You can construct a concrete instance of `DeploymentMapInput` via:
 //
-//          DeploymentMap{ "key": DeploymentArgs{...} }
+//	DeploymentMap{ "key": DeploymentArgs{...} }
 type DeploymentMapInput interface {
 	pulumi.Input
 
@@ -186,6 +425,9 @@ func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*DeploymentArrayInput)(nil)).Elem(), DeploymentArray{})
 	pulumi.RegisterInputType(reflect.TypeOf((*DeploymentMapInput)(nil)).Elem(), DeploymentMap{})
 	pulumi.RegisterOutputType(DeploymentOutput{})
+	pulumi.RegisterOutputType(DeploymentGetKubectlCommandsResultOutput{})
+	pulumi.RegisterOutputType(DeploymentGetStatusResultOutput{})
+	pulumi.RegisterOutputType(DeploymentRestartResultOutput{})
 	pulumi.RegisterOutputType(DeploymentArrayOutput{})
 	pulumi.RegisterOutputType(DeploymentMapOutput{})
 }
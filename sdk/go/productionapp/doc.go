@@ -1,3 +1,2 @@
 // Package productionapp exports types, functions, subpackages for provisioning productionapp resources.
-//
 package productionapp
@@ -20,6 +20,10 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
+	case "productionapp:index:Cache":
+		r = &Cache{}
+	case "productionapp:index:Database":
+		r = &Database{}
 	case "productionapp:index:Deployment":
 		r = &Deployment{}
 	default:
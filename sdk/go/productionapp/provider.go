@@ -10,6 +10,7 @@ import (
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 )
 
+// The provider type for the productionapp package. Its configuration sets organisation-wide defaults for the components it creates.
 type Provider struct {
 	pulumi.ProviderResourceState
 }
@@ -30,10 +31,22 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+	DefaultLabels map[string]string `pulumi:"defaultLabels"`
+	// A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+	DefaultRegistry *string `pulumi:"defaultRegistry"`
+	// The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+	Environment *string `pulumi:"environment"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+	DefaultLabels pulumi.StringMapInput
+	// A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+	DefaultRegistry pulumi.StringPtrInput
+	// The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+	Environment pulumi.StringPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
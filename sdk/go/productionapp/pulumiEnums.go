@@ -0,0 +1,533 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package productionapp
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// An environment tier, which selects replicas, resources and availability settings.
+type Environment string
+
+const (
+	// One small replica
+	EnvironmentDev = Environment("dev")
+	// Two replicas spread across nodes with a PodDisruptionBudget
+	EnvironmentStaging = Environment("staging")
+	// Three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace
+	EnvironmentProd = Environment("prod")
+)
+
+func (Environment) ElementType() reflect.Type {
+	return reflect.TypeOf((*Environment)(nil)).Elem()
+}
+
+func (e Environment) ToEnvironmentOutput() EnvironmentOutput {
+	return pulumi.ToOutput(e).(EnvironmentOutput)
+}
+
+func (e Environment) ToEnvironmentOutputWithContext(ctx context.Context) EnvironmentOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(EnvironmentOutput)
+}
+
+func (e Environment) ToEnvironmentPtrOutput() EnvironmentPtrOutput {
+	return e.ToEnvironmentPtrOutputWithContext(context.Background())
+}
+
+func (e Environment) ToEnvironmentPtrOutputWithContext(ctx context.Context) EnvironmentPtrOutput {
+	return Environment(e).ToEnvironmentOutputWithContext(ctx).ToEnvironmentPtrOutputWithContext(ctx)
+}
+
+func (e Environment) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Environment) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Environment) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e Environment) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type EnvironmentOutput struct{ *pulumi.OutputState }
+
+func (EnvironmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Environment)(nil)).Elem()
+}
+
+func (o EnvironmentOutput) ToEnvironmentOutput() EnvironmentOutput {
+	return o
+}
+
+func (o EnvironmentOutput) ToEnvironmentOutputWithContext(ctx context.Context) EnvironmentOutput {
+	return o
+}
+
+func (o EnvironmentOutput) ToEnvironmentPtrOutput() EnvironmentPtrOutput {
+	return o.ToEnvironmentPtrOutputWithContext(context.Background())
+}
+
+func (o EnvironmentOutput) ToEnvironmentPtrOutputWithContext(ctx context.Context) EnvironmentPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Environment) *Environment {
+		return &v
+	}).(EnvironmentPtrOutput)
+}
+
+func (o EnvironmentOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o EnvironmentOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Environment) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o EnvironmentOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o EnvironmentOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Environment) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type EnvironmentPtrOutput struct{ *pulumi.OutputState }
+
+func (EnvironmentPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Environment)(nil)).Elem()
+}
+
+func (o EnvironmentPtrOutput) ToEnvironmentPtrOutput() EnvironmentPtrOutput {
+	return o
+}
+
+func (o EnvironmentPtrOutput) ToEnvironmentPtrOutputWithContext(ctx context.Context) EnvironmentPtrOutput {
+	return o
+}
+
+func (o EnvironmentPtrOutput) Elem() EnvironmentOutput {
+	return o.ApplyT(func(v *Environment) Environment {
+		if v != nil {
+			return *v
+		}
+		var ret Environment
+		return ret
+	}).(EnvironmentOutput)
+}
+
+func (o EnvironmentPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o EnvironmentPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *Environment) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// EnvironmentInput is an input type that accepts EnvironmentArgs and EnvironmentOutput values.
+// You can construct a concrete instance of `EnvironmentInput` via:
+//
+//	EnvironmentArgs{...}
+type EnvironmentInput interface {
+	pulumi.Input
+
+	ToEnvironmentOutput() EnvironmentOutput
+	ToEnvironmentOutputWithContext(context.Context) EnvironmentOutput
+}
+
+var environmentPtrType = reflect.TypeOf((**Environment)(nil)).Elem()
+
+type EnvironmentPtrInput interface {
+	pulumi.Input
+
+	ToEnvironmentPtrOutput() EnvironmentPtrOutput
+	ToEnvironmentPtrOutputWithContext(context.Context) EnvironmentPtrOutput
+}
+
+type environmentPtr string
+
+func EnvironmentPtr(v string) EnvironmentPtrInput {
+	return (*environmentPtr)(&v)
+}
+
+func (*environmentPtr) ElementType() reflect.Type {
+	return environmentPtrType
+}
+
+func (in *environmentPtr) ToEnvironmentPtrOutput() EnvironmentPtrOutput {
+	return pulumi.ToOutput(in).(EnvironmentPtrOutput)
+}
+
+func (in *environmentPtr) ToEnvironmentPtrOutputWithContext(ctx context.Context) EnvironmentPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(EnvironmentPtrOutput)
+}
+
+// The type of Service the application is exposed through.
+type ServiceType string
+
+const (
+	// Only reachable from inside the cluster
+	ServiceTypeClusterIP = ServiceType("ClusterIP")
+	// Reachable on a port of every node
+	ServiceTypeNodePort = ServiceType("NodePort")
+	// Reachable through a cloud load balancer
+	ServiceTypeLoadBalancer = ServiceType("LoadBalancer")
+)
+
+func (ServiceType) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceType)(nil)).Elem()
+}
+
+func (e ServiceType) ToServiceTypeOutput() ServiceTypeOutput {
+	return pulumi.ToOutput(e).(ServiceTypeOutput)
+}
+
+func (e ServiceType) ToServiceTypeOutputWithContext(ctx context.Context) ServiceTypeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ServiceTypeOutput)
+}
+
+func (e ServiceType) ToServiceTypePtrOutput() ServiceTypePtrOutput {
+	return e.ToServiceTypePtrOutputWithContext(context.Background())
+}
+
+func (e ServiceType) ToServiceTypePtrOutputWithContext(ctx context.Context) ServiceTypePtrOutput {
+	return ServiceType(e).ToServiceTypeOutputWithContext(ctx).ToServiceTypePtrOutputWithContext(ctx)
+}
+
+func (e ServiceType) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ServiceType) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ServiceType) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ServiceType) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ServiceTypeOutput struct{ *pulumi.OutputState }
+
+func (ServiceTypeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ServiceType)(nil)).Elem()
+}
+
+func (o ServiceTypeOutput) ToServiceTypeOutput() ServiceTypeOutput {
+	return o
+}
+
+func (o ServiceTypeOutput) ToServiceTypeOutputWithContext(ctx context.Context) ServiceTypeOutput {
+	return o
+}
+
+func (o ServiceTypeOutput) ToServiceTypePtrOutput() ServiceTypePtrOutput {
+	return o.ToServiceTypePtrOutputWithContext(context.Background())
+}
+
+func (o ServiceTypeOutput) ToServiceTypePtrOutputWithContext(ctx context.Context) ServiceTypePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ServiceType) *ServiceType {
+		return &v
+	}).(ServiceTypePtrOutput)
+}
+
+func (o ServiceTypeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o ServiceTypeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ServiceType) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o ServiceTypeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ServiceTypeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ServiceType) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type ServiceTypePtrOutput struct{ *pulumi.OutputState }
+
+func (ServiceTypePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ServiceType)(nil)).Elem()
+}
+
+func (o ServiceTypePtrOutput) ToServiceTypePtrOutput() ServiceTypePtrOutput {
+	return o
+}
+
+func (o ServiceTypePtrOutput) ToServiceTypePtrOutputWithContext(ctx context.Context) ServiceTypePtrOutput {
+	return o
+}
+
+func (o ServiceTypePtrOutput) Elem() ServiceTypeOutput {
+	return o.ApplyT(func(v *ServiceType) ServiceType {
+		if v != nil {
+			return *v
+		}
+		var ret ServiceType
+		return ret
+	}).(ServiceTypeOutput)
+}
+
+func (o ServiceTypePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ServiceTypePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *ServiceType) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// ServiceTypeInput is an input type that accepts ServiceTypeArgs and ServiceTypeOutput values.
+// You can construct a concrete instance of `ServiceTypeInput` via:
+//
+//	ServiceTypeArgs{...}
+type ServiceTypeInput interface {
+	pulumi.Input
+
+	ToServiceTypeOutput() ServiceTypeOutput
+	ToServiceTypeOutputWithContext(context.Context) ServiceTypeOutput
+}
+
+var serviceTypePtrType = reflect.TypeOf((**ServiceType)(nil)).Elem()
+
+type ServiceTypePtrInput interface {
+	pulumi.Input
+
+	ToServiceTypePtrOutput() ServiceTypePtrOutput
+	ToServiceTypePtrOutputWithContext(context.Context) ServiceTypePtrOutput
+}
+
+type serviceTypePtr string
+
+func ServiceTypePtr(v string) ServiceTypePtrInput {
+	return (*serviceTypePtr)(&v)
+}
+
+func (*serviceTypePtr) ElementType() reflect.Type {
+	return serviceTypePtrType
+}
+
+func (in *serviceTypePtr) ToServiceTypePtrOutput() ServiceTypePtrOutput {
+	return pulumi.ToOutput(in).(ServiceTypePtrOutput)
+}
+
+func (in *serviceTypePtr) ToServiceTypePtrOutputWithContext(ctx context.Context) ServiceTypePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(ServiceTypePtrOutput)
+}
+
+// A preset of container resource requests and limits.
+type Size string
+
+const (
+	// 100m CPU and 128Mi memory requested, limited to 250m and 256Mi
+	SizeSmall = Size("small")
+	// 250m CPU and 512Mi memory requested, limited to 500m and 1Gi
+	SizeMedium = Size("medium")
+	// 500m CPU and 2Gi memory requested, limited to 1 CPU and 4Gi
+	SizeLarge = Size("large")
+)
+
+func (Size) ElementType() reflect.Type {
+	return reflect.TypeOf((*Size)(nil)).Elem()
+}
+
+func (e Size) ToSizeOutput() SizeOutput {
+	return pulumi.ToOutput(e).(SizeOutput)
+}
+
+func (e Size) ToSizeOutputWithContext(ctx context.Context) SizeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(SizeOutput)
+}
+
+func (e Size) ToSizePtrOutput() SizePtrOutput {
+	return e.ToSizePtrOutputWithContext(context.Background())
+}
+
+func (e Size) ToSizePtrOutputWithContext(ctx context.Context) SizePtrOutput {
+	return Size(e).ToSizeOutputWithContext(ctx).ToSizePtrOutputWithContext(ctx)
+}
+
+func (e Size) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Size) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e Size) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e Size) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type SizeOutput struct{ *pulumi.OutputState }
+
+func (SizeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Size)(nil)).Elem()
+}
+
+func (o SizeOutput) ToSizeOutput() SizeOutput {
+	return o
+}
+
+func (o SizeOutput) ToSizeOutputWithContext(ctx context.Context) SizeOutput {
+	return o
+}
+
+func (o SizeOutput) ToSizePtrOutput() SizePtrOutput {
+	return o.ToSizePtrOutputWithContext(context.Background())
+}
+
+func (o SizeOutput) ToSizePtrOutputWithContext(ctx context.Context) SizePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Size) *Size {
+		return &v
+	}).(SizePtrOutput)
+}
+
+func (o SizeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o SizeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Size) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o SizeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SizeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e Size) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type SizePtrOutput struct{ *pulumi.OutputState }
+
+func (SizePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Size)(nil)).Elem()
+}
+
+func (o SizePtrOutput) ToSizePtrOutput() SizePtrOutput {
+	return o
+}
+
+func (o SizePtrOutput) ToSizePtrOutputWithContext(ctx context.Context) SizePtrOutput {
+	return o
+}
+
+func (o SizePtrOutput) Elem() SizeOutput {
+	return o.ApplyT(func(v *Size) Size {
+		if v != nil {
+			return *v
+		}
+		var ret Size
+		return ret
+	}).(SizeOutput)
+}
+
+func (o SizePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SizePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *Size) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// SizeInput is an input type that accepts SizeArgs and SizeOutput values.
+// You can construct a concrete instance of `SizeInput` via:
+//
+//	SizeArgs{...}
+type SizeInput interface {
+	pulumi.Input
+
+	ToSizeOutput() SizeOutput
+	ToSizeOutputWithContext(context.Context) SizeOutput
+}
+
+var sizePtrType = reflect.TypeOf((**Size)(nil)).Elem()
+
+type SizePtrInput interface {
+	pulumi.Input
+
+	ToSizePtrOutput() SizePtrOutput
+	ToSizePtrOutputWithContext(context.Context) SizePtrOutput
+}
+
+type sizePtr string
+
+func SizePtr(v string) SizePtrInput {
+	return (*sizePtr)(&v)
+}
+
+func (*sizePtr) ElementType() reflect.Type {
+	return sizePtrType
+}
+
+func (in *sizePtr) ToSizePtrOutput() SizePtrOutput {
+	return pulumi.ToOutput(in).(SizePtrOutput)
+}
+
+func (in *sizePtr) ToSizePtrOutputWithContext(ctx context.Context) SizePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(SizePtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*EnvironmentInput)(nil)).Elem(), Environment("dev"))
+	pulumi.RegisterInputType(reflect.TypeOf((*EnvironmentPtrInput)(nil)).Elem(), Environment("dev"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ServiceTypeInput)(nil)).Elem(), ServiceType("ClusterIP"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ServiceTypePtrInput)(nil)).Elem(), ServiceType("ClusterIP"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SizeInput)(nil)).Elem(), Size("small"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SizePtrInput)(nil)).Elem(), Size("small"))
+	pulumi.RegisterOutputType(EnvironmentOutput{})
+	pulumi.RegisterOutputType(EnvironmentPtrOutput{})
+	pulumi.RegisterOutputType(ServiceTypeOutput{})
+	pulumi.RegisterOutputType(ServiceTypePtrOutput{})
+	pulumi.RegisterOutputType(SizeOutput{})
+	pulumi.RegisterOutputType(SizePtrOutput{})
+}
@@ -0,0 +1,3815 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package productionapp
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+type Autoscaling struct {
+	// The most replicas to scale to. Defaults to the environment's maximum, or 10
+	MaxReplicas *int `pulumi:"maxReplicas"`
+	// The fewest replicas to scale to. Defaults to the application's replicas
+	MinReplicas *int `pulumi:"minReplicas"`
+	// The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+	TargetCpuUtilizationPercentage *int `pulumi:"targetCpuUtilizationPercentage"`
+}
+
+// AutoscalingInput is an input type that accepts AutoscalingArgs and AutoscalingOutput values.
+// You can construct a concrete instance of `AutoscalingInput` via:
+//
+//	AutoscalingArgs{...}
+type AutoscalingInput interface {
+	pulumi.Input
+
+	ToAutoscalingOutput() AutoscalingOutput
+	ToAutoscalingOutputWithContext(context.Context) AutoscalingOutput
+}
+
+// Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+type AutoscalingArgs struct {
+	// The most replicas to scale to. Defaults to the environment's maximum, or 10
+	MaxReplicas *int `pulumi:"maxReplicas"`
+	// The fewest replicas to scale to. Defaults to the application's replicas
+	MinReplicas *int `pulumi:"minReplicas"`
+	// The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+	TargetCpuUtilizationPercentage *int `pulumi:"targetCpuUtilizationPercentage"`
+}
+
+func (AutoscalingArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Autoscaling)(nil)).Elem()
+}
+
+func (i AutoscalingArgs) ToAutoscalingOutput() AutoscalingOutput {
+	return i.ToAutoscalingOutputWithContext(context.Background())
+}
+
+func (i AutoscalingArgs) ToAutoscalingOutputWithContext(ctx context.Context) AutoscalingOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoscalingOutput)
+}
+
+func (i AutoscalingArgs) ToAutoscalingPtrOutput() AutoscalingPtrOutput {
+	return i.ToAutoscalingPtrOutputWithContext(context.Background())
+}
+
+func (i AutoscalingArgs) ToAutoscalingPtrOutputWithContext(ctx context.Context) AutoscalingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoscalingOutput).ToAutoscalingPtrOutputWithContext(ctx)
+}
+
+// AutoscalingPtrInput is an input type that accepts AutoscalingArgs, AutoscalingPtr and AutoscalingPtrOutput values.
+// You can construct a concrete instance of `AutoscalingPtrInput` via:
+//
+//	        AutoscalingArgs{...}
+//
+//	or:
+//
+//	        nil
+type AutoscalingPtrInput interface {
+	pulumi.Input
+
+	ToAutoscalingPtrOutput() AutoscalingPtrOutput
+	ToAutoscalingPtrOutputWithContext(context.Context) AutoscalingPtrOutput
+}
+
+type autoscalingPtrType AutoscalingArgs
+
+func AutoscalingPtr(v *AutoscalingArgs) AutoscalingPtrInput {
+	return (*autoscalingPtrType)(v)
+}
+
+func (*autoscalingPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Autoscaling)(nil)).Elem()
+}
+
+func (i *autoscalingPtrType) ToAutoscalingPtrOutput() AutoscalingPtrOutput {
+	return i.ToAutoscalingPtrOutputWithContext(context.Background())
+}
+
+func (i *autoscalingPtrType) ToAutoscalingPtrOutputWithContext(ctx context.Context) AutoscalingPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AutoscalingPtrOutput)
+}
+
+// Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+type AutoscalingOutput struct{ *pulumi.OutputState }
+
+func (AutoscalingOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Autoscaling)(nil)).Elem()
+}
+
+func (o AutoscalingOutput) ToAutoscalingOutput() AutoscalingOutput {
+	return o
+}
+
+func (o AutoscalingOutput) ToAutoscalingOutputWithContext(ctx context.Context) AutoscalingOutput {
+	return o
+}
+
+func (o AutoscalingOutput) ToAutoscalingPtrOutput() AutoscalingPtrOutput {
+	return o.ToAutoscalingPtrOutputWithContext(context.Background())
+}
+
+func (o AutoscalingOutput) ToAutoscalingPtrOutputWithContext(ctx context.Context) AutoscalingPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Autoscaling) *Autoscaling {
+		return &v
+	}).(AutoscalingPtrOutput)
+}
+
+// The most replicas to scale to. Defaults to the environment's maximum, or 10
+func (o AutoscalingOutput) MaxReplicas() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Autoscaling) *int { return v.MaxReplicas }).(pulumi.IntPtrOutput)
+}
+
+// The fewest replicas to scale to. Defaults to the application's replicas
+func (o AutoscalingOutput) MinReplicas() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Autoscaling) *int { return v.MinReplicas }).(pulumi.IntPtrOutput)
+}
+
+// The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+func (o AutoscalingOutput) TargetCpuUtilizationPercentage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Autoscaling) *int { return v.TargetCpuUtilizationPercentage }).(pulumi.IntPtrOutput)
+}
+
+type AutoscalingPtrOutput struct{ *pulumi.OutputState }
+
+func (AutoscalingPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Autoscaling)(nil)).Elem()
+}
+
+func (o AutoscalingPtrOutput) ToAutoscalingPtrOutput() AutoscalingPtrOutput {
+	return o
+}
+
+func (o AutoscalingPtrOutput) ToAutoscalingPtrOutputWithContext(ctx context.Context) AutoscalingPtrOutput {
+	return o
+}
+
+func (o AutoscalingPtrOutput) Elem() AutoscalingOutput {
+	return o.ApplyT(func(v *Autoscaling) Autoscaling {
+		if v != nil {
+			return *v
+		}
+		var ret Autoscaling
+		return ret
+	}).(AutoscalingOutput)
+}
+
+// The most replicas to scale to. Defaults to the environment's maximum, or 10
+func (o AutoscalingPtrOutput) MaxReplicas() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Autoscaling) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxReplicas
+	}).(pulumi.IntPtrOutput)
+}
+
+// The fewest replicas to scale to. Defaults to the application's replicas
+func (o AutoscalingPtrOutput) MinReplicas() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Autoscaling) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MinReplicas
+	}).(pulumi.IntPtrOutput)
+}
+
+// The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+func (o AutoscalingPtrOutput) TargetCpuUtilizationPercentage() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Autoscaling) *int {
+		if v == nil {
+			return nil
+		}
+		return v.TargetCpuUtilizationPercentage
+	}).(pulumi.IntPtrOutput)
+}
+
+// Controls how long Pulumi waits for the application to become ready.
+type Await struct {
+	// How long to wait for the application's resources to be created. Defaults to 10 minutes
+	CreateTimeoutSeconds *int `pulumi:"createTimeoutSeconds"`
+	// Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+	SkipAwait *bool `pulumi:"skipAwait"`
+	// How long to wait for the application's resources to be updated. Defaults to 10 minutes
+	UpdateTimeoutSeconds *int `pulumi:"updateTimeoutSeconds"`
+	// Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+	WaitForLoadBalancer *bool `pulumi:"waitForLoadBalancer"`
+}
+
+// AwaitInput is an input type that accepts AwaitArgs and AwaitOutput values.
+// You can construct a concrete instance of `AwaitInput` via:
+//
+//	AwaitArgs{...}
+type AwaitInput interface {
+	pulumi.Input
+
+	ToAwaitOutput() AwaitOutput
+	ToAwaitOutputWithContext(context.Context) AwaitOutput
+}
+
+// Controls how long Pulumi waits for the application to become ready.
+type AwaitArgs struct {
+	// How long to wait for the application's resources to be created. Defaults to 10 minutes
+	CreateTimeoutSeconds *int `pulumi:"createTimeoutSeconds"`
+	// Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+	SkipAwait *bool `pulumi:"skipAwait"`
+	// How long to wait for the application's resources to be updated. Defaults to 10 minutes
+	UpdateTimeoutSeconds *int `pulumi:"updateTimeoutSeconds"`
+	// Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+	WaitForLoadBalancer *bool `pulumi:"waitForLoadBalancer"`
+}
+
+func (AwaitArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Await)(nil)).Elem()
+}
+
+func (i AwaitArgs) ToAwaitOutput() AwaitOutput {
+	return i.ToAwaitOutputWithContext(context.Background())
+}
+
+func (i AwaitArgs) ToAwaitOutputWithContext(ctx context.Context) AwaitOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AwaitOutput)
+}
+
+func (i AwaitArgs) ToAwaitPtrOutput() AwaitPtrOutput {
+	return i.ToAwaitPtrOutputWithContext(context.Background())
+}
+
+func (i AwaitArgs) ToAwaitPtrOutputWithContext(ctx context.Context) AwaitPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AwaitOutput).ToAwaitPtrOutputWithContext(ctx)
+}
+
+// AwaitPtrInput is an input type that accepts AwaitArgs, AwaitPtr and AwaitPtrOutput values.
+// You can construct a concrete instance of `AwaitPtrInput` via:
+//
+//	        AwaitArgs{...}
+//
+//	or:
+//
+//	        nil
+type AwaitPtrInput interface {
+	pulumi.Input
+
+	ToAwaitPtrOutput() AwaitPtrOutput
+	ToAwaitPtrOutputWithContext(context.Context) AwaitPtrOutput
+}
+
+type awaitPtrType AwaitArgs
+
+func AwaitPtr(v *AwaitArgs) AwaitPtrInput {
+	return (*awaitPtrType)(v)
+}
+
+func (*awaitPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Await)(nil)).Elem()
+}
+
+func (i *awaitPtrType) ToAwaitPtrOutput() AwaitPtrOutput {
+	return i.ToAwaitPtrOutputWithContext(context.Background())
+}
+
+func (i *awaitPtrType) ToAwaitPtrOutputWithContext(ctx context.Context) AwaitPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(AwaitPtrOutput)
+}
+
+// Controls how long Pulumi waits for the application to become ready.
+type AwaitOutput struct{ *pulumi.OutputState }
+
+func (AwaitOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Await)(nil)).Elem()
+}
+
+func (o AwaitOutput) ToAwaitOutput() AwaitOutput {
+	return o
+}
+
+func (o AwaitOutput) ToAwaitOutputWithContext(ctx context.Context) AwaitOutput {
+	return o
+}
+
+func (o AwaitOutput) ToAwaitPtrOutput() AwaitPtrOutput {
+	return o.ToAwaitPtrOutputWithContext(context.Background())
+}
+
+func (o AwaitOutput) ToAwaitPtrOutputWithContext(ctx context.Context) AwaitPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Await) *Await {
+		return &v
+	}).(AwaitPtrOutput)
+}
+
+// How long to wait for the application's resources to be created. Defaults to 10 minutes
+func (o AwaitOutput) CreateTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Await) *int { return v.CreateTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+// Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+func (o AwaitOutput) SkipAwait() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Await) *bool { return v.SkipAwait }).(pulumi.BoolPtrOutput)
+}
+
+// How long to wait for the application's resources to be updated. Defaults to 10 minutes
+func (o AwaitOutput) UpdateTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Await) *int { return v.UpdateTimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+// Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+func (o AwaitOutput) WaitForLoadBalancer() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v Await) *bool { return v.WaitForLoadBalancer }).(pulumi.BoolPtrOutput)
+}
+
+type AwaitPtrOutput struct{ *pulumi.OutputState }
+
+func (AwaitPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Await)(nil)).Elem()
+}
+
+func (o AwaitPtrOutput) ToAwaitPtrOutput() AwaitPtrOutput {
+	return o
+}
+
+func (o AwaitPtrOutput) ToAwaitPtrOutputWithContext(ctx context.Context) AwaitPtrOutput {
+	return o
+}
+
+func (o AwaitPtrOutput) Elem() AwaitOutput {
+	return o.ApplyT(func(v *Await) Await {
+		if v != nil {
+			return *v
+		}
+		var ret Await
+		return ret
+	}).(AwaitOutput)
+}
+
+// How long to wait for the application's resources to be created. Defaults to 10 minutes
+func (o AwaitPtrOutput) CreateTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Await) *int {
+		if v == nil {
+			return nil
+		}
+		return v.CreateTimeoutSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+func (o AwaitPtrOutput) SkipAwait() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Await) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.SkipAwait
+	}).(pulumi.BoolPtrOutput)
+}
+
+// How long to wait for the application's resources to be updated. Defaults to 10 minutes
+func (o AwaitPtrOutput) UpdateTimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Await) *int {
+		if v == nil {
+			return nil
+		}
+		return v.UpdateTimeoutSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+func (o AwaitPtrOutput) WaitForLoadBalancer() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *Await) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.WaitForLoadBalancer
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Describes how an application connects to a cache.
+type CacheConnection struct {
+	// The connection string for the cache, such as the `connectionString` output of a `Cache`
+	ConnectionString string `pulumi:"connectionString"`
+	// The hostname of the cache, such as the `host` output of a `Cache`
+	Host string `pulumi:"host"`
+	// The port of the cache, such as the `port` output of a `Cache`
+	Port int `pulumi:"port"`
+}
+
+// CacheConnectionInput is an input type that accepts CacheConnectionArgs and CacheConnectionOutput values.
+// You can construct a concrete instance of `CacheConnectionInput` via:
+//
+//	CacheConnectionArgs{...}
+type CacheConnectionInput interface {
+	pulumi.Input
+
+	ToCacheConnectionOutput() CacheConnectionOutput
+	ToCacheConnectionOutputWithContext(context.Context) CacheConnectionOutput
+}
+
+// Describes how an application connects to a cache.
+type CacheConnectionArgs struct {
+	// The connection string for the cache, such as the `connectionString` output of a `Cache`
+	ConnectionString pulumi.StringInput `pulumi:"connectionString"`
+	// The hostname of the cache, such as the `host` output of a `Cache`
+	Host pulumi.StringInput `pulumi:"host"`
+	// The port of the cache, such as the `port` output of a `Cache`
+	Port pulumi.IntInput `pulumi:"port"`
+}
+
+func (CacheConnectionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CacheConnection)(nil)).Elem()
+}
+
+func (i CacheConnectionArgs) ToCacheConnectionOutput() CacheConnectionOutput {
+	return i.ToCacheConnectionOutputWithContext(context.Background())
+}
+
+func (i CacheConnectionArgs) ToCacheConnectionOutputWithContext(ctx context.Context) CacheConnectionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CacheConnectionOutput)
+}
+
+func (i CacheConnectionArgs) ToCacheConnectionPtrOutput() CacheConnectionPtrOutput {
+	return i.ToCacheConnectionPtrOutputWithContext(context.Background())
+}
+
+func (i CacheConnectionArgs) ToCacheConnectionPtrOutputWithContext(ctx context.Context) CacheConnectionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CacheConnectionOutput).ToCacheConnectionPtrOutputWithContext(ctx)
+}
+
+// CacheConnectionPtrInput is an input type that accepts CacheConnectionArgs, CacheConnectionPtr and CacheConnectionPtrOutput values.
+// You can construct a concrete instance of `CacheConnectionPtrInput` via:
+//
+//	        CacheConnectionArgs{...}
+//
+//	or:
+//
+//	        nil
+type CacheConnectionPtrInput interface {
+	pulumi.Input
+
+	ToCacheConnectionPtrOutput() CacheConnectionPtrOutput
+	ToCacheConnectionPtrOutputWithContext(context.Context) CacheConnectionPtrOutput
+}
+
+type cacheConnectionPtrType CacheConnectionArgs
+
+func CacheConnectionPtr(v *CacheConnectionArgs) CacheConnectionPtrInput {
+	return (*cacheConnectionPtrType)(v)
+}
+
+func (*cacheConnectionPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**CacheConnection)(nil)).Elem()
+}
+
+func (i *cacheConnectionPtrType) ToCacheConnectionPtrOutput() CacheConnectionPtrOutput {
+	return i.ToCacheConnectionPtrOutputWithContext(context.Background())
+}
+
+func (i *cacheConnectionPtrType) ToCacheConnectionPtrOutputWithContext(ctx context.Context) CacheConnectionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CacheConnectionPtrOutput)
+}
+
+// Describes how an application connects to a cache.
+type CacheConnectionOutput struct{ *pulumi.OutputState }
+
+func (CacheConnectionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CacheConnection)(nil)).Elem()
+}
+
+func (o CacheConnectionOutput) ToCacheConnectionOutput() CacheConnectionOutput {
+	return o
+}
+
+func (o CacheConnectionOutput) ToCacheConnectionOutputWithContext(ctx context.Context) CacheConnectionOutput {
+	return o
+}
+
+func (o CacheConnectionOutput) ToCacheConnectionPtrOutput() CacheConnectionPtrOutput {
+	return o.ToCacheConnectionPtrOutputWithContext(context.Background())
+}
+
+func (o CacheConnectionOutput) ToCacheConnectionPtrOutputWithContext(ctx context.Context) CacheConnectionPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v CacheConnection) *CacheConnection {
+		return &v
+	}).(CacheConnectionPtrOutput)
+}
+
+// The connection string for the cache, such as the `connectionString` output of a `Cache`
+func (o CacheConnectionOutput) ConnectionString() pulumi.StringOutput {
+	return o.ApplyT(func(v CacheConnection) string { return v.ConnectionString }).(pulumi.StringOutput)
+}
+
+// The hostname of the cache, such as the `host` output of a `Cache`
+func (o CacheConnectionOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v CacheConnection) string { return v.Host }).(pulumi.StringOutput)
+}
+
+// The port of the cache, such as the `port` output of a `Cache`
+func (o CacheConnectionOutput) Port() pulumi.IntOutput {
+	return o.ApplyT(func(v CacheConnection) int { return v.Port }).(pulumi.IntOutput)
+}
+
+type CacheConnectionPtrOutput struct{ *pulumi.OutputState }
+
+func (CacheConnectionPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**CacheConnection)(nil)).Elem()
+}
+
+func (o CacheConnectionPtrOutput) ToCacheConnectionPtrOutput() CacheConnectionPtrOutput {
+	return o
+}
+
+func (o CacheConnectionPtrOutput) ToCacheConnectionPtrOutputWithContext(ctx context.Context) CacheConnectionPtrOutput {
+	return o
+}
+
+func (o CacheConnectionPtrOutput) Elem() CacheConnectionOutput {
+	return o.ApplyT(func(v *CacheConnection) CacheConnection {
+		if v != nil {
+			return *v
+		}
+		var ret CacheConnection
+		return ret
+	}).(CacheConnectionOutput)
+}
+
+// The connection string for the cache, such as the `connectionString` output of a `Cache`
+func (o CacheConnectionPtrOutput) ConnectionString() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CacheConnection) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.ConnectionString
+	}).(pulumi.StringPtrOutput)
+}
+
+// The hostname of the cache, such as the `host` output of a `Cache`
+func (o CacheConnectionPtrOutput) Host() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *CacheConnection) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Host
+	}).(pulumi.StringPtrOutput)
+}
+
+// The port of the cache, such as the `port` output of a `Cache`
+func (o CacheConnectionPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *CacheConnection) *int {
+		if v == nil {
+			return nil
+		}
+		return &v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+// One of the clusters the application is deployed to, with overrides for the application's settings there.
+type Cluster struct {
+	// The kubeconfig context of the cluster
+	Context *string `pulumi:"context"`
+	// The image to deploy to the cluster, overriding `image`
+	Image *string `pulumi:"image"`
+	// The contents of a kubeconfig file, or the path to one, for the cluster
+	Kubeconfig *string `pulumi:"kubeconfig"`
+	// The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+	Name string `pulumi:"name"`
+	// The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+	Replicas *int `pulumi:"replicas"`
+}
+
+// ClusterInput is an input type that accepts ClusterArgs and ClusterOutput values.
+// You can construct a concrete instance of `ClusterInput` via:
+//
+//	ClusterArgs{...}
+type ClusterInput interface {
+	pulumi.Input
+
+	ToClusterOutput() ClusterOutput
+	ToClusterOutputWithContext(context.Context) ClusterOutput
+}
+
+// One of the clusters the application is deployed to, with overrides for the application's settings there.
+type ClusterArgs struct {
+	// The kubeconfig context of the cluster
+	Context pulumi.StringPtrInput `pulumi:"context"`
+	// The image to deploy to the cluster, overriding `image`
+	Image pulumi.StringPtrInput `pulumi:"image"`
+	// The contents of a kubeconfig file, or the path to one, for the cluster
+	Kubeconfig pulumi.StringPtrInput `pulumi:"kubeconfig"`
+	// The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+	Name string `pulumi:"name"`
+	// The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+	Replicas *int `pulumi:"replicas"`
+}
+
+func (ClusterArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Cluster)(nil)).Elem()
+}
+
+func (i ClusterArgs) ToClusterOutput() ClusterOutput {
+	return i.ToClusterOutputWithContext(context.Background())
+}
+
+func (i ClusterArgs) ToClusterOutputWithContext(ctx context.Context) ClusterOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterOutput)
+}
+
+// ClusterArrayInput is an input type that accepts ClusterArray and ClusterArrayOutput values.
+// You can construct a concrete instance of `ClusterArrayInput` via:
+//
+//	ClusterArray{ ClusterArgs{...} }
+type ClusterArrayInput interface {
+	pulumi.Input
+
+	ToClusterArrayOutput() ClusterArrayOutput
+	ToClusterArrayOutputWithContext(context.Context) ClusterArrayOutput
+}
+
+type ClusterArray []ClusterInput
+
+func (ClusterArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Cluster)(nil)).Elem()
+}
+
+func (i ClusterArray) ToClusterArrayOutput() ClusterArrayOutput {
+	return i.ToClusterArrayOutputWithContext(context.Background())
+}
+
+func (i ClusterArray) ToClusterArrayOutputWithContext(ctx context.Context) ClusterArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ClusterArrayOutput)
+}
+
+// One of the clusters the application is deployed to, with overrides for the application's settings there.
+type ClusterOutput struct{ *pulumi.OutputState }
+
+func (ClusterOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Cluster)(nil)).Elem()
+}
+
+func (o ClusterOutput) ToClusterOutput() ClusterOutput {
+	return o
+}
+
+func (o ClusterOutput) ToClusterOutputWithContext(ctx context.Context) ClusterOutput {
+	return o
+}
+
+// The kubeconfig context of the cluster
+func (o ClusterOutput) Context() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Cluster) *string { return v.Context }).(pulumi.StringPtrOutput)
+}
+
+// The image to deploy to the cluster, overriding `image`
+func (o ClusterOutput) Image() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Cluster) *string { return v.Image }).(pulumi.StringPtrOutput)
+}
+
+// The contents of a kubeconfig file, or the path to one, for the cluster
+func (o ClusterOutput) Kubeconfig() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Cluster) *string { return v.Kubeconfig }).(pulumi.StringPtrOutput)
+}
+
+// The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+func (o ClusterOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v Cluster) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+func (o ClusterOutput) Replicas() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Cluster) *int { return v.Replicas }).(pulumi.IntPtrOutput)
+}
+
+type ClusterArrayOutput struct{ *pulumi.OutputState }
+
+func (ClusterArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Cluster)(nil)).Elem()
+}
+
+func (o ClusterArrayOutput) ToClusterArrayOutput() ClusterArrayOutput {
+	return o
+}
+
+func (o ClusterArrayOutput) ToClusterArrayOutputWithContext(ctx context.Context) ClusterArrayOutput {
+	return o
+}
+
+func (o ClusterArrayOutput) Index(i pulumi.IntInput) ClusterOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Cluster {
+		return vs[0].([]Cluster)[vs[1].(int)]
+	}).(ClusterOutput)
+}
+
+// A volume populated from a ConfigMap.
+type ConfigMapVolume struct {
+	// The name of the ConfigMap in the application namespace
+	Name string `pulumi:"name"`
+}
+
+// ConfigMapVolumeInput is an input type that accepts ConfigMapVolumeArgs and ConfigMapVolumeOutput values.
+// You can construct a concrete instance of `ConfigMapVolumeInput` via:
+//
+//	ConfigMapVolumeArgs{...}
+type ConfigMapVolumeInput interface {
+	pulumi.Input
+
+	ToConfigMapVolumeOutput() ConfigMapVolumeOutput
+	ToConfigMapVolumeOutputWithContext(context.Context) ConfigMapVolumeOutput
+}
+
+// A volume populated from a ConfigMap.
+type ConfigMapVolumeArgs struct {
+	// The name of the ConfigMap in the application namespace
+	Name string `pulumi:"name"`
+}
+
+func (ConfigMapVolumeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ConfigMapVolume)(nil)).Elem()
+}
+
+func (i ConfigMapVolumeArgs) ToConfigMapVolumeOutput() ConfigMapVolumeOutput {
+	return i.ToConfigMapVolumeOutputWithContext(context.Background())
+}
+
+func (i ConfigMapVolumeArgs) ToConfigMapVolumeOutputWithContext(ctx context.Context) ConfigMapVolumeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapVolumeOutput)
+}
+
+func (i ConfigMapVolumeArgs) ToConfigMapVolumePtrOutput() ConfigMapVolumePtrOutput {
+	return i.ToConfigMapVolumePtrOutputWithContext(context.Background())
+}
+
+func (i ConfigMapVolumeArgs) ToConfigMapVolumePtrOutputWithContext(ctx context.Context) ConfigMapVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapVolumeOutput).ToConfigMapVolumePtrOutputWithContext(ctx)
+}
+
+// ConfigMapVolumePtrInput is an input type that accepts ConfigMapVolumeArgs, ConfigMapVolumePtr and ConfigMapVolumePtrOutput values.
+// You can construct a concrete instance of `ConfigMapVolumePtrInput` via:
+//
+//	        ConfigMapVolumeArgs{...}
+//
+//	or:
+//
+//	        nil
+type ConfigMapVolumePtrInput interface {
+	pulumi.Input
+
+	ToConfigMapVolumePtrOutput() ConfigMapVolumePtrOutput
+	ToConfigMapVolumePtrOutputWithContext(context.Context) ConfigMapVolumePtrOutput
+}
+
+type configMapVolumePtrType ConfigMapVolumeArgs
+
+func ConfigMapVolumePtr(v *ConfigMapVolumeArgs) ConfigMapVolumePtrInput {
+	return (*configMapVolumePtrType)(v)
+}
+
+func (*configMapVolumePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ConfigMapVolume)(nil)).Elem()
+}
+
+func (i *configMapVolumePtrType) ToConfigMapVolumePtrOutput() ConfigMapVolumePtrOutput {
+	return i.ToConfigMapVolumePtrOutputWithContext(context.Background())
+}
+
+func (i *configMapVolumePtrType) ToConfigMapVolumePtrOutputWithContext(ctx context.Context) ConfigMapVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ConfigMapVolumePtrOutput)
+}
+
+// A volume populated from a ConfigMap.
+type ConfigMapVolumeOutput struct{ *pulumi.OutputState }
+
+func (ConfigMapVolumeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ConfigMapVolume)(nil)).Elem()
+}
+
+func (o ConfigMapVolumeOutput) ToConfigMapVolumeOutput() ConfigMapVolumeOutput {
+	return o
+}
+
+func (o ConfigMapVolumeOutput) ToConfigMapVolumeOutputWithContext(ctx context.Context) ConfigMapVolumeOutput {
+	return o
+}
+
+func (o ConfigMapVolumeOutput) ToConfigMapVolumePtrOutput() ConfigMapVolumePtrOutput {
+	return o.ToConfigMapVolumePtrOutputWithContext(context.Background())
+}
+
+func (o ConfigMapVolumeOutput) ToConfigMapVolumePtrOutputWithContext(ctx context.Context) ConfigMapVolumePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ConfigMapVolume) *ConfigMapVolume {
+		return &v
+	}).(ConfigMapVolumePtrOutput)
+}
+
+// The name of the ConfigMap in the application namespace
+func (o ConfigMapVolumeOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ConfigMapVolume) string { return v.Name }).(pulumi.StringOutput)
+}
+
+type ConfigMapVolumePtrOutput struct{ *pulumi.OutputState }
+
+func (ConfigMapVolumePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ConfigMapVolume)(nil)).Elem()
+}
+
+func (o ConfigMapVolumePtrOutput) ToConfigMapVolumePtrOutput() ConfigMapVolumePtrOutput {
+	return o
+}
+
+func (o ConfigMapVolumePtrOutput) ToConfigMapVolumePtrOutputWithContext(ctx context.Context) ConfigMapVolumePtrOutput {
+	return o
+}
+
+func (o ConfigMapVolumePtrOutput) Elem() ConfigMapVolumeOutput {
+	return o.ApplyT(func(v *ConfigMapVolume) ConfigMapVolume {
+		if v != nil {
+			return *v
+		}
+		var ret ConfigMapVolume
+		return ret
+	}).(ConfigMapVolumeOutput)
+}
+
+// The name of the ConfigMap in the application namespace
+func (o ConfigMapVolumePtrOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *ConfigMapVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Name
+	}).(pulumi.StringPtrOutput)
+}
+
+// Describes how an application connects to a database.
+type DatabaseConnection struct {
+	// The connection string for the database, such as the `connectionString` output of a `Database`
+	ConnectionString string `pulumi:"connectionString"`
+}
+
+// DatabaseConnectionInput is an input type that accepts DatabaseConnectionArgs and DatabaseConnectionOutput values.
+// You can construct a concrete instance of `DatabaseConnectionInput` via:
+//
+//	DatabaseConnectionArgs{...}
+type DatabaseConnectionInput interface {
+	pulumi.Input
+
+	ToDatabaseConnectionOutput() DatabaseConnectionOutput
+	ToDatabaseConnectionOutputWithContext(context.Context) DatabaseConnectionOutput
+}
+
+// Describes how an application connects to a database.
+type DatabaseConnectionArgs struct {
+	// The connection string for the database, such as the `connectionString` output of a `Database`
+	ConnectionString pulumi.StringInput `pulumi:"connectionString"`
+}
+
+func (DatabaseConnectionArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*DatabaseConnection)(nil)).Elem()
+}
+
+func (i DatabaseConnectionArgs) ToDatabaseConnectionOutput() DatabaseConnectionOutput {
+	return i.ToDatabaseConnectionOutputWithContext(context.Background())
+}
+
+func (i DatabaseConnectionArgs) ToDatabaseConnectionOutputWithContext(ctx context.Context) DatabaseConnectionOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseConnectionOutput)
+}
+
+func (i DatabaseConnectionArgs) ToDatabaseConnectionPtrOutput() DatabaseConnectionPtrOutput {
+	return i.ToDatabaseConnectionPtrOutputWithContext(context.Background())
+}
+
+func (i DatabaseConnectionArgs) ToDatabaseConnectionPtrOutputWithContext(ctx context.Context) DatabaseConnectionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseConnectionOutput).ToDatabaseConnectionPtrOutputWithContext(ctx)
+}
+
+// DatabaseConnectionPtrInput is an input type that accepts DatabaseConnectionArgs, DatabaseConnectionPtr and DatabaseConnectionPtrOutput values.
+// You can construct a concrete instance of `DatabaseConnectionPtrInput` via:
+//
+//	        DatabaseConnectionArgs{...}
+//
+//	or:
+//
+//	        nil
+type DatabaseConnectionPtrInput interface {
+	pulumi.Input
+
+	ToDatabaseConnectionPtrOutput() DatabaseConnectionPtrOutput
+	ToDatabaseConnectionPtrOutputWithContext(context.Context) DatabaseConnectionPtrOutput
+}
+
+type databaseConnectionPtrType DatabaseConnectionArgs
+
+func DatabaseConnectionPtr(v *DatabaseConnectionArgs) DatabaseConnectionPtrInput {
+	return (*databaseConnectionPtrType)(v)
+}
+
+func (*databaseConnectionPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**DatabaseConnection)(nil)).Elem()
+}
+
+func (i *databaseConnectionPtrType) ToDatabaseConnectionPtrOutput() DatabaseConnectionPtrOutput {
+	return i.ToDatabaseConnectionPtrOutputWithContext(context.Background())
+}
+
+func (i *databaseConnectionPtrType) ToDatabaseConnectionPtrOutputWithContext(ctx context.Context) DatabaseConnectionPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(DatabaseConnectionPtrOutput)
+}
+
+// Describes how an application connects to a database.
+type DatabaseConnectionOutput struct{ *pulumi.OutputState }
+
+func (DatabaseConnectionOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*DatabaseConnection)(nil)).Elem()
+}
+
+func (o DatabaseConnectionOutput) ToDatabaseConnectionOutput() DatabaseConnectionOutput {
+	return o
+}
+
+func (o DatabaseConnectionOutput) ToDatabaseConnectionOutputWithContext(ctx context.Context) DatabaseConnectionOutput {
+	return o
+}
+
+func (o DatabaseConnectionOutput) ToDatabaseConnectionPtrOutput() DatabaseConnectionPtrOutput {
+	return o.ToDatabaseConnectionPtrOutputWithContext(context.Background())
+}
+
+func (o DatabaseConnectionOutput) ToDatabaseConnectionPtrOutputWithContext(ctx context.Context) DatabaseConnectionPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v DatabaseConnection) *DatabaseConnection {
+		return &v
+	}).(DatabaseConnectionPtrOutput)
+}
+
+// The connection string for the database, such as the `connectionString` output of a `Database`
+func (o DatabaseConnectionOutput) ConnectionString() pulumi.StringOutput {
+	return o.ApplyT(func(v DatabaseConnection) string { return v.ConnectionString }).(pulumi.StringOutput)
+}
+
+type DatabaseConnectionPtrOutput struct{ *pulumi.OutputState }
+
+func (DatabaseConnectionPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**DatabaseConnection)(nil)).Elem()
+}
+
+func (o DatabaseConnectionPtrOutput) ToDatabaseConnectionPtrOutput() DatabaseConnectionPtrOutput {
+	return o
+}
+
+func (o DatabaseConnectionPtrOutput) ToDatabaseConnectionPtrOutputWithContext(ctx context.Context) DatabaseConnectionPtrOutput {
+	return o
+}
+
+func (o DatabaseConnectionPtrOutput) Elem() DatabaseConnectionOutput {
+	return o.ApplyT(func(v *DatabaseConnection) DatabaseConnection {
+		if v != nil {
+			return *v
+		}
+		var ret DatabaseConnection
+		return ret
+	}).(DatabaseConnectionOutput)
+}
+
+// The connection string for the database, such as the `connectionString` output of a `Database`
+func (o DatabaseConnectionPtrOutput) ConnectionString() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *DatabaseConnection) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.ConnectionString
+	}).(pulumi.StringPtrOutput)
+}
+
+// A scratch directory that lives as long as the pod.
+type EmptyDirVolume struct {
+	// Set to `Memory` to back the directory with tmpfs
+	Medium *string `pulumi:"medium"`
+	// The maximum size of the directory, such as `1Gi`
+	SizeLimit *string `pulumi:"sizeLimit"`
+}
+
+// EmptyDirVolumeInput is an input type that accepts EmptyDirVolumeArgs and EmptyDirVolumeOutput values.
+// You can construct a concrete instance of `EmptyDirVolumeInput` via:
+//
+//	EmptyDirVolumeArgs{...}
+type EmptyDirVolumeInput interface {
+	pulumi.Input
+
+	ToEmptyDirVolumeOutput() EmptyDirVolumeOutput
+	ToEmptyDirVolumeOutputWithContext(context.Context) EmptyDirVolumeOutput
+}
+
+// A scratch directory that lives as long as the pod.
+type EmptyDirVolumeArgs struct {
+	// Set to `Memory` to back the directory with tmpfs
+	Medium *string `pulumi:"medium"`
+	// The maximum size of the directory, such as `1Gi`
+	SizeLimit *string `pulumi:"sizeLimit"`
+}
+
+func (EmptyDirVolumeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*EmptyDirVolume)(nil)).Elem()
+}
+
+func (i EmptyDirVolumeArgs) ToEmptyDirVolumeOutput() EmptyDirVolumeOutput {
+	return i.ToEmptyDirVolumeOutputWithContext(context.Background())
+}
+
+func (i EmptyDirVolumeArgs) ToEmptyDirVolumeOutputWithContext(ctx context.Context) EmptyDirVolumeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EmptyDirVolumeOutput)
+}
+
+func (i EmptyDirVolumeArgs) ToEmptyDirVolumePtrOutput() EmptyDirVolumePtrOutput {
+	return i.ToEmptyDirVolumePtrOutputWithContext(context.Background())
+}
+
+func (i EmptyDirVolumeArgs) ToEmptyDirVolumePtrOutputWithContext(ctx context.Context) EmptyDirVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EmptyDirVolumeOutput).ToEmptyDirVolumePtrOutputWithContext(ctx)
+}
+
+// EmptyDirVolumePtrInput is an input type that accepts EmptyDirVolumeArgs, EmptyDirVolumePtr and EmptyDirVolumePtrOutput values.
+// You can construct a concrete instance of `EmptyDirVolumePtrInput` via:
+//
+//	        EmptyDirVolumeArgs{...}
+//
+//	or:
+//
+//	        nil
+type EmptyDirVolumePtrInput interface {
+	pulumi.Input
+
+	ToEmptyDirVolumePtrOutput() EmptyDirVolumePtrOutput
+	ToEmptyDirVolumePtrOutputWithContext(context.Context) EmptyDirVolumePtrOutput
+}
+
+type emptyDirVolumePtrType EmptyDirVolumeArgs
+
+func EmptyDirVolumePtr(v *EmptyDirVolumeArgs) EmptyDirVolumePtrInput {
+	return (*emptyDirVolumePtrType)(v)
+}
+
+func (*emptyDirVolumePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**EmptyDirVolume)(nil)).Elem()
+}
+
+func (i *emptyDirVolumePtrType) ToEmptyDirVolumePtrOutput() EmptyDirVolumePtrOutput {
+	return i.ToEmptyDirVolumePtrOutputWithContext(context.Background())
+}
+
+func (i *emptyDirVolumePtrType) ToEmptyDirVolumePtrOutputWithContext(ctx context.Context) EmptyDirVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(EmptyDirVolumePtrOutput)
+}
+
+// A scratch directory that lives as long as the pod.
+type EmptyDirVolumeOutput struct{ *pulumi.OutputState }
+
+func (EmptyDirVolumeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*EmptyDirVolume)(nil)).Elem()
+}
+
+func (o EmptyDirVolumeOutput) ToEmptyDirVolumeOutput() EmptyDirVolumeOutput {
+	return o
+}
+
+func (o EmptyDirVolumeOutput) ToEmptyDirVolumeOutputWithContext(ctx context.Context) EmptyDirVolumeOutput {
+	return o
+}
+
+func (o EmptyDirVolumeOutput) ToEmptyDirVolumePtrOutput() EmptyDirVolumePtrOutput {
+	return o.ToEmptyDirVolumePtrOutputWithContext(context.Background())
+}
+
+func (o EmptyDirVolumeOutput) ToEmptyDirVolumePtrOutputWithContext(ctx context.Context) EmptyDirVolumePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v EmptyDirVolume) *EmptyDirVolume {
+		return &v
+	}).(EmptyDirVolumePtrOutput)
+}
+
+// Set to `Memory` to back the directory with tmpfs
+func (o EmptyDirVolumeOutput) Medium() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EmptyDirVolume) *string { return v.Medium }).(pulumi.StringPtrOutput)
+}
+
+// The maximum size of the directory, such as `1Gi`
+func (o EmptyDirVolumeOutput) SizeLimit() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v EmptyDirVolume) *string { return v.SizeLimit }).(pulumi.StringPtrOutput)
+}
+
+type EmptyDirVolumePtrOutput struct{ *pulumi.OutputState }
+
+func (EmptyDirVolumePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**EmptyDirVolume)(nil)).Elem()
+}
+
+func (o EmptyDirVolumePtrOutput) ToEmptyDirVolumePtrOutput() EmptyDirVolumePtrOutput {
+	return o
+}
+
+func (o EmptyDirVolumePtrOutput) ToEmptyDirVolumePtrOutputWithContext(ctx context.Context) EmptyDirVolumePtrOutput {
+	return o
+}
+
+func (o EmptyDirVolumePtrOutput) Elem() EmptyDirVolumeOutput {
+	return o.ApplyT(func(v *EmptyDirVolume) EmptyDirVolume {
+		if v != nil {
+			return *v
+		}
+		var ret EmptyDirVolume
+		return ret
+	}).(EmptyDirVolumeOutput)
+}
+
+// Set to `Memory` to back the directory with tmpfs
+func (o EmptyDirVolumePtrOutput) Medium() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EmptyDirVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Medium
+	}).(pulumi.StringPtrOutput)
+}
+
+// The maximum size of the directory, such as `1Gi`
+func (o EmptyDirVolumePtrOutput) SizeLimit() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *EmptyDirVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SizeLimit
+	}).(pulumi.StringPtrOutput)
+}
+
+// The defaults a Deployment gets in one environment tier.
+type EnvironmentPreset struct {
+	// Whether the pods are spread across nodes
+	AntiAffinity bool `pulumi:"antiAffinity"`
+	// The application container's image pull policy
+	ImagePullPolicy string `pulumi:"imagePullPolicy"`
+	// The number of pods the HorizontalPodAutoscaler scales up to. Unset if there is no HorizontalPodAutoscaler
+	MaxReplicas *int `pulumi:"maxReplicas"`
+	// The minimum number or percentage of pods the PodDisruptionBudget keeps available. Unset if there is no PodDisruptionBudget
+	MinAvailable *string `pulumi:"minAvailable"`
+	// Whether the namespace is protected from deletion
+	ProtectNamespace bool `pulumi:"protectNamespace"`
+	// The number of pods
+	Replicas int `pulumi:"replicas"`
+	// The application container's resource requests and limits
+	Resources ResourcePreset `pulumi:"resources"`
+	// The CPU utilization the HorizontalPodAutoscaler scales at. Unset if there is no HorizontalPodAutoscaler
+	TargetCpuUtilizationPercentage *int `pulumi:"targetCpuUtilizationPercentage"`
+}
+
+// A volume backed by a path on the node.
+type HostPathVolume struct {
+	// The path on the node
+	Path string `pulumi:"path"`
+	// The type of the path, such as `Directory`
+	Type *string `pulumi:"type"`
+}
+
+// HostPathVolumeInput is an input type that accepts HostPathVolumeArgs and HostPathVolumeOutput values.
+// You can construct a concrete instance of `HostPathVolumeInput` via:
+//
+//	HostPathVolumeArgs{...}
+type HostPathVolumeInput interface {
+	pulumi.Input
+
+	ToHostPathVolumeOutput() HostPathVolumeOutput
+	ToHostPathVolumeOutputWithContext(context.Context) HostPathVolumeOutput
+}
+
+// A volume backed by a path on the node.
+type HostPathVolumeArgs struct {
+	// The path on the node
+	Path string `pulumi:"path"`
+	// The type of the path, such as `Directory`
+	Type *string `pulumi:"type"`
+}
+
+func (HostPathVolumeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*HostPathVolume)(nil)).Elem()
+}
+
+func (i HostPathVolumeArgs) ToHostPathVolumeOutput() HostPathVolumeOutput {
+	return i.ToHostPathVolumeOutputWithContext(context.Background())
+}
+
+func (i HostPathVolumeArgs) ToHostPathVolumeOutputWithContext(ctx context.Context) HostPathVolumeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HostPathVolumeOutput)
+}
+
+func (i HostPathVolumeArgs) ToHostPathVolumePtrOutput() HostPathVolumePtrOutput {
+	return i.ToHostPathVolumePtrOutputWithContext(context.Background())
+}
+
+func (i HostPathVolumeArgs) ToHostPathVolumePtrOutputWithContext(ctx context.Context) HostPathVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HostPathVolumeOutput).ToHostPathVolumePtrOutputWithContext(ctx)
+}
+
+// HostPathVolumePtrInput is an input type that accepts HostPathVolumeArgs, HostPathVolumePtr and HostPathVolumePtrOutput values.
+// You can construct a concrete instance of `HostPathVolumePtrInput` via:
+//
+//	        HostPathVolumeArgs{...}
+//
+//	or:
+//
+//	        nil
+type HostPathVolumePtrInput interface {
+	pulumi.Input
+
+	ToHostPathVolumePtrOutput() HostPathVolumePtrOutput
+	ToHostPathVolumePtrOutputWithContext(context.Context) HostPathVolumePtrOutput
+}
+
+type hostPathVolumePtrType HostPathVolumeArgs
+
+func HostPathVolumePtr(v *HostPathVolumeArgs) HostPathVolumePtrInput {
+	return (*hostPathVolumePtrType)(v)
+}
+
+func (*hostPathVolumePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**HostPathVolume)(nil)).Elem()
+}
+
+func (i *hostPathVolumePtrType) ToHostPathVolumePtrOutput() HostPathVolumePtrOutput {
+	return i.ToHostPathVolumePtrOutputWithContext(context.Background())
+}
+
+func (i *hostPathVolumePtrType) ToHostPathVolumePtrOutputWithContext(ctx context.Context) HostPathVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HostPathVolumePtrOutput)
+}
+
+// A volume backed by a path on the node.
+type HostPathVolumeOutput struct{ *pulumi.OutputState }
+
+func (HostPathVolumeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*HostPathVolume)(nil)).Elem()
+}
+
+func (o HostPathVolumeOutput) ToHostPathVolumeOutput() HostPathVolumeOutput {
+	return o
+}
+
+func (o HostPathVolumeOutput) ToHostPathVolumeOutputWithContext(ctx context.Context) HostPathVolumeOutput {
+	return o
+}
+
+func (o HostPathVolumeOutput) ToHostPathVolumePtrOutput() HostPathVolumePtrOutput {
+	return o.ToHostPathVolumePtrOutputWithContext(context.Background())
+}
+
+func (o HostPathVolumeOutput) ToHostPathVolumePtrOutputWithContext(ctx context.Context) HostPathVolumePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v HostPathVolume) *HostPathVolume {
+		return &v
+	}).(HostPathVolumePtrOutput)
+}
+
+// The path on the node
+func (o HostPathVolumeOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v HostPathVolume) string { return v.Path }).(pulumi.StringOutput)
+}
+
+// The type of the path, such as `Directory`
+func (o HostPathVolumeOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v HostPathVolume) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type HostPathVolumePtrOutput struct{ *pulumi.OutputState }
+
+func (HostPathVolumePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**HostPathVolume)(nil)).Elem()
+}
+
+func (o HostPathVolumePtrOutput) ToHostPathVolumePtrOutput() HostPathVolumePtrOutput {
+	return o
+}
+
+func (o HostPathVolumePtrOutput) ToHostPathVolumePtrOutputWithContext(ctx context.Context) HostPathVolumePtrOutput {
+	return o
+}
+
+func (o HostPathVolumePtrOutput) Elem() HostPathVolumeOutput {
+	return o.ApplyT(func(v *HostPathVolume) HostPathVolume {
+		if v != nil {
+			return *v
+		}
+		var ret HostPathVolume
+		return ret
+	}).(HostPathVolumeOutput)
+}
+
+// The path on the node
+func (o HostPathVolumePtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *HostPathVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Path
+	}).(pulumi.StringPtrOutput)
+}
+
+// The type of the path, such as `Directory`
+func (o HostPathVolumePtrOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *HostPathVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Type
+	}).(pulumi.StringPtrOutput)
+}
+
+// Routes a host name to the application's Service.
+type Ingress struct {
+	// Annotations added to the Ingress, such as the controller's settings
+	Annotations map[string]string `pulumi:"annotations"`
+	// The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+	ClassName *string `pulumi:"className"`
+	// The host name to route, such as `app.example.com`
+	Host string `pulumi:"host"`
+	// The path prefix to route. Defaults to `/`
+	Path *string `pulumi:"path"`
+	// A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+	TlsSecretName *string `pulumi:"tlsSecretName"`
+}
+
+// IngressInput is an input type that accepts IngressArgs and IngressOutput values.
+// You can construct a concrete instance of `IngressInput` via:
+//
+//	IngressArgs{...}
+type IngressInput interface {
+	pulumi.Input
+
+	ToIngressOutput() IngressOutput
+	ToIngressOutputWithContext(context.Context) IngressOutput
+}
+
+// Routes a host name to the application's Service.
+type IngressArgs struct {
+	// Annotations added to the Ingress, such as the controller's settings
+	Annotations map[string]string `pulumi:"annotations"`
+	// The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+	ClassName *string `pulumi:"className"`
+	// The host name to route, such as `app.example.com`
+	Host string `pulumi:"host"`
+	// The path prefix to route. Defaults to `/`
+	Path *string `pulumi:"path"`
+	// A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+	TlsSecretName *string `pulumi:"tlsSecretName"`
+}
+
+func (IngressArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Ingress)(nil)).Elem()
+}
+
+func (i IngressArgs) ToIngressOutput() IngressOutput {
+	return i.ToIngressOutputWithContext(context.Background())
+}
+
+func (i IngressArgs) ToIngressOutputWithContext(ctx context.Context) IngressOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(IngressOutput)
+}
+
+func (i IngressArgs) ToIngressPtrOutput() IngressPtrOutput {
+	return i.ToIngressPtrOutputWithContext(context.Background())
+}
+
+func (i IngressArgs) ToIngressPtrOutputWithContext(ctx context.Context) IngressPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(IngressOutput).ToIngressPtrOutputWithContext(ctx)
+}
+
+// IngressPtrInput is an input type that accepts IngressArgs, IngressPtr and IngressPtrOutput values.
+// You can construct a concrete instance of `IngressPtrInput` via:
+//
+//	        IngressArgs{...}
+//
+//	or:
+//
+//	        nil
+type IngressPtrInput interface {
+	pulumi.Input
+
+	ToIngressPtrOutput() IngressPtrOutput
+	ToIngressPtrOutputWithContext(context.Context) IngressPtrOutput
+}
+
+type ingressPtrType IngressArgs
+
+func IngressPtr(v *IngressArgs) IngressPtrInput {
+	return (*ingressPtrType)(v)
+}
+
+func (*ingressPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Ingress)(nil)).Elem()
+}
+
+func (i *ingressPtrType) ToIngressPtrOutput() IngressPtrOutput {
+	return i.ToIngressPtrOutputWithContext(context.Background())
+}
+
+func (i *ingressPtrType) ToIngressPtrOutputWithContext(ctx context.Context) IngressPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(IngressPtrOutput)
+}
+
+// Routes a host name to the application's Service.
+type IngressOutput struct{ *pulumi.OutputState }
+
+func (IngressOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Ingress)(nil)).Elem()
+}
+
+func (o IngressOutput) ToIngressOutput() IngressOutput {
+	return o
+}
+
+func (o IngressOutput) ToIngressOutputWithContext(ctx context.Context) IngressOutput {
+	return o
+}
+
+func (o IngressOutput) ToIngressPtrOutput() IngressPtrOutput {
+	return o.ToIngressPtrOutputWithContext(context.Background())
+}
+
+func (o IngressOutput) ToIngressPtrOutputWithContext(ctx context.Context) IngressPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Ingress) *Ingress {
+		return &v
+	}).(IngressPtrOutput)
+}
+
+// Annotations added to the Ingress, such as the controller's settings
+func (o IngressOutput) Annotations() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Ingress) map[string]string { return v.Annotations }).(pulumi.StringMapOutput)
+}
+
+// The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+func (o IngressOutput) ClassName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Ingress) *string { return v.ClassName }).(pulumi.StringPtrOutput)
+}
+
+// The host name to route, such as `app.example.com`
+func (o IngressOutput) Host() pulumi.StringOutput {
+	return o.ApplyT(func(v Ingress) string { return v.Host }).(pulumi.StringOutput)
+}
+
+// The path prefix to route. Defaults to `/`
+func (o IngressOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Ingress) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+// A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+func (o IngressOutput) TlsSecretName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Ingress) *string { return v.TlsSecretName }).(pulumi.StringPtrOutput)
+}
+
+type IngressPtrOutput struct{ *pulumi.OutputState }
+
+func (IngressPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Ingress)(nil)).Elem()
+}
+
+func (o IngressPtrOutput) ToIngressPtrOutput() IngressPtrOutput {
+	return o
+}
+
+func (o IngressPtrOutput) ToIngressPtrOutputWithContext(ctx context.Context) IngressPtrOutput {
+	return o
+}
+
+func (o IngressPtrOutput) Elem() IngressOutput {
+	return o.ApplyT(func(v *Ingress) Ingress {
+		if v != nil {
+			return *v
+		}
+		var ret Ingress
+		return ret
+	}).(IngressOutput)
+}
+
+// Annotations added to the Ingress, such as the controller's settings
+func (o IngressPtrOutput) Annotations() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Ingress) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Annotations
+	}).(pulumi.StringMapOutput)
+}
+
+// The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+func (o IngressPtrOutput) ClassName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Ingress) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ClassName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The host name to route, such as `app.example.com`
+func (o IngressPtrOutput) Host() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Ingress) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.Host
+	}).(pulumi.StringPtrOutput)
+}
+
+// The path prefix to route. Defaults to `/`
+func (o IngressPtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Ingress) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Path
+	}).(pulumi.StringPtrOutput)
+}
+
+// A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+func (o IngressPtrOutput) TlsSecretName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Ingress) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TlsSecretName
+	}).(pulumi.StringPtrOutput)
+}
+
+// kubectl commands for one of the application's workloads.
+type KubectlCommands struct {
+	// The name of the cluster, when the application is deployed to several
+	Cluster *string `pulumi:"cluster"`
+	// Describes the application's Deployment
+	Describe string `pulumi:"describe"`
+	// Lists the application's pods
+	GetPods string `pulumi:"getPods"`
+	// The kubeconfig file the commands use, when the application was deployed with a kubeconfig
+	Kubeconfig *string `pulumi:"kubeconfig"`
+	// Follows the application's logs
+	Logs string `pulumi:"logs"`
+	// Restarts the application's pods
+	Restart string `pulumi:"restart"`
+	// Waits for the application's rollout to finish
+	RolloutStatus string `pulumi:"rolloutStatus"`
+}
+
+// kubectl commands for one of the application's workloads.
+type KubectlCommandsOutput struct{ *pulumi.OutputState }
+
+func (KubectlCommandsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*KubectlCommands)(nil)).Elem()
+}
+
+func (o KubectlCommandsOutput) ToKubectlCommandsOutput() KubectlCommandsOutput {
+	return o
+}
+
+func (o KubectlCommandsOutput) ToKubectlCommandsOutputWithContext(ctx context.Context) KubectlCommandsOutput {
+	return o
+}
+
+// The name of the cluster, when the application is deployed to several
+func (o KubectlCommandsOutput) Cluster() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubectlCommands) *string { return v.Cluster }).(pulumi.StringPtrOutput)
+}
+
+// Describes the application's Deployment
+func (o KubectlCommandsOutput) Describe() pulumi.StringOutput {
+	return o.ApplyT(func(v KubectlCommands) string { return v.Describe }).(pulumi.StringOutput)
+}
+
+// Lists the application's pods
+func (o KubectlCommandsOutput) GetPods() pulumi.StringOutput {
+	return o.ApplyT(func(v KubectlCommands) string { return v.GetPods }).(pulumi.StringOutput)
+}
+
+// The kubeconfig file the commands use, when the application was deployed with a kubeconfig
+func (o KubectlCommandsOutput) Kubeconfig() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v KubectlCommands) *string { return v.Kubeconfig }).(pulumi.StringPtrOutput)
+}
+
+// Follows the application's logs
+func (o KubectlCommandsOutput) Logs() pulumi.StringOutput {
+	return o.ApplyT(func(v KubectlCommands) string { return v.Logs }).(pulumi.StringOutput)
+}
+
+// Restarts the application's pods
+func (o KubectlCommandsOutput) Restart() pulumi.StringOutput {
+	return o.ApplyT(func(v KubectlCommands) string { return v.Restart }).(pulumi.StringOutput)
+}
+
+// Waits for the application's rollout to finish
+func (o KubectlCommandsOutput) RolloutStatus() pulumi.StringOutput {
+	return o.ApplyT(func(v KubectlCommands) string { return v.RolloutStatus }).(pulumi.StringOutput)
+}
+
+type KubectlCommandsArrayOutput struct{ *pulumi.OutputState }
+
+func (KubectlCommandsArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]KubectlCommands)(nil)).Elem()
+}
+
+func (o KubectlCommandsArrayOutput) ToKubectlCommandsArrayOutput() KubectlCommandsArrayOutput {
+	return o
+}
+
+func (o KubectlCommandsArrayOutput) ToKubectlCommandsArrayOutputWithContext(ctx context.Context) KubectlCommandsArrayOutput {
+	return o
+}
+
+func (o KubectlCommandsArrayOutput) Index(i pulumi.IntInput) KubectlCommandsOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) KubectlCommands {
+		return vs[0].([]KubectlCommands)[vs[1].(int)]
+	}).(KubectlCommandsOutput)
+}
+
+// Modifies the arguments of every resource of one kind before it is registered.
+type Patch struct {
+	// The patch, in the shape of the Kubernetes resource. `null` removes a field
+	Patch interface{} `pulumi:"patch"`
+	// How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+	Type *string `pulumi:"type"`
+}
+
+// PatchInput is an input type that accepts PatchArgs and PatchOutput values.
+// You can construct a concrete instance of `PatchInput` via:
+//
+//	PatchArgs{...}
+type PatchInput interface {
+	pulumi.Input
+
+	ToPatchOutput() PatchOutput
+	ToPatchOutputWithContext(context.Context) PatchOutput
+}
+
+// Modifies the arguments of every resource of one kind before it is registered.
+type PatchArgs struct {
+	// The patch, in the shape of the Kubernetes resource. `null` removes a field
+	Patch interface{} `pulumi:"patch"`
+	// How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+	Type *string `pulumi:"type"`
+}
+
+func (PatchArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Patch)(nil)).Elem()
+}
+
+func (i PatchArgs) ToPatchOutput() PatchOutput {
+	return i.ToPatchOutputWithContext(context.Background())
+}
+
+func (i PatchArgs) ToPatchOutputWithContext(ctx context.Context) PatchOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PatchOutput)
+}
+
+// PatchMapInput is an input type that accepts PatchMap and PatchMapOutput values.
+// You can construct a concrete instance of `PatchMapInput` via:
+//
+//	PatchMap{ "key": PatchArgs{...} }
+type PatchMapInput interface {
+	pulumi.Input
+
+	ToPatchMapOutput() PatchMapOutput
+	ToPatchMapOutputWithContext(context.Context) PatchMapOutput
+}
+
+type PatchMap map[string]PatchInput
+
+func (PatchMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]Patch)(nil)).Elem()
+}
+
+func (i PatchMap) ToPatchMapOutput() PatchMapOutput {
+	return i.ToPatchMapOutputWithContext(context.Background())
+}
+
+func (i PatchMap) ToPatchMapOutputWithContext(ctx context.Context) PatchMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PatchMapOutput)
+}
+
+// Modifies the arguments of every resource of one kind before it is registered.
+type PatchOutput struct{ *pulumi.OutputState }
+
+func (PatchOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Patch)(nil)).Elem()
+}
+
+func (o PatchOutput) ToPatchOutput() PatchOutput {
+	return o
+}
+
+func (o PatchOutput) ToPatchOutputWithContext(ctx context.Context) PatchOutput {
+	return o
+}
+
+// The patch, in the shape of the Kubernetes resource. `null` removes a field
+func (o PatchOutput) Patch() pulumi.AnyOutput {
+	return o.ApplyT(func(v Patch) interface{} { return v.Patch }).(pulumi.AnyOutput)
+}
+
+// How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+func (o PatchOutput) Type() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Patch) *string { return v.Type }).(pulumi.StringPtrOutput)
+}
+
+type PatchMapOutput struct{ *pulumi.OutputState }
+
+func (PatchMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]Patch)(nil)).Elem()
+}
+
+func (o PatchMapOutput) ToPatchMapOutput() PatchMapOutput {
+	return o
+}
+
+func (o PatchMapOutput) ToPatchMapOutputWithContext(ctx context.Context) PatchMapOutput {
+	return o
+}
+
+func (o PatchMapOutput) MapIndex(k pulumi.StringInput) PatchOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) Patch {
+		return vs[0].(map[string]Patch)[vs[1].(string)]
+	}).(PatchOutput)
+}
+
+// A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+type PreDeployJob struct {
+	// The command to run
+	Command []string `pulumi:"command"`
+	// Environment variables for the job, in addition to those of the application
+	Env map[string]string `pulumi:"env"`
+	// The image to run. Defaults to the application image
+	Image *string `pulumi:"image"`
+	// How long the job may run before it is considered failed
+	TimeoutSeconds *int `pulumi:"timeoutSeconds"`
+}
+
+// PreDeployJobInput is an input type that accepts PreDeployJobArgs and PreDeployJobOutput values.
+// You can construct a concrete instance of `PreDeployJobInput` via:
+//
+//	PreDeployJobArgs{...}
+type PreDeployJobInput interface {
+	pulumi.Input
+
+	ToPreDeployJobOutput() PreDeployJobOutput
+	ToPreDeployJobOutputWithContext(context.Context) PreDeployJobOutput
+}
+
+// A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+type PreDeployJobArgs struct {
+	// The command to run
+	Command []string `pulumi:"command"`
+	// Environment variables for the job, in addition to those of the application
+	Env map[string]string `pulumi:"env"`
+	// The image to run. Defaults to the application image
+	Image *string `pulumi:"image"`
+	// How long the job may run before it is considered failed
+	TimeoutSeconds *int `pulumi:"timeoutSeconds"`
+}
+
+func (PreDeployJobArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*PreDeployJob)(nil)).Elem()
+}
+
+func (i PreDeployJobArgs) ToPreDeployJobOutput() PreDeployJobOutput {
+	return i.ToPreDeployJobOutputWithContext(context.Background())
+}
+
+func (i PreDeployJobArgs) ToPreDeployJobOutputWithContext(ctx context.Context) PreDeployJobOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PreDeployJobOutput)
+}
+
+func (i PreDeployJobArgs) ToPreDeployJobPtrOutput() PreDeployJobPtrOutput {
+	return i.ToPreDeployJobPtrOutputWithContext(context.Background())
+}
+
+func (i PreDeployJobArgs) ToPreDeployJobPtrOutputWithContext(ctx context.Context) PreDeployJobPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PreDeployJobOutput).ToPreDeployJobPtrOutputWithContext(ctx)
+}
+
+// PreDeployJobPtrInput is an input type that accepts PreDeployJobArgs, PreDeployJobPtr and PreDeployJobPtrOutput values.
+// You can construct a concrete instance of `PreDeployJobPtrInput` via:
+//
+//	        PreDeployJobArgs{...}
+//
+//	or:
+//
+//	        nil
+type PreDeployJobPtrInput interface {
+	pulumi.Input
+
+	ToPreDeployJobPtrOutput() PreDeployJobPtrOutput
+	ToPreDeployJobPtrOutputWithContext(context.Context) PreDeployJobPtrOutput
+}
+
+type preDeployJobPtrType PreDeployJobArgs
+
+func PreDeployJobPtr(v *PreDeployJobArgs) PreDeployJobPtrInput {
+	return (*preDeployJobPtrType)(v)
+}
+
+func (*preDeployJobPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**PreDeployJob)(nil)).Elem()
+}
+
+func (i *preDeployJobPtrType) ToPreDeployJobPtrOutput() PreDeployJobPtrOutput {
+	return i.ToPreDeployJobPtrOutputWithContext(context.Background())
+}
+
+func (i *preDeployJobPtrType) ToPreDeployJobPtrOutputWithContext(ctx context.Context) PreDeployJobPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PreDeployJobPtrOutput)
+}
+
+// A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+type PreDeployJobOutput struct{ *pulumi.OutputState }
+
+func (PreDeployJobOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PreDeployJob)(nil)).Elem()
+}
+
+func (o PreDeployJobOutput) ToPreDeployJobOutput() PreDeployJobOutput {
+	return o
+}
+
+func (o PreDeployJobOutput) ToPreDeployJobOutputWithContext(ctx context.Context) PreDeployJobOutput {
+	return o
+}
+
+func (o PreDeployJobOutput) ToPreDeployJobPtrOutput() PreDeployJobPtrOutput {
+	return o.ToPreDeployJobPtrOutputWithContext(context.Background())
+}
+
+func (o PreDeployJobOutput) ToPreDeployJobPtrOutputWithContext(ctx context.Context) PreDeployJobPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v PreDeployJob) *PreDeployJob {
+		return &v
+	}).(PreDeployJobPtrOutput)
+}
+
+// The command to run
+func (o PreDeployJobOutput) Command() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v PreDeployJob) []string { return v.Command }).(pulumi.StringArrayOutput)
+}
+
+// Environment variables for the job, in addition to those of the application
+func (o PreDeployJobOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v PreDeployJob) map[string]string { return v.Env }).(pulumi.StringMapOutput)
+}
+
+// The image to run. Defaults to the application image
+func (o PreDeployJobOutput) Image() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PreDeployJob) *string { return v.Image }).(pulumi.StringPtrOutput)
+}
+
+// How long the job may run before it is considered failed
+func (o PreDeployJobOutput) TimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v PreDeployJob) *int { return v.TimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+type PreDeployJobPtrOutput struct{ *pulumi.OutputState }
+
+func (PreDeployJobPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**PreDeployJob)(nil)).Elem()
+}
+
+func (o PreDeployJobPtrOutput) ToPreDeployJobPtrOutput() PreDeployJobPtrOutput {
+	return o
+}
+
+func (o PreDeployJobPtrOutput) ToPreDeployJobPtrOutputWithContext(ctx context.Context) PreDeployJobPtrOutput {
+	return o
+}
+
+func (o PreDeployJobPtrOutput) Elem() PreDeployJobOutput {
+	return o.ApplyT(func(v *PreDeployJob) PreDeployJob {
+		if v != nil {
+			return *v
+		}
+		var ret PreDeployJob
+		return ret
+	}).(PreDeployJobOutput)
+}
+
+// The command to run
+func (o PreDeployJobPtrOutput) Command() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *PreDeployJob) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Command
+	}).(pulumi.StringArrayOutput)
+}
+
+// Environment variables for the job, in addition to those of the application
+func (o PreDeployJobPtrOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *PreDeployJob) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Env
+	}).(pulumi.StringMapOutput)
+}
+
+// The image to run. Defaults to the application image
+func (o PreDeployJobPtrOutput) Image() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PreDeployJob) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Image
+	}).(pulumi.StringPtrOutput)
+}
+
+// How long the job may run before it is considered failed
+func (o PreDeployJobPtrOutput) TimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *PreDeployJob) *int {
+		if v == nil {
+			return nil
+		}
+		return v.TimeoutSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// An HTTP check the kubelet makes against the application container.
+type Probe struct {
+	// How many consecutive checks must fail before the probe fails. Defaults to 3
+	FailureThreshold *int `pulumi:"failureThreshold"`
+	// How long to wait after the container starts before the first check
+	InitialDelaySeconds *int `pulumi:"initialDelaySeconds"`
+	// The path to request. Defaults to `/`
+	Path *string `pulumi:"path"`
+	// How often to check. Defaults to 10 seconds
+	PeriodSeconds *int `pulumi:"periodSeconds"`
+	// The container port to request. Defaults to the application's port
+	Port *int `pulumi:"port"`
+	// How long a check may take. Defaults to 1 second
+	TimeoutSeconds *int `pulumi:"timeoutSeconds"`
+}
+
+// ProbeInput is an input type that accepts ProbeArgs and ProbeOutput values.
+// You can construct a concrete instance of `ProbeInput` via:
+//
+//	ProbeArgs{...}
+type ProbeInput interface {
+	pulumi.Input
+
+	ToProbeOutput() ProbeOutput
+	ToProbeOutputWithContext(context.Context) ProbeOutput
+}
+
+// An HTTP check the kubelet makes against the application container.
+type ProbeArgs struct {
+	// How many consecutive checks must fail before the probe fails. Defaults to 3
+	FailureThreshold *int `pulumi:"failureThreshold"`
+	// How long to wait after the container starts before the first check
+	InitialDelaySeconds *int `pulumi:"initialDelaySeconds"`
+	// The path to request. Defaults to `/`
+	Path *string `pulumi:"path"`
+	// How often to check. Defaults to 10 seconds
+	PeriodSeconds *int `pulumi:"periodSeconds"`
+	// The container port to request. Defaults to the application's port
+	Port *int `pulumi:"port"`
+	// How long a check may take. Defaults to 1 second
+	TimeoutSeconds *int `pulumi:"timeoutSeconds"`
+}
+
+func (ProbeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Probe)(nil)).Elem()
+}
+
+func (i ProbeArgs) ToProbeOutput() ProbeOutput {
+	return i.ToProbeOutputWithContext(context.Background())
+}
+
+func (i ProbeArgs) ToProbeOutputWithContext(ctx context.Context) ProbeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProbeOutput)
+}
+
+func (i ProbeArgs) ToProbePtrOutput() ProbePtrOutput {
+	return i.ToProbePtrOutputWithContext(context.Background())
+}
+
+func (i ProbeArgs) ToProbePtrOutputWithContext(ctx context.Context) ProbePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProbeOutput).ToProbePtrOutputWithContext(ctx)
+}
+
+// ProbePtrInput is an input type that accepts ProbeArgs, ProbePtr and ProbePtrOutput values.
+// You can construct a concrete instance of `ProbePtrInput` via:
+//
+//	        ProbeArgs{...}
+//
+//	or:
+//
+//	        nil
+type ProbePtrInput interface {
+	pulumi.Input
+
+	ToProbePtrOutput() ProbePtrOutput
+	ToProbePtrOutputWithContext(context.Context) ProbePtrOutput
+}
+
+type probePtrType ProbeArgs
+
+func ProbePtr(v *ProbeArgs) ProbePtrInput {
+	return (*probePtrType)(v)
+}
+
+func (*probePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Probe)(nil)).Elem()
+}
+
+func (i *probePtrType) ToProbePtrOutput() ProbePtrOutput {
+	return i.ToProbePtrOutputWithContext(context.Background())
+}
+
+func (i *probePtrType) ToProbePtrOutputWithContext(ctx context.Context) ProbePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ProbePtrOutput)
+}
+
+// An HTTP check the kubelet makes against the application container.
+type ProbeOutput struct{ *pulumi.OutputState }
+
+func (ProbeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Probe)(nil)).Elem()
+}
+
+func (o ProbeOutput) ToProbeOutput() ProbeOutput {
+	return o
+}
+
+func (o ProbeOutput) ToProbeOutputWithContext(ctx context.Context) ProbeOutput {
+	return o
+}
+
+func (o ProbeOutput) ToProbePtrOutput() ProbePtrOutput {
+	return o.ToProbePtrOutputWithContext(context.Background())
+}
+
+func (o ProbeOutput) ToProbePtrOutputWithContext(ctx context.Context) ProbePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Probe) *Probe {
+		return &v
+	}).(ProbePtrOutput)
+}
+
+// How many consecutive checks must fail before the probe fails. Defaults to 3
+func (o ProbeOutput) FailureThreshold() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Probe) *int { return v.FailureThreshold }).(pulumi.IntPtrOutput)
+}
+
+// How long to wait after the container starts before the first check
+func (o ProbeOutput) InitialDelaySeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Probe) *int { return v.InitialDelaySeconds }).(pulumi.IntPtrOutput)
+}
+
+// The path to request. Defaults to `/`
+func (o ProbeOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Probe) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+// How often to check. Defaults to 10 seconds
+func (o ProbeOutput) PeriodSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Probe) *int { return v.PeriodSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The container port to request. Defaults to the application's port
+func (o ProbeOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Probe) *int { return v.Port }).(pulumi.IntPtrOutput)
+}
+
+// How long a check may take. Defaults to 1 second
+func (o ProbeOutput) TimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Probe) *int { return v.TimeoutSeconds }).(pulumi.IntPtrOutput)
+}
+
+type ProbePtrOutput struct{ *pulumi.OutputState }
+
+func (ProbePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Probe)(nil)).Elem()
+}
+
+func (o ProbePtrOutput) ToProbePtrOutput() ProbePtrOutput {
+	return o
+}
+
+func (o ProbePtrOutput) ToProbePtrOutputWithContext(ctx context.Context) ProbePtrOutput {
+	return o
+}
+
+func (o ProbePtrOutput) Elem() ProbeOutput {
+	return o.ApplyT(func(v *Probe) Probe {
+		if v != nil {
+			return *v
+		}
+		var ret Probe
+		return ret
+	}).(ProbeOutput)
+}
+
+// How many consecutive checks must fail before the probe fails. Defaults to 3
+func (o ProbePtrOutput) FailureThreshold() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Probe) *int {
+		if v == nil {
+			return nil
+		}
+		return v.FailureThreshold
+	}).(pulumi.IntPtrOutput)
+}
+
+// How long to wait after the container starts before the first check
+func (o ProbePtrOutput) InitialDelaySeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Probe) *int {
+		if v == nil {
+			return nil
+		}
+		return v.InitialDelaySeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// The path to request. Defaults to `/`
+func (o ProbePtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Probe) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Path
+	}).(pulumi.StringPtrOutput)
+}
+
+// How often to check. Defaults to 10 seconds
+func (o ProbePtrOutput) PeriodSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Probe) *int {
+		if v == nil {
+			return nil
+		}
+		return v.PeriodSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// The container port to request. Defaults to the application's port
+func (o ProbePtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Probe) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+// How long a check may take. Defaults to 1 second
+func (o ProbePtrOutput) TimeoutSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Probe) *int {
+		if v == nil {
+			return nil
+		}
+		return v.TimeoutSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// Caps what the application may consume in its namespace.
+type Quota struct {
+	// The total CPU limit of all pods in the namespace, such as `4`
+	Cpu *string `pulumi:"cpu"`
+	// Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+	DefaultLimits map[string]string `pulumi:"defaultLimits"`
+	// Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+	DefaultRequests map[string]string `pulumi:"defaultRequests"`
+	// The maximum number of LoadBalancer services in the namespace. Must be at least 1
+	LoadBalancers *int `pulumi:"loadBalancers"`
+	// The total memory limit of all pods in the namespace, such as `8Gi`
+	Memory *string `pulumi:"memory"`
+	// The maximum number of persistent volume claims in the namespace
+	PersistentVolumeClaims *int `pulumi:"persistentVolumeClaims"`
+	// The maximum number of pods in the namespace
+	Pods *int `pulumi:"pods"`
+}
+
+// QuotaInput is an input type that accepts QuotaArgs and QuotaOutput values.
+// You can construct a concrete instance of `QuotaInput` via:
+//
+//	QuotaArgs{...}
+type QuotaInput interface {
+	pulumi.Input
+
+	ToQuotaOutput() QuotaOutput
+	ToQuotaOutputWithContext(context.Context) QuotaOutput
+}
+
+// Caps what the application may consume in its namespace.
+type QuotaArgs struct {
+	// The total CPU limit of all pods in the namespace, such as `4`
+	Cpu *string `pulumi:"cpu"`
+	// Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+	DefaultLimits map[string]string `pulumi:"defaultLimits"`
+	// Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+	DefaultRequests map[string]string `pulumi:"defaultRequests"`
+	// The maximum number of LoadBalancer services in the namespace. Must be at least 1
+	LoadBalancers *int `pulumi:"loadBalancers"`
+	// The total memory limit of all pods in the namespace, such as `8Gi`
+	Memory *string `pulumi:"memory"`
+	// The maximum number of persistent volume claims in the namespace
+	PersistentVolumeClaims *int `pulumi:"persistentVolumeClaims"`
+	// The maximum number of pods in the namespace
+	Pods *int `pulumi:"pods"`
+}
+
+func (QuotaArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Quota)(nil)).Elem()
+}
+
+func (i QuotaArgs) ToQuotaOutput() QuotaOutput {
+	return i.ToQuotaOutputWithContext(context.Background())
+}
+
+func (i QuotaArgs) ToQuotaOutputWithContext(ctx context.Context) QuotaOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(QuotaOutput)
+}
+
+func (i QuotaArgs) ToQuotaPtrOutput() QuotaPtrOutput {
+	return i.ToQuotaPtrOutputWithContext(context.Background())
+}
+
+func (i QuotaArgs) ToQuotaPtrOutputWithContext(ctx context.Context) QuotaPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(QuotaOutput).ToQuotaPtrOutputWithContext(ctx)
+}
+
+// QuotaPtrInput is an input type that accepts QuotaArgs, QuotaPtr and QuotaPtrOutput values.
+// You can construct a concrete instance of `QuotaPtrInput` via:
+//
+//	        QuotaArgs{...}
+//
+//	or:
+//
+//	        nil
+type QuotaPtrInput interface {
+	pulumi.Input
+
+	ToQuotaPtrOutput() QuotaPtrOutput
+	ToQuotaPtrOutputWithContext(context.Context) QuotaPtrOutput
+}
+
+type quotaPtrType QuotaArgs
+
+func QuotaPtr(v *QuotaArgs) QuotaPtrInput {
+	return (*quotaPtrType)(v)
+}
+
+func (*quotaPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Quota)(nil)).Elem()
+}
+
+func (i *quotaPtrType) ToQuotaPtrOutput() QuotaPtrOutput {
+	return i.ToQuotaPtrOutputWithContext(context.Background())
+}
+
+func (i *quotaPtrType) ToQuotaPtrOutputWithContext(ctx context.Context) QuotaPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(QuotaPtrOutput)
+}
+
+// Caps what the application may consume in its namespace.
+type QuotaOutput struct{ *pulumi.OutputState }
+
+func (QuotaOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Quota)(nil)).Elem()
+}
+
+func (o QuotaOutput) ToQuotaOutput() QuotaOutput {
+	return o
+}
+
+func (o QuotaOutput) ToQuotaOutputWithContext(ctx context.Context) QuotaOutput {
+	return o
+}
+
+func (o QuotaOutput) ToQuotaPtrOutput() QuotaPtrOutput {
+	return o.ToQuotaPtrOutputWithContext(context.Background())
+}
+
+func (o QuotaOutput) ToQuotaPtrOutputWithContext(ctx context.Context) QuotaPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Quota) *Quota {
+		return &v
+	}).(QuotaPtrOutput)
+}
+
+// The total CPU limit of all pods in the namespace, such as `4`
+func (o QuotaOutput) Cpu() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Quota) *string { return v.Cpu }).(pulumi.StringPtrOutput)
+}
+
+// Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+func (o QuotaOutput) DefaultLimits() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Quota) map[string]string { return v.DefaultLimits }).(pulumi.StringMapOutput)
+}
+
+// Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+func (o QuotaOutput) DefaultRequests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Quota) map[string]string { return v.DefaultRequests }).(pulumi.StringMapOutput)
+}
+
+// The maximum number of LoadBalancer services in the namespace. Must be at least 1
+func (o QuotaOutput) LoadBalancers() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Quota) *int { return v.LoadBalancers }).(pulumi.IntPtrOutput)
+}
+
+// The total memory limit of all pods in the namespace, such as `8Gi`
+func (o QuotaOutput) Memory() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Quota) *string { return v.Memory }).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of persistent volume claims in the namespace
+func (o QuotaOutput) PersistentVolumeClaims() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Quota) *int { return v.PersistentVolumeClaims }).(pulumi.IntPtrOutput)
+}
+
+// The maximum number of pods in the namespace
+func (o QuotaOutput) Pods() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Quota) *int { return v.Pods }).(pulumi.IntPtrOutput)
+}
+
+type QuotaPtrOutput struct{ *pulumi.OutputState }
+
+func (QuotaPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Quota)(nil)).Elem()
+}
+
+func (o QuotaPtrOutput) ToQuotaPtrOutput() QuotaPtrOutput {
+	return o
+}
+
+func (o QuotaPtrOutput) ToQuotaPtrOutputWithContext(ctx context.Context) QuotaPtrOutput {
+	return o
+}
+
+func (o QuotaPtrOutput) Elem() QuotaOutput {
+	return o.ApplyT(func(v *Quota) Quota {
+		if v != nil {
+			return *v
+		}
+		var ret Quota
+		return ret
+	}).(QuotaOutput)
+}
+
+// The total CPU limit of all pods in the namespace, such as `4`
+func (o QuotaPtrOutput) Cpu() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Quota) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Cpu
+	}).(pulumi.StringPtrOutput)
+}
+
+// Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+func (o QuotaPtrOutput) DefaultLimits() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Quota) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.DefaultLimits
+	}).(pulumi.StringMapOutput)
+}
+
+// Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+func (o QuotaPtrOutput) DefaultRequests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Quota) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.DefaultRequests
+	}).(pulumi.StringMapOutput)
+}
+
+// The maximum number of LoadBalancer services in the namespace. Must be at least 1
+func (o QuotaPtrOutput) LoadBalancers() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Quota) *int {
+		if v == nil {
+			return nil
+		}
+		return v.LoadBalancers
+	}).(pulumi.IntPtrOutput)
+}
+
+// The total memory limit of all pods in the namespace, such as `8Gi`
+func (o QuotaPtrOutput) Memory() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Quota) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Memory
+	}).(pulumi.StringPtrOutput)
+}
+
+// The maximum number of persistent volume claims in the namespace
+func (o QuotaPtrOutput) PersistentVolumeClaims() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Quota) *int {
+		if v == nil {
+			return nil
+		}
+		return v.PersistentVolumeClaims
+	}).(pulumi.IntPtrOutput)
+}
+
+// The maximum number of pods in the namespace
+func (o QuotaPtrOutput) Pods() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Quota) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Pods
+	}).(pulumi.IntPtrOutput)
+}
+
+// The credentials the application's pods pull images with.
+type RegistryCredentials struct {
+	// The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+	ExistingSecretName *string `pulumi:"existingSecretName"`
+	// The password or token to authenticate with
+	Password *string `pulumi:"password"`
+	// The registry server, such as `ghcr.io`
+	Server *string `pulumi:"server"`
+	// The username to authenticate with
+	Username *string `pulumi:"username"`
+}
+
+// RegistryCredentialsInput is an input type that accepts RegistryCredentialsArgs and RegistryCredentialsOutput values.
+// You can construct a concrete instance of `RegistryCredentialsInput` via:
+//
+//	RegistryCredentialsArgs{...}
+type RegistryCredentialsInput interface {
+	pulumi.Input
+
+	ToRegistryCredentialsOutput() RegistryCredentialsOutput
+	ToRegistryCredentialsOutputWithContext(context.Context) RegistryCredentialsOutput
+}
+
+// The credentials the application's pods pull images with.
+type RegistryCredentialsArgs struct {
+	// The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+	ExistingSecretName pulumi.StringPtrInput `pulumi:"existingSecretName"`
+	// The password or token to authenticate with
+	Password pulumi.StringPtrInput `pulumi:"password"`
+	// The registry server, such as `ghcr.io`
+	Server pulumi.StringPtrInput `pulumi:"server"`
+	// The username to authenticate with
+	Username pulumi.StringPtrInput `pulumi:"username"`
+}
+
+func (RegistryCredentialsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*RegistryCredentials)(nil)).Elem()
+}
+
+func (i RegistryCredentialsArgs) ToRegistryCredentialsOutput() RegistryCredentialsOutput {
+	return i.ToRegistryCredentialsOutputWithContext(context.Background())
+}
+
+func (i RegistryCredentialsArgs) ToRegistryCredentialsOutputWithContext(ctx context.Context) RegistryCredentialsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RegistryCredentialsOutput)
+}
+
+func (i RegistryCredentialsArgs) ToRegistryCredentialsPtrOutput() RegistryCredentialsPtrOutput {
+	return i.ToRegistryCredentialsPtrOutputWithContext(context.Background())
+}
+
+func (i RegistryCredentialsArgs) ToRegistryCredentialsPtrOutputWithContext(ctx context.Context) RegistryCredentialsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RegistryCredentialsOutput).ToRegistryCredentialsPtrOutputWithContext(ctx)
+}
+
+// RegistryCredentialsPtrInput is an input type that accepts RegistryCredentialsArgs, RegistryCredentialsPtr and RegistryCredentialsPtrOutput values.
+// You can construct a concrete instance of `RegistryCredentialsPtrInput` via:
+//
+//	        RegistryCredentialsArgs{...}
+//
+//	or:
+//
+//	        nil
+type RegistryCredentialsPtrInput interface {
+	pulumi.Input
+
+	ToRegistryCredentialsPtrOutput() RegistryCredentialsPtrOutput
+	ToRegistryCredentialsPtrOutputWithContext(context.Context) RegistryCredentialsPtrOutput
+}
+
+type registryCredentialsPtrType RegistryCredentialsArgs
+
+func RegistryCredentialsPtr(v *RegistryCredentialsArgs) RegistryCredentialsPtrInput {
+	return (*registryCredentialsPtrType)(v)
+}
+
+func (*registryCredentialsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**RegistryCredentials)(nil)).Elem()
+}
+
+func (i *registryCredentialsPtrType) ToRegistryCredentialsPtrOutput() RegistryCredentialsPtrOutput {
+	return i.ToRegistryCredentialsPtrOutputWithContext(context.Background())
+}
+
+func (i *registryCredentialsPtrType) ToRegistryCredentialsPtrOutputWithContext(ctx context.Context) RegistryCredentialsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(RegistryCredentialsPtrOutput)
+}
+
+// The credentials the application's pods pull images with.
+type RegistryCredentialsOutput struct{ *pulumi.OutputState }
+
+func (RegistryCredentialsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RegistryCredentials)(nil)).Elem()
+}
+
+func (o RegistryCredentialsOutput) ToRegistryCredentialsOutput() RegistryCredentialsOutput {
+	return o
+}
+
+func (o RegistryCredentialsOutput) ToRegistryCredentialsOutputWithContext(ctx context.Context) RegistryCredentialsOutput {
+	return o
+}
+
+func (o RegistryCredentialsOutput) ToRegistryCredentialsPtrOutput() RegistryCredentialsPtrOutput {
+	return o.ToRegistryCredentialsPtrOutputWithContext(context.Background())
+}
+
+func (o RegistryCredentialsOutput) ToRegistryCredentialsPtrOutputWithContext(ctx context.Context) RegistryCredentialsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v RegistryCredentials) *RegistryCredentials {
+		return &v
+	}).(RegistryCredentialsPtrOutput)
+}
+
+// The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+func (o RegistryCredentialsOutput) ExistingSecretName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RegistryCredentials) *string { return v.ExistingSecretName }).(pulumi.StringPtrOutput)
+}
+
+// The password or token to authenticate with
+func (o RegistryCredentialsOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RegistryCredentials) *string { return v.Password }).(pulumi.StringPtrOutput)
+}
+
+// The registry server, such as `ghcr.io`
+func (o RegistryCredentialsOutput) Server() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RegistryCredentials) *string { return v.Server }).(pulumi.StringPtrOutput)
+}
+
+// The username to authenticate with
+func (o RegistryCredentialsOutput) Username() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v RegistryCredentials) *string { return v.Username }).(pulumi.StringPtrOutput)
+}
+
+type RegistryCredentialsPtrOutput struct{ *pulumi.OutputState }
+
+func (RegistryCredentialsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**RegistryCredentials)(nil)).Elem()
+}
+
+func (o RegistryCredentialsPtrOutput) ToRegistryCredentialsPtrOutput() RegistryCredentialsPtrOutput {
+	return o
+}
+
+func (o RegistryCredentialsPtrOutput) ToRegistryCredentialsPtrOutputWithContext(ctx context.Context) RegistryCredentialsPtrOutput {
+	return o
+}
+
+func (o RegistryCredentialsPtrOutput) Elem() RegistryCredentialsOutput {
+	return o.ApplyT(func(v *RegistryCredentials) RegistryCredentials {
+		if v != nil {
+			return *v
+		}
+		var ret RegistryCredentials
+		return ret
+	}).(RegistryCredentialsOutput)
+}
+
+// The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+func (o RegistryCredentialsPtrOutput) ExistingSecretName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RegistryCredentials) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ExistingSecretName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The password or token to authenticate with
+func (o RegistryCredentialsPtrOutput) Password() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RegistryCredentials) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Password
+	}).(pulumi.StringPtrOutput)
+}
+
+// The registry server, such as `ghcr.io`
+func (o RegistryCredentialsPtrOutput) Server() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RegistryCredentials) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Server
+	}).(pulumi.StringPtrOutput)
+}
+
+// The username to authenticate with
+func (o RegistryCredentialsPtrOutput) Username() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *RegistryCredentials) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Username
+	}).(pulumi.StringPtrOutput)
+}
+
+// The number of a Deployment's pods in each state.
+type ReplicaStatus struct {
+	// The number of available pods
+	AvailableReplicas int `pulumi:"availableReplicas"`
+	// The number of ready pods
+	ReadyReplicas int `pulumi:"readyReplicas"`
+	// The number of pods
+	Replicas int `pulumi:"replicas"`
+	// The number of pods running the latest pod template
+	UpdatedReplicas int `pulumi:"updatedReplicas"`
+}
+
+// The number of a Deployment's pods in each state.
+type ReplicaStatusOutput struct{ *pulumi.OutputState }
+
+func (ReplicaStatusOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ReplicaStatus)(nil)).Elem()
+}
+
+func (o ReplicaStatusOutput) ToReplicaStatusOutput() ReplicaStatusOutput {
+	return o
+}
+
+func (o ReplicaStatusOutput) ToReplicaStatusOutputWithContext(ctx context.Context) ReplicaStatusOutput {
+	return o
+}
+
+// The number of available pods
+func (o ReplicaStatusOutput) AvailableReplicas() pulumi.IntOutput {
+	return o.ApplyT(func(v ReplicaStatus) int { return v.AvailableReplicas }).(pulumi.IntOutput)
+}
+
+// The number of ready pods
+func (o ReplicaStatusOutput) ReadyReplicas() pulumi.IntOutput {
+	return o.ApplyT(func(v ReplicaStatus) int { return v.ReadyReplicas }).(pulumi.IntOutput)
+}
+
+// The number of pods
+func (o ReplicaStatusOutput) Replicas() pulumi.IntOutput {
+	return o.ApplyT(func(v ReplicaStatus) int { return v.Replicas }).(pulumi.IntOutput)
+}
+
+// The number of pods running the latest pod template
+func (o ReplicaStatusOutput) UpdatedReplicas() pulumi.IntOutput {
+	return o.ApplyT(func(v ReplicaStatus) int { return v.UpdatedReplicas }).(pulumi.IntOutput)
+}
+
+type ReplicaStatusMapOutput struct{ *pulumi.OutputState }
+
+func (ReplicaStatusMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]ReplicaStatus)(nil)).Elem()
+}
+
+func (o ReplicaStatusMapOutput) ToReplicaStatusMapOutput() ReplicaStatusMapOutput {
+	return o
+}
+
+func (o ReplicaStatusMapOutput) ToReplicaStatusMapOutputWithContext(ctx context.Context) ReplicaStatusMapOutput {
+	return o
+}
+
+func (o ReplicaStatusMapOutput) MapIndex(k pulumi.StringInput) ReplicaStatusOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) ReplicaStatus {
+		return vs[0].(map[string]ReplicaStatus)[vs[1].(string)]
+	}).(ReplicaStatusOutput)
+}
+
+// Container resource requests and limits.
+type ResourcePreset struct {
+	// The resource limits, keyed by resource name
+	Limits map[string]string `pulumi:"limits"`
+	// The resources requested, keyed by resource name
+	Requests map[string]string `pulumi:"requests"`
+}
+
+// Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+type Resources struct {
+	// The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+	Limits map[string]string `pulumi:"limits"`
+	// The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+	Requests map[string]string `pulumi:"requests"`
+}
+
+// ResourcesInput is an input type that accepts ResourcesArgs and ResourcesOutput values.
+// You can construct a concrete instance of `ResourcesInput` via:
+//
+//	ResourcesArgs{...}
+type ResourcesInput interface {
+	pulumi.Input
+
+	ToResourcesOutput() ResourcesOutput
+	ToResourcesOutputWithContext(context.Context) ResourcesOutput
+}
+
+// Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+type ResourcesArgs struct {
+	// The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+	Limits map[string]string `pulumi:"limits"`
+	// The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+	Requests map[string]string `pulumi:"requests"`
+}
+
+func (ResourcesArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Resources)(nil)).Elem()
+}
+
+func (i ResourcesArgs) ToResourcesOutput() ResourcesOutput {
+	return i.ToResourcesOutputWithContext(context.Background())
+}
+
+func (i ResourcesArgs) ToResourcesOutputWithContext(ctx context.Context) ResourcesOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ResourcesOutput)
+}
+
+func (i ResourcesArgs) ToResourcesPtrOutput() ResourcesPtrOutput {
+	return i.ToResourcesPtrOutputWithContext(context.Background())
+}
+
+func (i ResourcesArgs) ToResourcesPtrOutputWithContext(ctx context.Context) ResourcesPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ResourcesOutput).ToResourcesPtrOutputWithContext(ctx)
+}
+
+// ResourcesPtrInput is an input type that accepts ResourcesArgs, ResourcesPtr and ResourcesPtrOutput values.
+// You can construct a concrete instance of `ResourcesPtrInput` via:
+//
+//	        ResourcesArgs{...}
+//
+//	or:
+//
+//	        nil
+type ResourcesPtrInput interface {
+	pulumi.Input
+
+	ToResourcesPtrOutput() ResourcesPtrOutput
+	ToResourcesPtrOutputWithContext(context.Context) ResourcesPtrOutput
+}
+
+type resourcesPtrType ResourcesArgs
+
+func ResourcesPtr(v *ResourcesArgs) ResourcesPtrInput {
+	return (*resourcesPtrType)(v)
+}
+
+func (*resourcesPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Resources)(nil)).Elem()
+}
+
+func (i *resourcesPtrType) ToResourcesPtrOutput() ResourcesPtrOutput {
+	return i.ToResourcesPtrOutputWithContext(context.Background())
+}
+
+func (i *resourcesPtrType) ToResourcesPtrOutputWithContext(ctx context.Context) ResourcesPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ResourcesPtrOutput)
+}
+
+// Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+type ResourcesOutput struct{ *pulumi.OutputState }
+
+func (ResourcesOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Resources)(nil)).Elem()
+}
+
+func (o ResourcesOutput) ToResourcesOutput() ResourcesOutput {
+	return o
+}
+
+func (o ResourcesOutput) ToResourcesOutputWithContext(ctx context.Context) ResourcesOutput {
+	return o
+}
+
+func (o ResourcesOutput) ToResourcesPtrOutput() ResourcesPtrOutput {
+	return o.ToResourcesPtrOutputWithContext(context.Background())
+}
+
+func (o ResourcesOutput) ToResourcesPtrOutputWithContext(ctx context.Context) ResourcesPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Resources) *Resources {
+		return &v
+	}).(ResourcesPtrOutput)
+}
+
+// The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+func (o ResourcesOutput) Limits() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Resources) map[string]string { return v.Limits }).(pulumi.StringMapOutput)
+}
+
+// The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+func (o ResourcesOutput) Requests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v Resources) map[string]string { return v.Requests }).(pulumi.StringMapOutput)
+}
+
+type ResourcesPtrOutput struct{ *pulumi.OutputState }
+
+func (ResourcesPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Resources)(nil)).Elem()
+}
+
+func (o ResourcesPtrOutput) ToResourcesPtrOutput() ResourcesPtrOutput {
+	return o
+}
+
+func (o ResourcesPtrOutput) ToResourcesPtrOutputWithContext(ctx context.Context) ResourcesPtrOutput {
+	return o
+}
+
+func (o ResourcesPtrOutput) Elem() ResourcesOutput {
+	return o.ApplyT(func(v *Resources) Resources {
+		if v != nil {
+			return *v
+		}
+		var ret Resources
+		return ret
+	}).(ResourcesOutput)
+}
+
+// The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+func (o ResourcesPtrOutput) Limits() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Resources) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Limits
+	}).(pulumi.StringMapOutput)
+}
+
+// The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+func (o ResourcesPtrOutput) Requests() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *Resources) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Requests
+	}).(pulumi.StringMapOutput)
+}
+
+// A volume populated from a Secret.
+type SecretVolume struct {
+	// The name of the Secret in the application namespace
+	SecretName string `pulumi:"secretName"`
+}
+
+// SecretVolumeInput is an input type that accepts SecretVolumeArgs and SecretVolumeOutput values.
+// You can construct a concrete instance of `SecretVolumeInput` via:
+//
+//	SecretVolumeArgs{...}
+type SecretVolumeInput interface {
+	pulumi.Input
+
+	ToSecretVolumeOutput() SecretVolumeOutput
+	ToSecretVolumeOutputWithContext(context.Context) SecretVolumeOutput
+}
+
+// A volume populated from a Secret.
+type SecretVolumeArgs struct {
+	// The name of the Secret in the application namespace
+	SecretName string `pulumi:"secretName"`
+}
+
+func (SecretVolumeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SecretVolume)(nil)).Elem()
+}
+
+func (i SecretVolumeArgs) ToSecretVolumeOutput() SecretVolumeOutput {
+	return i.ToSecretVolumeOutputWithContext(context.Background())
+}
+
+func (i SecretVolumeArgs) ToSecretVolumeOutputWithContext(ctx context.Context) SecretVolumeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretVolumeOutput)
+}
+
+func (i SecretVolumeArgs) ToSecretVolumePtrOutput() SecretVolumePtrOutput {
+	return i.ToSecretVolumePtrOutputWithContext(context.Background())
+}
+
+func (i SecretVolumeArgs) ToSecretVolumePtrOutputWithContext(ctx context.Context) SecretVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretVolumeOutput).ToSecretVolumePtrOutputWithContext(ctx)
+}
+
+// SecretVolumePtrInput is an input type that accepts SecretVolumeArgs, SecretVolumePtr and SecretVolumePtrOutput values.
+// You can construct a concrete instance of `SecretVolumePtrInput` via:
+//
+//	        SecretVolumeArgs{...}
+//
+//	or:
+//
+//	        nil
+type SecretVolumePtrInput interface {
+	pulumi.Input
+
+	ToSecretVolumePtrOutput() SecretVolumePtrOutput
+	ToSecretVolumePtrOutputWithContext(context.Context) SecretVolumePtrOutput
+}
+
+type secretVolumePtrType SecretVolumeArgs
+
+func SecretVolumePtr(v *SecretVolumeArgs) SecretVolumePtrInput {
+	return (*secretVolumePtrType)(v)
+}
+
+func (*secretVolumePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SecretVolume)(nil)).Elem()
+}
+
+func (i *secretVolumePtrType) ToSecretVolumePtrOutput() SecretVolumePtrOutput {
+	return i.ToSecretVolumePtrOutputWithContext(context.Background())
+}
+
+func (i *secretVolumePtrType) ToSecretVolumePtrOutputWithContext(ctx context.Context) SecretVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SecretVolumePtrOutput)
+}
+
+// A volume populated from a Secret.
+type SecretVolumeOutput struct{ *pulumi.OutputState }
+
+func (SecretVolumeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SecretVolume)(nil)).Elem()
+}
+
+func (o SecretVolumeOutput) ToSecretVolumeOutput() SecretVolumeOutput {
+	return o
+}
+
+func (o SecretVolumeOutput) ToSecretVolumeOutputWithContext(ctx context.Context) SecretVolumeOutput {
+	return o
+}
+
+func (o SecretVolumeOutput) ToSecretVolumePtrOutput() SecretVolumePtrOutput {
+	return o.ToSecretVolumePtrOutputWithContext(context.Background())
+}
+
+func (o SecretVolumeOutput) ToSecretVolumePtrOutputWithContext(ctx context.Context) SecretVolumePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SecretVolume) *SecretVolume {
+		return &v
+	}).(SecretVolumePtrOutput)
+}
+
+// The name of the Secret in the application namespace
+func (o SecretVolumeOutput) SecretName() pulumi.StringOutput {
+	return o.ApplyT(func(v SecretVolume) string { return v.SecretName }).(pulumi.StringOutput)
+}
+
+type SecretVolumePtrOutput struct{ *pulumi.OutputState }
+
+func (SecretVolumePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SecretVolume)(nil)).Elem()
+}
+
+func (o SecretVolumePtrOutput) ToSecretVolumePtrOutput() SecretVolumePtrOutput {
+	return o
+}
+
+func (o SecretVolumePtrOutput) ToSecretVolumePtrOutputWithContext(ctx context.Context) SecretVolumePtrOutput {
+	return o
+}
+
+func (o SecretVolumePtrOutput) Elem() SecretVolumeOutput {
+	return o.ApplyT(func(v *SecretVolume) SecretVolume {
+		if v != nil {
+			return *v
+		}
+		var ret SecretVolume
+		return ret
+	}).(SecretVolumeOutput)
+}
+
+// The name of the Secret in the application namespace
+func (o SecretVolumePtrOutput) SecretName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SecretVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return &v.SecretName
+	}).(pulumi.StringPtrOutput)
+}
+
+// Configures how the application's pods are stopped.
+type Shutdown struct {
+	// A command to run in the preStop hook instead of sleeping
+	PreStopCommand []string `pulumi:"preStopCommand"`
+	// How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+	PreStopSleepSeconds *int `pulumi:"preStopSleepSeconds"`
+	// How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+	TerminationGracePeriodSeconds *int `pulumi:"terminationGracePeriodSeconds"`
+}
+
+// ShutdownInput is an input type that accepts ShutdownArgs and ShutdownOutput values.
+// You can construct a concrete instance of `ShutdownInput` via:
+//
+//	ShutdownArgs{...}
+type ShutdownInput interface {
+	pulumi.Input
+
+	ToShutdownOutput() ShutdownOutput
+	ToShutdownOutputWithContext(context.Context) ShutdownOutput
+}
+
+// Configures how the application's pods are stopped.
+type ShutdownArgs struct {
+	// A command to run in the preStop hook instead of sleeping
+	PreStopCommand []string `pulumi:"preStopCommand"`
+	// How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+	PreStopSleepSeconds *int `pulumi:"preStopSleepSeconds"`
+	// How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+	TerminationGracePeriodSeconds *int `pulumi:"terminationGracePeriodSeconds"`
+}
+
+func (ShutdownArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Shutdown)(nil)).Elem()
+}
+
+func (i ShutdownArgs) ToShutdownOutput() ShutdownOutput {
+	return i.ToShutdownOutputWithContext(context.Background())
+}
+
+func (i ShutdownArgs) ToShutdownOutputWithContext(ctx context.Context) ShutdownOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ShutdownOutput)
+}
+
+func (i ShutdownArgs) ToShutdownPtrOutput() ShutdownPtrOutput {
+	return i.ToShutdownPtrOutputWithContext(context.Background())
+}
+
+func (i ShutdownArgs) ToShutdownPtrOutputWithContext(ctx context.Context) ShutdownPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ShutdownOutput).ToShutdownPtrOutputWithContext(ctx)
+}
+
+// ShutdownPtrInput is an input type that accepts ShutdownArgs, ShutdownPtr and ShutdownPtrOutput values.
+// You can construct a concrete instance of `ShutdownPtrInput` via:
+//
+//	        ShutdownArgs{...}
+//
+//	or:
+//
+//	        nil
+type ShutdownPtrInput interface {
+	pulumi.Input
+
+	ToShutdownPtrOutput() ShutdownPtrOutput
+	ToShutdownPtrOutputWithContext(context.Context) ShutdownPtrOutput
+}
+
+type shutdownPtrType ShutdownArgs
+
+func ShutdownPtr(v *ShutdownArgs) ShutdownPtrInput {
+	return (*shutdownPtrType)(v)
+}
+
+func (*shutdownPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Shutdown)(nil)).Elem()
+}
+
+func (i *shutdownPtrType) ToShutdownPtrOutput() ShutdownPtrOutput {
+	return i.ToShutdownPtrOutputWithContext(context.Background())
+}
+
+func (i *shutdownPtrType) ToShutdownPtrOutputWithContext(ctx context.Context) ShutdownPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ShutdownPtrOutput)
+}
+
+// Configures how the application's pods are stopped.
+type ShutdownOutput struct{ *pulumi.OutputState }
+
+func (ShutdownOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Shutdown)(nil)).Elem()
+}
+
+func (o ShutdownOutput) ToShutdownOutput() ShutdownOutput {
+	return o
+}
+
+func (o ShutdownOutput) ToShutdownOutputWithContext(ctx context.Context) ShutdownOutput {
+	return o
+}
+
+func (o ShutdownOutput) ToShutdownPtrOutput() ShutdownPtrOutput {
+	return o.ToShutdownPtrOutputWithContext(context.Background())
+}
+
+func (o ShutdownOutput) ToShutdownPtrOutputWithContext(ctx context.Context) ShutdownPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Shutdown) *Shutdown {
+		return &v
+	}).(ShutdownPtrOutput)
+}
+
+// A command to run in the preStop hook instead of sleeping
+func (o ShutdownOutput) PreStopCommand() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Shutdown) []string { return v.PreStopCommand }).(pulumi.StringArrayOutput)
+}
+
+// How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+func (o ShutdownOutput) PreStopSleepSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Shutdown) *int { return v.PreStopSleepSeconds }).(pulumi.IntPtrOutput)
+}
+
+// How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+func (o ShutdownOutput) TerminationGracePeriodSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Shutdown) *int { return v.TerminationGracePeriodSeconds }).(pulumi.IntPtrOutput)
+}
+
+type ShutdownPtrOutput struct{ *pulumi.OutputState }
+
+func (ShutdownPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Shutdown)(nil)).Elem()
+}
+
+func (o ShutdownPtrOutput) ToShutdownPtrOutput() ShutdownPtrOutput {
+	return o
+}
+
+func (o ShutdownPtrOutput) ToShutdownPtrOutputWithContext(ctx context.Context) ShutdownPtrOutput {
+	return o
+}
+
+func (o ShutdownPtrOutput) Elem() ShutdownOutput {
+	return o.ApplyT(func(v *Shutdown) Shutdown {
+		if v != nil {
+			return *v
+		}
+		var ret Shutdown
+		return ret
+	}).(ShutdownOutput)
+}
+
+// A command to run in the preStop hook instead of sleeping
+func (o ShutdownPtrOutput) PreStopCommand() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Shutdown) []string {
+		if v == nil {
+			return nil
+		}
+		return v.PreStopCommand
+	}).(pulumi.StringArrayOutput)
+}
+
+// How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+func (o ShutdownPtrOutput) PreStopSleepSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Shutdown) *int {
+		if v == nil {
+			return nil
+		}
+		return v.PreStopSleepSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+func (o ShutdownPtrOutput) TerminationGracePeriodSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Shutdown) *int {
+		if v == nil {
+			return nil
+		}
+		return v.TerminationGracePeriodSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+type SmokeTest struct {
+	// Text the response's body must contain
+	BodyContains *string `pulumi:"bodyContains"`
+	// The status code the response must have. Defaults to 200
+	ExpectedStatus *int `pulumi:"expectedStatus"`
+	// How long to wait between attempts. Defaults to 5 seconds
+	IntervalSeconds *int `pulumi:"intervalSeconds"`
+	// The path to request. Defaults to `/`
+	Path *string `pulumi:"path"`
+	// How many times to retry the request before failing. Defaults to 10
+	Retries *int `pulumi:"retries"`
+}
+
+// SmokeTestInput is an input type that accepts SmokeTestArgs and SmokeTestOutput values.
+// You can construct a concrete instance of `SmokeTestInput` via:
+//
+//	SmokeTestArgs{...}
+type SmokeTestInput interface {
+	pulumi.Input
+
+	ToSmokeTestOutput() SmokeTestOutput
+	ToSmokeTestOutputWithContext(context.Context) SmokeTestOutput
+}
+
+// An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+type SmokeTestArgs struct {
+	// Text the response's body must contain
+	BodyContains *string `pulumi:"bodyContains"`
+	// The status code the response must have. Defaults to 200
+	ExpectedStatus *int `pulumi:"expectedStatus"`
+	// How long to wait between attempts. Defaults to 5 seconds
+	IntervalSeconds *int `pulumi:"intervalSeconds"`
+	// The path to request. Defaults to `/`
+	Path *string `pulumi:"path"`
+	// How many times to retry the request before failing. Defaults to 10
+	Retries *int `pulumi:"retries"`
+}
+
+func (SmokeTestArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*SmokeTest)(nil)).Elem()
+}
+
+func (i SmokeTestArgs) ToSmokeTestOutput() SmokeTestOutput {
+	return i.ToSmokeTestOutputWithContext(context.Background())
+}
+
+func (i SmokeTestArgs) ToSmokeTestOutputWithContext(ctx context.Context) SmokeTestOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestOutput)
+}
+
+func (i SmokeTestArgs) ToSmokeTestPtrOutput() SmokeTestPtrOutput {
+	return i.ToSmokeTestPtrOutputWithContext(context.Background())
+}
+
+func (i SmokeTestArgs) ToSmokeTestPtrOutputWithContext(ctx context.Context) SmokeTestPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestOutput).ToSmokeTestPtrOutputWithContext(ctx)
+}
+
+// SmokeTestPtrInput is an input type that accepts SmokeTestArgs, SmokeTestPtr and SmokeTestPtrOutput values.
+// You can construct a concrete instance of `SmokeTestPtrInput` via:
+//
+//	        SmokeTestArgs{...}
+//
+//	or:
+//
+//	        nil
+type SmokeTestPtrInput interface {
+	pulumi.Input
+
+	ToSmokeTestPtrOutput() SmokeTestPtrOutput
+	ToSmokeTestPtrOutputWithContext(context.Context) SmokeTestPtrOutput
+}
+
+type smokeTestPtrType SmokeTestArgs
+
+func SmokeTestPtr(v *SmokeTestArgs) SmokeTestPtrInput {
+	return (*smokeTestPtrType)(v)
+}
+
+func (*smokeTestPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**SmokeTest)(nil)).Elem()
+}
+
+func (i *smokeTestPtrType) ToSmokeTestPtrOutput() SmokeTestPtrOutput {
+	return i.ToSmokeTestPtrOutputWithContext(context.Background())
+}
+
+func (i *smokeTestPtrType) ToSmokeTestPtrOutputWithContext(ctx context.Context) SmokeTestPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SmokeTestPtrOutput)
+}
+
+// An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+type SmokeTestOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SmokeTest)(nil)).Elem()
+}
+
+func (o SmokeTestOutput) ToSmokeTestOutput() SmokeTestOutput {
+	return o
+}
+
+func (o SmokeTestOutput) ToSmokeTestOutputWithContext(ctx context.Context) SmokeTestOutput {
+	return o
+}
+
+func (o SmokeTestOutput) ToSmokeTestPtrOutput() SmokeTestPtrOutput {
+	return o.ToSmokeTestPtrOutputWithContext(context.Background())
+}
+
+func (o SmokeTestOutput) ToSmokeTestPtrOutputWithContext(ctx context.Context) SmokeTestPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SmokeTest) *SmokeTest {
+		return &v
+	}).(SmokeTestPtrOutput)
+}
+
+// Text the response's body must contain
+func (o SmokeTestOutput) BodyContains() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTest) *string { return v.BodyContains }).(pulumi.StringPtrOutput)
+}
+
+// The status code the response must have. Defaults to 200
+func (o SmokeTestOutput) ExpectedStatus() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SmokeTest) *int { return v.ExpectedStatus }).(pulumi.IntPtrOutput)
+}
+
+// How long to wait between attempts. Defaults to 5 seconds
+func (o SmokeTestOutput) IntervalSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SmokeTest) *int { return v.IntervalSeconds }).(pulumi.IntPtrOutput)
+}
+
+// The path to request. Defaults to `/`
+func (o SmokeTestOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v SmokeTest) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+// How many times to retry the request before failing. Defaults to 10
+func (o SmokeTestOutput) Retries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v SmokeTest) *int { return v.Retries }).(pulumi.IntPtrOutput)
+}
+
+type SmokeTestPtrOutput struct{ *pulumi.OutputState }
+
+func (SmokeTestPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SmokeTest)(nil)).Elem()
+}
+
+func (o SmokeTestPtrOutput) ToSmokeTestPtrOutput() SmokeTestPtrOutput {
+	return o
+}
+
+func (o SmokeTestPtrOutput) ToSmokeTestPtrOutputWithContext(ctx context.Context) SmokeTestPtrOutput {
+	return o
+}
+
+func (o SmokeTestPtrOutput) Elem() SmokeTestOutput {
+	return o.ApplyT(func(v *SmokeTest) SmokeTest {
+		if v != nil {
+			return *v
+		}
+		var ret SmokeTest
+		return ret
+	}).(SmokeTestOutput)
+}
+
+// Text the response's body must contain
+func (o SmokeTestPtrOutput) BodyContains() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SmokeTest) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BodyContains
+	}).(pulumi.StringPtrOutput)
+}
+
+// The status code the response must have. Defaults to 200
+func (o SmokeTestPtrOutput) ExpectedStatus() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SmokeTest) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ExpectedStatus
+	}).(pulumi.IntPtrOutput)
+}
+
+// How long to wait between attempts. Defaults to 5 seconds
+func (o SmokeTestPtrOutput) IntervalSeconds() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SmokeTest) *int {
+		if v == nil {
+			return nil
+		}
+		return v.IntervalSeconds
+	}).(pulumi.IntPtrOutput)
+}
+
+// The path to request. Defaults to `/`
+func (o SmokeTestPtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *SmokeTest) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Path
+	}).(pulumi.StringPtrOutput)
+}
+
+// How many times to retry the request before failing. Defaults to 10
+func (o SmokeTestPtrOutput) Retries() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *SmokeTest) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Retries
+	}).(pulumi.IntPtrOutput)
+}
+
+// A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+type Volume struct {
+	// The contents of a ConfigMap
+	ConfigMap *ConfigMapVolume `pulumi:"configMap"`
+	// A scratch directory that lives as long as the pod
+	EmptyDir *EmptyDirVolume `pulumi:"emptyDir"`
+	// A path on the node. Requires `allowHostPath`
+	HostPath *HostPathVolume `pulumi:"hostPath"`
+	// The name of the volume, referenced by `volumeMounts`
+	Name string `pulumi:"name"`
+	// The contents of a Secret
+	Secret *SecretVolume `pulumi:"secret"`
+}
+
+// VolumeInput is an input type that accepts VolumeArgs and VolumeOutput values.
+// You can construct a concrete instance of `VolumeInput` via:
+//
+//	VolumeArgs{...}
+type VolumeInput interface {
+	pulumi.Input
+
+	ToVolumeOutput() VolumeOutput
+	ToVolumeOutputWithContext(context.Context) VolumeOutput
+}
+
+// A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+type VolumeArgs struct {
+	// The contents of a ConfigMap
+	ConfigMap *ConfigMapVolumeArgs `pulumi:"configMap"`
+	// A scratch directory that lives as long as the pod
+	EmptyDir *EmptyDirVolumeArgs `pulumi:"emptyDir"`
+	// A path on the node. Requires `allowHostPath`
+	HostPath *HostPathVolumeArgs `pulumi:"hostPath"`
+	// The name of the volume, referenced by `volumeMounts`
+	Name string `pulumi:"name"`
+	// The contents of a Secret
+	Secret *SecretVolumeArgs `pulumi:"secret"`
+}
+
+func (VolumeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Volume)(nil)).Elem()
+}
+
+func (i VolumeArgs) ToVolumeOutput() VolumeOutput {
+	return i.ToVolumeOutputWithContext(context.Background())
+}
+
+func (i VolumeArgs) ToVolumeOutputWithContext(ctx context.Context) VolumeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VolumeOutput)
+}
+
+// VolumeArrayInput is an input type that accepts VolumeArray and VolumeArrayOutput values.
+// You can construct a concrete instance of `VolumeArrayInput` via:
+//
+//	VolumeArray{ VolumeArgs{...} }
+type VolumeArrayInput interface {
+	pulumi.Input
+
+	ToVolumeArrayOutput() VolumeArrayOutput
+	ToVolumeArrayOutputWithContext(context.Context) VolumeArrayOutput
+}
+
+type VolumeArray []VolumeInput
+
+func (VolumeArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Volume)(nil)).Elem()
+}
+
+func (i VolumeArray) ToVolumeArrayOutput() VolumeArrayOutput {
+	return i.ToVolumeArrayOutputWithContext(context.Background())
+}
+
+func (i VolumeArray) ToVolumeArrayOutputWithContext(ctx context.Context) VolumeArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VolumeArrayOutput)
+}
+
+// A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+type VolumeOutput struct{ *pulumi.OutputState }
+
+func (VolumeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Volume)(nil)).Elem()
+}
+
+func (o VolumeOutput) ToVolumeOutput() VolumeOutput {
+	return o
+}
+
+func (o VolumeOutput) ToVolumeOutputWithContext(ctx context.Context) VolumeOutput {
+	return o
+}
+
+// The contents of a ConfigMap
+func (o VolumeOutput) ConfigMap() ConfigMapVolumePtrOutput {
+	return o.ApplyT(func(v Volume) *ConfigMapVolume { return v.ConfigMap }).(ConfigMapVolumePtrOutput)
+}
+
+// A scratch directory that lives as long as the pod
+func (o VolumeOutput) EmptyDir() EmptyDirVolumePtrOutput {
+	return o.ApplyT(func(v Volume) *EmptyDirVolume { return v.EmptyDir }).(EmptyDirVolumePtrOutput)
+}
+
+// A path on the node. Requires `allowHostPath`
+func (o VolumeOutput) HostPath() HostPathVolumePtrOutput {
+	return o.ApplyT(func(v Volume) *HostPathVolume { return v.HostPath }).(HostPathVolumePtrOutput)
+}
+
+// The name of the volume, referenced by `volumeMounts`
+func (o VolumeOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v Volume) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The contents of a Secret
+func (o VolumeOutput) Secret() SecretVolumePtrOutput {
+	return o.ApplyT(func(v Volume) *SecretVolume { return v.Secret }).(SecretVolumePtrOutput)
+}
+
+type VolumeArrayOutput struct{ *pulumi.OutputState }
+
+func (VolumeArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Volume)(nil)).Elem()
+}
+
+func (o VolumeArrayOutput) ToVolumeArrayOutput() VolumeArrayOutput {
+	return o
+}
+
+func (o VolumeArrayOutput) ToVolumeArrayOutputWithContext(ctx context.Context) VolumeArrayOutput {
+	return o
+}
+
+func (o VolumeArrayOutput) Index(i pulumi.IntInput) VolumeOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Volume {
+		return vs[0].([]Volume)[vs[1].(int)]
+	}).(VolumeOutput)
+}
+
+// Mounts one of the application's volumes into its container.
+type VolumeMount struct {
+	// Where to mount the volume
+	MountPath string `pulumi:"mountPath"`
+	// The name of the volume to mount
+	Name string `pulumi:"name"`
+	// Whether to mount the volume read-only
+	ReadOnly *bool `pulumi:"readOnly"`
+	// A path within the volume to mount instead of its root
+	SubPath *string `pulumi:"subPath"`
+}
+
+// VolumeMountInput is an input type that accepts VolumeMountArgs and VolumeMountOutput values.
+// You can construct a concrete instance of `VolumeMountInput` via:
+//
+//	VolumeMountArgs{...}
+type VolumeMountInput interface {
+	pulumi.Input
+
+	ToVolumeMountOutput() VolumeMountOutput
+	ToVolumeMountOutputWithContext(context.Context) VolumeMountOutput
+}
+
+// Mounts one of the application's volumes into its container.
+type VolumeMountArgs struct {
+	// Where to mount the volume
+	MountPath string `pulumi:"mountPath"`
+	// The name of the volume to mount
+	Name string `pulumi:"name"`
+	// Whether to mount the volume read-only
+	ReadOnly *bool `pulumi:"readOnly"`
+	// A path within the volume to mount instead of its root
+	SubPath *string `pulumi:"subPath"`
+}
+
+func (VolumeMountArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*VolumeMount)(nil)).Elem()
+}
+
+func (i VolumeMountArgs) ToVolumeMountOutput() VolumeMountOutput {
+	return i.ToVolumeMountOutputWithContext(context.Background())
+}
+
+func (i VolumeMountArgs) ToVolumeMountOutputWithContext(ctx context.Context) VolumeMountOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VolumeMountOutput)
+}
+
+// VolumeMountArrayInput is an input type that accepts VolumeMountArray and VolumeMountArrayOutput values.
+// You can construct a concrete instance of `VolumeMountArrayInput` via:
+//
+//	VolumeMountArray{ VolumeMountArgs{...} }
+type VolumeMountArrayInput interface {
+	pulumi.Input
+
+	ToVolumeMountArrayOutput() VolumeMountArrayOutput
+	ToVolumeMountArrayOutputWithContext(context.Context) VolumeMountArrayOutput
+}
+
+type VolumeMountArray []VolumeMountInput
+
+func (VolumeMountArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]VolumeMount)(nil)).Elem()
+}
+
+func (i VolumeMountArray) ToVolumeMountArrayOutput() VolumeMountArrayOutput {
+	return i.ToVolumeMountArrayOutputWithContext(context.Background())
+}
+
+func (i VolumeMountArray) ToVolumeMountArrayOutputWithContext(ctx context.Context) VolumeMountArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VolumeMountArrayOutput)
+}
+
+// Mounts one of the application's volumes into its container.
+type VolumeMountOutput struct{ *pulumi.OutputState }
+
+func (VolumeMountOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*VolumeMount)(nil)).Elem()
+}
+
+func (o VolumeMountOutput) ToVolumeMountOutput() VolumeMountOutput {
+	return o
+}
+
+func (o VolumeMountOutput) ToVolumeMountOutputWithContext(ctx context.Context) VolumeMountOutput {
+	return o
+}
+
+// Where to mount the volume
+func (o VolumeMountOutput) MountPath() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeMount) string { return v.MountPath }).(pulumi.StringOutput)
+}
+
+// The name of the volume to mount
+func (o VolumeMountOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeMount) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// Whether to mount the volume read-only
+func (o VolumeMountOutput) ReadOnly() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v VolumeMount) *bool { return v.ReadOnly }).(pulumi.BoolPtrOutput)
+}
+
+// A path within the volume to mount instead of its root
+func (o VolumeMountOutput) SubPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v VolumeMount) *string { return v.SubPath }).(pulumi.StringPtrOutput)
+}
+
+type VolumeMountArrayOutput struct{ *pulumi.OutputState }
+
+func (VolumeMountArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]VolumeMount)(nil)).Elem()
+}
+
+func (o VolumeMountArrayOutput) ToVolumeMountArrayOutput() VolumeMountArrayOutput {
+	return o
+}
+
+func (o VolumeMountArrayOutput) ToVolumeMountArrayOutputWithContext(ctx context.Context) VolumeMountArrayOutput {
+	return o
+}
+
+func (o VolumeMountArrayOutput) Index(i pulumi.IntInput) VolumeMountOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) VolumeMount {
+		return vs[0].([]VolumeMount)[vs[1].(int)]
+	}).(VolumeMountOutput)
+}
+
+// Where the application runs in one cluster.
+type Workload struct {
+	// The name of the cluster, when the application is deployed to several
+	Cluster *string `pulumi:"cluster"`
+	// The kubeconfig context the application was deployed with
+	Context *string `pulumi:"context"`
+	// The name of the application's Deployment
+	Deployment string `pulumi:"deployment"`
+	// The contents of the kubeconfig file, or the path to it, the application was deployed with
+	Kubeconfig *string `pulumi:"kubeconfig"`
+	// The namespace the application runs in
+	Namespace string `pulumi:"namespace"`
+	// The URL of the application's Service
+	Url string `pulumi:"url"`
+}
+
+// Where the application runs in one cluster.
+type WorkloadOutput struct{ *pulumi.OutputState }
+
+func (WorkloadOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Workload)(nil)).Elem()
+}
+
+func (o WorkloadOutput) ToWorkloadOutput() WorkloadOutput {
+	return o
+}
+
+func (o WorkloadOutput) ToWorkloadOutputWithContext(ctx context.Context) WorkloadOutput {
+	return o
+}
+
+// The name of the cluster, when the application is deployed to several
+func (o WorkloadOutput) Cluster() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Workload) *string { return v.Cluster }).(pulumi.StringPtrOutput)
+}
+
+// The kubeconfig context the application was deployed with
+func (o WorkloadOutput) Context() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Workload) *string { return v.Context }).(pulumi.StringPtrOutput)
+}
+
+// The name of the application's Deployment
+func (o WorkloadOutput) Deployment() pulumi.StringOutput {
+	return o.ApplyT(func(v Workload) string { return v.Deployment }).(pulumi.StringOutput)
+}
+
+// The contents of the kubeconfig file, or the path to it, the application was deployed with
+func (o WorkloadOutput) Kubeconfig() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Workload) *string { return v.Kubeconfig }).(pulumi.StringPtrOutput)
+}
+
+// The namespace the application runs in
+func (o WorkloadOutput) Namespace() pulumi.StringOutput {
+	return o.ApplyT(func(v Workload) string { return v.Namespace }).(pulumi.StringOutput)
+}
+
+// The URL of the application's Service
+func (o WorkloadOutput) Url() pulumi.StringOutput {
+	return o.ApplyT(func(v Workload) string { return v.Url }).(pulumi.StringOutput)
+}
+
+type WorkloadArrayOutput struct{ *pulumi.OutputState }
+
+func (WorkloadArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Workload)(nil)).Elem()
+}
+
+func (o WorkloadArrayOutput) ToWorkloadArrayOutput() WorkloadArrayOutput {
+	return o
+}
+
+func (o WorkloadArrayOutput) ToWorkloadArrayOutputWithContext(ctx context.Context) WorkloadArrayOutput {
+	return o
+}
+
+func (o WorkloadArrayOutput) Index(i pulumi.IntInput) WorkloadOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Workload {
+		return vs[0].([]Workload)[vs[1].(int)]
+	}).(WorkloadOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoscalingInput)(nil)).Elem(), AutoscalingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AutoscalingPtrInput)(nil)).Elem(), AutoscalingArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AwaitInput)(nil)).Elem(), AwaitArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*AwaitPtrInput)(nil)).Elem(), AwaitArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CacheConnectionInput)(nil)).Elem(), CacheConnectionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CacheConnectionPtrInput)(nil)).Elem(), CacheConnectionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ClusterInput)(nil)).Elem(), ClusterArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ClusterArrayInput)(nil)).Elem(), ClusterArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapVolumeInput)(nil)).Elem(), ConfigMapVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ConfigMapVolumePtrInput)(nil)).Elem(), ConfigMapVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseConnectionInput)(nil)).Elem(), DatabaseConnectionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*DatabaseConnectionPtrInput)(nil)).Elem(), DatabaseConnectionArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EmptyDirVolumeInput)(nil)).Elem(), EmptyDirVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*EmptyDirVolumePtrInput)(nil)).Elem(), EmptyDirVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HostPathVolumeInput)(nil)).Elem(), HostPathVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HostPathVolumePtrInput)(nil)).Elem(), HostPathVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*IngressInput)(nil)).Elem(), IngressArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*IngressPtrInput)(nil)).Elem(), IngressArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PatchInput)(nil)).Elem(), PatchArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PatchMapInput)(nil)).Elem(), PatchMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PreDeployJobInput)(nil)).Elem(), PreDeployJobArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PreDeployJobPtrInput)(nil)).Elem(), PreDeployJobArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProbeInput)(nil)).Elem(), ProbeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ProbePtrInput)(nil)).Elem(), ProbeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*QuotaInput)(nil)).Elem(), QuotaArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*QuotaPtrInput)(nil)).Elem(), QuotaArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RegistryCredentialsInput)(nil)).Elem(), RegistryCredentialsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*RegistryCredentialsPtrInput)(nil)).Elem(), RegistryCredentialsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ResourcesInput)(nil)).Elem(), ResourcesArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ResourcesPtrInput)(nil)).Elem(), ResourcesArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretVolumeInput)(nil)).Elem(), SecretVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SecretVolumePtrInput)(nil)).Elem(), SecretVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ShutdownInput)(nil)).Elem(), ShutdownArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ShutdownPtrInput)(nil)).Elem(), ShutdownArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestInput)(nil)).Elem(), SmokeTestArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SmokeTestPtrInput)(nil)).Elem(), SmokeTestArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VolumeInput)(nil)).Elem(), VolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VolumeArrayInput)(nil)).Elem(), VolumeArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VolumeMountInput)(nil)).Elem(), VolumeMountArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VolumeMountArrayInput)(nil)).Elem(), VolumeMountArray{})
+	pulumi.RegisterOutputType(AutoscalingOutput{})
+	pulumi.RegisterOutputType(AutoscalingPtrOutput{})
+	pulumi.RegisterOutputType(AwaitOutput{})
+	pulumi.RegisterOutputType(AwaitPtrOutput{})
+	pulumi.RegisterOutputType(CacheConnectionOutput{})
+	pulumi.RegisterOutputType(CacheConnectionPtrOutput{})
+	pulumi.RegisterOutputType(ClusterOutput{})
+	pulumi.RegisterOutputType(ClusterArrayOutput{})
+	pulumi.RegisterOutputType(ConfigMapVolumeOutput{})
+	pulumi.RegisterOutputType(ConfigMapVolumePtrOutput{})
+	pulumi.RegisterOutputType(DatabaseConnectionOutput{})
+	pulumi.RegisterOutputType(DatabaseConnectionPtrOutput{})
+	pulumi.RegisterOutputType(EmptyDirVolumeOutput{})
+	pulumi.RegisterOutputType(EmptyDirVolumePtrOutput{})
+	pulumi.RegisterOutputType(HostPathVolumeOutput{})
+	pulumi.RegisterOutputType(HostPathVolumePtrOutput{})
+	pulumi.RegisterOutputType(IngressOutput{})
+	pulumi.RegisterOutputType(IngressPtrOutput{})
+	pulumi.RegisterOutputType(KubectlCommandsOutput{})
+	pulumi.RegisterOutputType(KubectlCommandsArrayOutput{})
+	pulumi.RegisterOutputType(PatchOutput{})
+	pulumi.RegisterOutputType(PatchMapOutput{})
+	pulumi.RegisterOutputType(PreDeployJobOutput{})
+	pulumi.RegisterOutputType(PreDeployJobPtrOutput{})
+	pulumi.RegisterOutputType(ProbeOutput{})
+	pulumi.RegisterOutputType(ProbePtrOutput{})
+	pulumi.RegisterOutputType(QuotaOutput{})
+	pulumi.RegisterOutputType(QuotaPtrOutput{})
+	pulumi.RegisterOutputType(RegistryCredentialsOutput{})
+	pulumi.RegisterOutputType(RegistryCredentialsPtrOutput{})
+	pulumi.RegisterOutputType(ReplicaStatusOutput{})
+	pulumi.RegisterOutputType(ReplicaStatusMapOutput{})
+	pulumi.RegisterOutputType(ResourcesOutput{})
+	pulumi.RegisterOutputType(ResourcesPtrOutput{})
+	pulumi.RegisterOutputType(SecretVolumeOutput{})
+	pulumi.RegisterOutputType(SecretVolumePtrOutput{})
+	pulumi.RegisterOutputType(ShutdownOutput{})
+	pulumi.RegisterOutputType(ShutdownPtrOutput{})
+	pulumi.RegisterOutputType(SmokeTestOutput{})
+	pulumi.RegisterOutputType(SmokeTestPtrOutput{})
+	pulumi.RegisterOutputType(VolumeOutput{})
+	pulumi.RegisterOutputType(VolumeArrayOutput{})
+	pulumi.RegisterOutputType(VolumeMountOutput{})
+	pulumi.RegisterOutputType(VolumeMountArrayOutput{})
+	pulumi.RegisterOutputType(WorkloadOutput{})
+	pulumi.RegisterOutputType(WorkloadArrayOutput{})
+}
@@ -0,0 +1,204 @@
+// Code generated by Pulumi SDK Generator DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package productionapp
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Checks a Deployment's name and inputs without creating anything, applying the provider's configuration as constructing it would.
+func ValidateArgs(ctx *pulumi.Context, args *ValidateArgsArgs, opts ...pulumi.InvokeOption) (*ValidateArgsResult, error) {
+	var rv ValidateArgsResult
+	err := ctx.Invoke("productionapp:index:validateArgs", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type ValidateArgsArgs struct {
+	// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+	AllowHostPath *bool `pulumi:"allowHostPath"`
+	// Annotations added to every resource the component creates
+	Annotations map[string]string `pulumi:"annotations"`
+	// Overrides the arguments of the application image
+	Args []string `pulumi:"args"`
+	// Scales the application with a HorizontalPodAutoscaler
+	Autoscaling *Autoscaling `pulumi:"autoscaling"`
+	// How long to wait for the application to become ready
+	Await *Await `pulumi:"await"`
+	// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+	Cache *CacheConnection `pulumi:"cache"`
+	// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+	Clusters []Cluster `pulumi:"clusters"`
+	// Overrides the entrypoint of the application image
+	Command []string `pulumi:"command"`
+	// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+	Context *string `pulumi:"context"`
+	// A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+	Database *DatabaseConnection `pulumi:"database"`
+	// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+	Environment *Environment `pulumi:"environment"`
+	// The image to deploy in your production application
+	Image *string `pulumi:"image"`
+	// An Ingress that routes a host name to the application. The url becomes the Ingress's
+	Ingress *Ingress `pulumi:"ingress"`
+	// The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+	Kubeconfig *string `pulumi:"kubeconfig"`
+	// Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+	Labels map[string]string `pulumi:"labels"`
+	// An HTTP check that restarts the application's container when it fails
+	LivenessProbe *Probe `pulumi:"livenessProbe"`
+	// The name the Deployment would be given
+	Name string `pulumi:"name"`
+	// Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+	Patches map[string]Patch `pulumi:"patches"`
+	// Annotations added to the application's pods
+	PodAnnotations map[string]string `pulumi:"podAnnotations"`
+	// The port your container listens on
+	Port *int `pulumi:"port"`
+	// A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+	PreDeployJob *PreDeployJob `pulumi:"preDeployJob"`
+	// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+	Quota *Quota `pulumi:"quota"`
+	// An HTTP check that must pass before the application's pods receive traffic
+	ReadinessProbe *Probe `pulumi:"readinessProbe"`
+	// Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+	RegistryCredentials *RegistryCredentials `pulumi:"registryCredentials"`
+	// Resource requests and limits for the application's container, overriding the ones the environment or size sets
+	Resources *Resources `pulumi:"resources"`
+	// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+	SanitizeName *bool `pulumi:"sanitizeName"`
+	// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+	ServiceType *ServiceType `pulumi:"serviceType"`
+	// How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+	Shutdown *Shutdown `pulumi:"shutdown"`
+	// The resource preset for the application's container, replacing the environment's resources
+	Size *Size `pulumi:"size"`
+	// An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+	SmokeTest *SmokeTest `pulumi:"smokeTest"`
+	// Where to mount `volumes` in the application container
+	VolumeMounts []VolumeMount `pulumi:"volumeMounts"`
+	// Volumes available to the application container
+	Volumes []Volume `pulumi:"volumes"`
+	// The working directory of the application container
+	WorkingDir *string `pulumi:"workingDir"`
+}
+
+type ValidateArgsResult struct {
+	// Every problem with the inputs, naming the offending input. Empty if they are valid
+	Problems []string `pulumi:"problems"`
+}
+
+func ValidateArgsOutput(ctx *pulumi.Context, args ValidateArgsOutputArgs, opts ...pulumi.InvokeOption) ValidateArgsResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (ValidateArgsResult, error) {
+			args := v.(ValidateArgsArgs)
+			r, err := ValidateArgs(ctx, &args, opts...)
+			var s ValidateArgsResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(ValidateArgsResultOutput)
+}
+
+type ValidateArgsOutputArgs struct {
+	// Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+	AllowHostPath pulumi.BoolPtrInput `pulumi:"allowHostPath"`
+	// Annotations added to every resource the component creates
+	Annotations pulumi.StringMapInput `pulumi:"annotations"`
+	// Overrides the arguments of the application image
+	Args pulumi.StringArrayInput `pulumi:"args"`
+	// Scales the application with a HorizontalPodAutoscaler
+	Autoscaling AutoscalingPtrInput `pulumi:"autoscaling"`
+	// How long to wait for the application to become ready
+	Await AwaitPtrInput `pulumi:"await"`
+	// A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+	Cache CacheConnectionPtrInput `pulumi:"cache"`
+	// Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+	Clusters ClusterArrayInput `pulumi:"clusters"`
+	// Overrides the entrypoint of the application image
+	Command pulumi.StringArrayInput `pulumi:"command"`
+	// The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+	Context pulumi.StringPtrInput `pulumi:"context"`
+	// A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+	Database DatabaseConnectionPtrInput `pulumi:"database"`
+	// The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+	Environment EnvironmentPtrInput `pulumi:"environment"`
+	// The image to deploy in your production application
+	Image pulumi.StringPtrInput `pulumi:"image"`
+	// An Ingress that routes a host name to the application. The url becomes the Ingress's
+	Ingress IngressPtrInput `pulumi:"ingress"`
+	// The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+	Kubeconfig pulumi.StringPtrInput `pulumi:"kubeconfig"`
+	// Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+	Labels pulumi.StringMapInput `pulumi:"labels"`
+	// An HTTP check that restarts the application's container when it fails
+	LivenessProbe ProbePtrInput `pulumi:"livenessProbe"`
+	// The name the Deployment would be given
+	Name pulumi.StringInput `pulumi:"name"`
+	// Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+	Patches PatchMapInput `pulumi:"patches"`
+	// Annotations added to the application's pods
+	PodAnnotations pulumi.StringMapInput `pulumi:"podAnnotations"`
+	// The port your container listens on
+	Port pulumi.IntPtrInput `pulumi:"port"`
+	// A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+	PreDeployJob PreDeployJobPtrInput `pulumi:"preDeployJob"`
+	// Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+	Quota QuotaPtrInput `pulumi:"quota"`
+	// An HTTP check that must pass before the application's pods receive traffic
+	ReadinessProbe ProbePtrInput `pulumi:"readinessProbe"`
+	// Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+	RegistryCredentials RegistryCredentialsPtrInput `pulumi:"registryCredentials"`
+	// Resource requests and limits for the application's container, overriding the ones the environment or size sets
+	Resources ResourcesPtrInput `pulumi:"resources"`
+	// Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+	SanitizeName pulumi.BoolPtrInput `pulumi:"sanitizeName"`
+	// The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+	ServiceType ServiceTypePtrInput `pulumi:"serviceType"`
+	// How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+	Shutdown ShutdownPtrInput `pulumi:"shutdown"`
+	// The resource preset for the application's container, replacing the environment's resources
+	Size SizePtrInput `pulumi:"size"`
+	// An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+	SmokeTest SmokeTestPtrInput `pulumi:"smokeTest"`
+	// Where to mount `volumes` in the application container
+	VolumeMounts VolumeMountArrayInput `pulumi:"volumeMounts"`
+	// Volumes available to the application container
+	Volumes VolumeArrayInput `pulumi:"volumes"`
+	// The working directory of the application container
+	WorkingDir pulumi.StringPtrInput `pulumi:"workingDir"`
+}
+
+func (ValidateArgsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ValidateArgsArgs)(nil)).Elem()
+}
+
+type ValidateArgsResultOutput struct{ *pulumi.OutputState }
+
+func (ValidateArgsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ValidateArgsResult)(nil)).Elem()
+}
+
+func (o ValidateArgsResultOutput) ToValidateArgsResultOutput() ValidateArgsResultOutput {
+	return o
+}
+
+func (o ValidateArgsResultOutput) ToValidateArgsResultOutputWithContext(ctx context.Context) ValidateArgsResultOutput {
+	return o
+}
+
+// Every problem with the inputs, naming the offending input. Empty if they are valid
+func (o ValidateArgsResultOutput) Problems() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ValidateArgsResult) []string { return v.Problems }).(pulumi.StringArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(ValidateArgsResultOutput{})
+}
@@ -0,0 +1,117 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.jaxxstorm.productionapp.CacheArgs;
+import com.jaxxstorm.productionapp.Utilities;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+/**
+ * A Redis cache running in the cluster.
+ * 
+ */
+@ResourceType(type="productionapp:index:Cache")
+public class Cache extends com.pulumi.resources.ComponentResource {
+    /**
+     * A redis:// connection string for the cache
+     * 
+     */
+    @Export(name="connectionString", type=String.class, parameters={})
+    private Output<String> connectionString;
+
+    /**
+     * @return A redis:// connection string for the cache
+     * 
+     */
+    public Output<String> connectionString() {
+        return this.connectionString;
+    }
+    /**
+     * The in-cluster hostname of the cache
+     * 
+     */
+    @Export(name="host", type=String.class, parameters={})
+    private Output<String> host;
+
+    /**
+     * @return The in-cluster hostname of the cache
+     * 
+     */
+    public Output<String> host() {
+        return this.host;
+    }
+    /**
+     * The generated password for the cache, when authentication is enabled
+     * 
+     */
+    @Export(name="password", type=String.class, parameters={})
+    private Output</* @Nullable */ String> password;
+
+    /**
+     * @return The generated password for the cache, when authentication is enabled
+     * 
+     */
+    public Output<Optional<String>> password() {
+        return Codegen.optional(this.password);
+    }
+    /**
+     * The port the cache listens on
+     * 
+     */
+    @Export(name="port", type=Integer.class, parameters={})
+    private Output<Integer> port;
+
+    /**
+     * @return The port the cache listens on
+     * 
+     */
+    public Output<Integer> port() {
+        return this.port;
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public Cache(String name) {
+        this(name, CacheArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public Cache(String name, @Nullable CacheArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public Cache(String name, @Nullable CacheArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        super("productionapp:index:Cache", name, args == null ? CacheArgs.Empty : args, makeResourceOptions(options, Codegen.empty()), true);
+    }
+
+    private static com.pulumi.resources.ComponentResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.ComponentResourceOptions options, @Nullable Output<String> id) {
+        var defaultOptions = com.pulumi.resources.ComponentResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .additionalSecretOutputs(List.of(
+                "connectionString",
+                "password"
+            ))
+            .build();
+        return com.pulumi.resources.ComponentResourceOptions.merge(defaultOptions, options, id);
+    }
+
+}
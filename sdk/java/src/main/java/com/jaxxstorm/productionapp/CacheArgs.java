@@ -0,0 +1,143 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.jaxxstorm.productionapp.enums.Size;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.core.internal.Codegen;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class CacheArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final CacheArgs Empty = new CacheArgs();
+
+    /**
+     * Whether to require a generated password to connect to the cache
+     * 
+     */
+    @Import(name="enableAuth")
+    private @Nullable Boolean enableAuth;
+
+    /**
+     * @return Whether to require a generated password to connect to the cache
+     * 
+     */
+    public Optional<Boolean> enableAuth() {
+        return Optional.ofNullable(this.enableAuth);
+    }
+
+    /**
+     * The Redis image to run
+     * 
+     */
+    @Import(name="image")
+    private @Nullable Output<String> image;
+
+    /**
+     * @return The Redis image to run
+     * 
+     */
+    public Optional<Output<String>> image() {
+        return Optional.ofNullable(this.image);
+    }
+
+    /**
+     * The resource preset for the cache
+     * 
+     */
+    @Import(name="size")
+    private @Nullable Size size;
+
+    /**
+     * @return The resource preset for the cache
+     * 
+     */
+    public Optional<Size> size() {
+        return Optional.ofNullable(this.size);
+    }
+
+    private CacheArgs() {}
+
+    private CacheArgs(CacheArgs $) {
+        this.enableAuth = $.enableAuth;
+        this.image = $.image;
+        this.size = $.size;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(CacheArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private CacheArgs $;
+
+        public Builder() {
+            $ = new CacheArgs();
+        }
+
+        public Builder(CacheArgs defaults) {
+            $ = new CacheArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param enableAuth Whether to require a generated password to connect to the cache
+         * 
+         * @return builder
+         * 
+         */
+        public Builder enableAuth(@Nullable Boolean enableAuth) {
+            $.enableAuth = enableAuth;
+            return this;
+        }
+
+        /**
+         * @param image The Redis image to run
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(@Nullable Output<String> image) {
+            $.image = image;
+            return this;
+        }
+
+        /**
+         * @param image The Redis image to run
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(String image) {
+            return image(Output.of(image));
+        }
+
+        /**
+         * @param size The resource preset for the cache
+         * 
+         * @return builder
+         * 
+         */
+        public Builder size(@Nullable Size size) {
+            $.size = size;
+            return this;
+        }
+
+        public CacheArgs build() {
+            $.enableAuth = Codegen.booleanProp("enableAuth").arg($.enableAuth).def(false).getNullable();
+            $.image = Codegen.stringProp("image").output().arg($.image).def("redis:6").getNullable();
+            $.size = Codegen.objectProp("size", Size.class).arg($.size).def(Size.Small).getNullable();
+            return $;
+        }
+    }
+
+}
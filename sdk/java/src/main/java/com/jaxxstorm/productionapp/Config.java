@@ -0,0 +1,36 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.pulumi.core.TypeShape;
+import com.pulumi.core.internal.Codegen;
+import java.lang.String;
+import java.util.Map;
+import java.util.Optional;
+
+public final class Config {
+
+    private static final com.pulumi.Config config = com.pulumi.Config.of("productionapp");
+/**
+ * Labels added to every Deployment&#39;s resources. Labels set on a Deployment take precedence
+ * 
+ */
+    public Optional<Map<String,String>> defaultLabels() {
+        return Codegen.objectProp("defaultLabels", TypeShape.<Map<String,String>>builder(Map.class).addParameter(String.class).addParameter(String.class).build()).config(config).get();
+    }
+/**
+ * A registry to pull images that don&#39;t name one from, such as `registry.example.com/mirror`
+ * 
+ */
+    public Optional<String> defaultRegistry() {
+        return Codegen.stringProp("defaultRegistry").config(config).get();
+    }
+/**
+ * The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+ * 
+ */
+    public Optional<String> environment() {
+        return Codegen.stringProp("environment").config(config).get();
+    }
+}
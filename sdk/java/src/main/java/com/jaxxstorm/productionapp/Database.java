@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.jaxxstorm.productionapp.DatabaseArgs;
+import com.jaxxstorm.productionapp.Utilities;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Export;
+import com.pulumi.core.annotations.ResourceType;
+import com.pulumi.core.internal.Codegen;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import javax.annotation.Nullable;
+
+/**
+ * A Postgres database running in the cluster, intended for development environments.
+ * 
+ */
+@ResourceType(type="productionapp:index:Database")
+public class Database extends com.pulumi.resources.ComponentResource {
+    /**
+     * A postgresql:// connection string for the database
+     * 
+     */
+    @Export(name="connectionString", type=String.class, parameters={})
+    private Output<String> connectionString;
+
+    /**
+     * @return A postgresql:// connection string for the database
+     * 
+     */
+    public Output<String> connectionString() {
+        return this.connectionString;
+    }
+    /**
+     * The name of the database
+     * 
+     */
+    @Export(name="databaseName", type=String.class, parameters={})
+    private Output<String> databaseName;
+
+    /**
+     * @return The name of the database
+     * 
+     */
+    public Output<String> databaseName() {
+        return this.databaseName;
+    }
+    /**
+     * The in-cluster hostname of the database
+     * 
+     */
+    @Export(name="host", type=String.class, parameters={})
+    private Output<String> host;
+
+    /**
+     * @return The in-cluster hostname of the database
+     * 
+     */
+    public Output<String> host() {
+        return this.host;
+    }
+    /**
+     * The generated password for the database user
+     * 
+     */
+    @Export(name="password", type=String.class, parameters={})
+    private Output<String> password;
+
+    /**
+     * @return The generated password for the database user
+     * 
+     */
+    public Output<String> password() {
+        return this.password;
+    }
+    /**
+     * The port the database listens on
+     * 
+     */
+    @Export(name="port", type=Integer.class, parameters={})
+    private Output<Integer> port;
+
+    /**
+     * @return The port the database listens on
+     * 
+     */
+    public Output<Integer> port() {
+        return this.port;
+    }
+    /**
+     * The name of the database user
+     * 
+     */
+    @Export(name="username", type=String.class, parameters={})
+    private Output<String> username;
+
+    /**
+     * @return The name of the database user
+     * 
+     */
+    public Output<String> username() {
+        return this.username;
+    }
+
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     */
+    public Database(String name) {
+        this(name, DatabaseArgs.Empty);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     */
+    public Database(String name, @Nullable DatabaseArgs args) {
+        this(name, args, null);
+    }
+    /**
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param options A bag of options that control this resource's behavior.
+     */
+    public Database(String name, @Nullable DatabaseArgs args, @Nullable com.pulumi.resources.ComponentResourceOptions options) {
+        super("productionapp:index:Database", name, args == null ? DatabaseArgs.Empty : args, makeResourceOptions(options, Codegen.empty()), true);
+    }
+
+    private static com.pulumi.resources.ComponentResourceOptions makeResourceOptions(@Nullable com.pulumi.resources.ComponentResourceOptions options, @Nullable Output<String> id) {
+        var defaultOptions = com.pulumi.resources.ComponentResourceOptions.builder()
+            .version(Utilities.getVersion())
+            .additionalSecretOutputs(List.of(
+                "connectionString",
+                "password"
+            ))
+            .build();
+        return com.pulumi.resources.ComponentResourceOptions.merge(defaultOptions, options, id);
+    }
+
+}
@@ -0,0 +1,236 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.core.internal.Codegen;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class DatabaseArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final DatabaseArgs Empty = new DatabaseArgs();
+
+    /**
+     * The name of the database to create
+     * 
+     */
+    @Import(name="databaseName")
+    private @Nullable Output<String> databaseName;
+
+    /**
+     * @return The name of the database to create
+     * 
+     */
+    public Optional<Output<String>> databaseName() {
+        return Optional.ofNullable(this.databaseName);
+    }
+
+    /**
+     * The Postgres image to run
+     * 
+     */
+    @Import(name="image")
+    private @Nullable Output<String> image;
+
+    /**
+     * @return The Postgres image to run
+     * 
+     */
+    public Optional<Output<String>> image() {
+        return Optional.ofNullable(this.image);
+    }
+
+    /**
+     * The storage class for the persistent volume. Uses the cluster default when unset
+     * 
+     */
+    @Import(name="storageClassName")
+    private @Nullable Output<String> storageClassName;
+
+    /**
+     * @return The storage class for the persistent volume. Uses the cluster default when unset
+     * 
+     */
+    public Optional<Output<String>> storageClassName() {
+        return Optional.ofNullable(this.storageClassName);
+    }
+
+    /**
+     * The size of the persistent volume backing the database
+     * 
+     */
+    @Import(name="storageSize")
+    private @Nullable Output<String> storageSize;
+
+    /**
+     * @return The size of the persistent volume backing the database
+     * 
+     */
+    public Optional<Output<String>> storageSize() {
+        return Optional.ofNullable(this.storageSize);
+    }
+
+    /**
+     * The name of the database user to create
+     * 
+     */
+    @Import(name="username")
+    private @Nullable Output<String> username;
+
+    /**
+     * @return The name of the database user to create
+     * 
+     */
+    public Optional<Output<String>> username() {
+        return Optional.ofNullable(this.username);
+    }
+
+    private DatabaseArgs() {}
+
+    private DatabaseArgs(DatabaseArgs $) {
+        this.databaseName = $.databaseName;
+        this.image = $.image;
+        this.storageClassName = $.storageClassName;
+        this.storageSize = $.storageSize;
+        this.username = $.username;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(DatabaseArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private DatabaseArgs $;
+
+        public Builder() {
+            $ = new DatabaseArgs();
+        }
+
+        public Builder(DatabaseArgs defaults) {
+            $ = new DatabaseArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param databaseName The name of the database to create
+         * 
+         * @return builder
+         * 
+         */
+        public Builder databaseName(@Nullable Output<String> databaseName) {
+            $.databaseName = databaseName;
+            return this;
+        }
+
+        /**
+         * @param databaseName The name of the database to create
+         * 
+         * @return builder
+         * 
+         */
+        public Builder databaseName(String databaseName) {
+            return databaseName(Output.of(databaseName));
+        }
+
+        /**
+         * @param image The Postgres image to run
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(@Nullable Output<String> image) {
+            $.image = image;
+            return this;
+        }
+
+        /**
+         * @param image The Postgres image to run
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(String image) {
+            return image(Output.of(image));
+        }
+
+        /**
+         * @param storageClassName The storage class for the persistent volume. Uses the cluster default when unset
+         * 
+         * @return builder
+         * 
+         */
+        public Builder storageClassName(@Nullable Output<String> storageClassName) {
+            $.storageClassName = storageClassName;
+            return this;
+        }
+
+        /**
+         * @param storageClassName The storage class for the persistent volume. Uses the cluster default when unset
+         * 
+         * @return builder
+         * 
+         */
+        public Builder storageClassName(String storageClassName) {
+            return storageClassName(Output.of(storageClassName));
+        }
+
+        /**
+         * @param storageSize The size of the persistent volume backing the database
+         * 
+         * @return builder
+         * 
+         */
+        public Builder storageSize(@Nullable Output<String> storageSize) {
+            $.storageSize = storageSize;
+            return this;
+        }
+
+        /**
+         * @param storageSize The size of the persistent volume backing the database
+         * 
+         * @return builder
+         * 
+         */
+        public Builder storageSize(String storageSize) {
+            return storageSize(Output.of(storageSize));
+        }
+
+        /**
+         * @param username The name of the database user to create
+         * 
+         * @return builder
+         * 
+         */
+        public Builder username(@Nullable Output<String> username) {
+            $.username = username;
+            return this;
+        }
+
+        /**
+         * @param username The name of the database user to create
+         * 
+         * @return builder
+         * 
+         */
+        public Builder username(String username) {
+            return username(Output.of(username));
+        }
+
+        public DatabaseArgs build() {
+            $.databaseName = Codegen.stringProp("databaseName").output().arg($.databaseName).def("app").getNullable();
+            $.image = Codegen.stringProp("image").output().arg($.image).def("postgres:14").getNullable();
+            $.storageSize = Codegen.stringProp("storageSize").output().arg($.storageSize).def("1Gi").getNullable();
+            $.username = Codegen.stringProp("username").output().arg($.username).def("app").getNullable();
+            return $;
+        }
+    }
+
+}
@@ -1,33 +1,68 @@
 // *** WARNING: this file was generated by Pulumi SDK Generator. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
-package com.pulumi.productionapp;
+package com.jaxxstorm.productionapp;
 
+import com.jaxxstorm.productionapp.DeploymentArgs;
+import com.jaxxstorm.productionapp.Utilities;
+import com.jaxxstorm.productionapp.outputs.Workload;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.Export;
 import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
-import com.pulumi.productionapp.DeploymentArgs;
-import com.pulumi.productionapp.Utilities;
 import java.lang.String;
+import java.util.List;
+import java.util.Map;
 import javax.annotation.Nullable;
 
+/**
+ * An application deployed to Kubernetes in a namespace of its own, behind a Service, with the availability settings its environment calls for.
+ * 
+ */
 @ResourceType(type="productionapp:index:Deployment")
 public class Deployment extends com.pulumi.resources.ComponentResource {
     /**
-     * The URL from the generated service
+     * The URL from the generated service, in the first cluster when deployed to several
      * 
      */
     @Export(name="url", type=String.class, parameters={})
     private Output<String> url;
 
     /**
-     * @return The URL from the generated service
+     * @return The URL from the generated service, in the first cluster when deployed to several
      * 
      */
     public Output<String> url() {
         return this.url;
     }
+    /**
+     * The URL of the application in each cluster, keyed by cluster name
+     * 
+     */
+    @Export(name="urls", type=Map.class, parameters={String.class, String.class})
+    private Output<Map<String,String>> urls;
+
+    /**
+     * @return The URL of the application in each cluster, keyed by cluster name
+     * 
+     */
+    public Output<Map<String,String>> urls() {
+        return this.urls;
+    }
+    /**
+     * Where the application runs, one workload per cluster
+     * 
+     */
+    @Export(name="workloads", type=List.class, parameters={Workload.class})
+    private Output<List<Workload>> workloads;
+
+    /**
+     * @return Where the application runs, one workload per cluster
+     * 
+     */
+    public Output<List<Workload>> workloads() {
+        return this.workloads;
+    }
 
     /**
      *
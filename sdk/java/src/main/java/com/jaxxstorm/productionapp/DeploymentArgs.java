@@ -0,0 +1,1088 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.jaxxstorm.productionapp.enums.Environment;
+import com.jaxxstorm.productionapp.enums.ServiceType;
+import com.jaxxstorm.productionapp.enums.Size;
+import com.jaxxstorm.productionapp.inputs.AutoscalingArgs;
+import com.jaxxstorm.productionapp.inputs.AwaitArgs;
+import com.jaxxstorm.productionapp.inputs.CacheConnectionArgs;
+import com.jaxxstorm.productionapp.inputs.ClusterArgs;
+import com.jaxxstorm.productionapp.inputs.DatabaseConnectionArgs;
+import com.jaxxstorm.productionapp.inputs.IngressArgs;
+import com.jaxxstorm.productionapp.inputs.PatchArgs;
+import com.jaxxstorm.productionapp.inputs.PreDeployJobArgs;
+import com.jaxxstorm.productionapp.inputs.ProbeArgs;
+import com.jaxxstorm.productionapp.inputs.QuotaArgs;
+import com.jaxxstorm.productionapp.inputs.RegistryCredentialsArgs;
+import com.jaxxstorm.productionapp.inputs.ResourcesArgs;
+import com.jaxxstorm.productionapp.inputs.ShutdownArgs;
+import com.jaxxstorm.productionapp.inputs.SmokeTestArgs;
+import com.jaxxstorm.productionapp.inputs.VolumeArgs;
+import com.jaxxstorm.productionapp.inputs.VolumeMountArgs;
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import com.pulumi.core.internal.Codegen;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class DeploymentArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final DeploymentArgs Empty = new DeploymentArgs();
+
+    /**
+     * Whether `hostPath` volumes are allowed. They expose the node&#39;s filesystem and are denied by default
+     * 
+     */
+    @Import(name="allowHostPath")
+    private @Nullable Boolean allowHostPath;
+
+    /**
+     * @return Whether `hostPath` volumes are allowed. They expose the node&#39;s filesystem and are denied by default
+     * 
+     */
+    public Optional<Boolean> allowHostPath() {
+        return Optional.ofNullable(this.allowHostPath);
+    }
+
+    /**
+     * Annotations added to every resource the component creates
+     * 
+     */
+    @Import(name="annotations")
+    private @Nullable Map<String,String> annotations;
+
+    /**
+     * @return Annotations added to every resource the component creates
+     * 
+     */
+    public Optional<Map<String,String>> annotations() {
+        return Optional.ofNullable(this.annotations);
+    }
+
+    /**
+     * Overrides the arguments of the application image
+     * 
+     */
+    @Import(name="args")
+    private @Nullable Output<List<String>> args;
+
+    /**
+     * @return Overrides the arguments of the application image
+     * 
+     */
+    public Optional<Output<List<String>>> args() {
+        return Optional.ofNullable(this.args);
+    }
+
+    /**
+     * Scales the application with a HorizontalPodAutoscaler
+     * 
+     */
+    @Import(name="autoscaling")
+    private @Nullable AutoscalingArgs autoscaling;
+
+    /**
+     * @return Scales the application with a HorizontalPodAutoscaler
+     * 
+     */
+    public Optional<AutoscalingArgs> autoscaling() {
+        return Optional.ofNullable(this.autoscaling);
+    }
+
+    /**
+     * How long to wait for the application to become ready
+     * 
+     */
+    @Import(name="await")
+    private @Nullable AwaitArgs await;
+
+    /**
+     * @return How long to wait for the application to become ready
+     * 
+     */
+    public Optional<AwaitArgs> await() {
+        return Optional.ofNullable(this.await);
+    }
+
+    /**
+     * A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+     * 
+     */
+    @Import(name="cache")
+    private @Nullable Output<CacheConnectionArgs> cache;
+
+    /**
+     * @return A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+     * 
+     */
+    public Optional<Output<CacheConnectionArgs>> cache() {
+        return Optional.ofNullable(this.cache);
+    }
+
+    /**
+     * Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+     * 
+     */
+    @Import(name="clusters")
+    private @Nullable List<ClusterArgs> clusters;
+
+    /**
+     * @return Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+     * 
+     */
+    public Optional<List<ClusterArgs>> clusters() {
+        return Optional.ofNullable(this.clusters);
+    }
+
+    /**
+     * Overrides the entrypoint of the application image
+     * 
+     */
+    @Import(name="command")
+    private @Nullable Output<List<String>> command;
+
+    /**
+     * @return Overrides the entrypoint of the application image
+     * 
+     */
+    public Optional<Output<List<String>>> command() {
+        return Optional.ofNullable(this.command);
+    }
+
+    /**
+     * The kubeconfig context to create the application&#39;s resources in. Defaults to the kubeconfig&#39;s current context
+     * 
+     */
+    @Import(name="context")
+    private @Nullable Output<String> context;
+
+    /**
+     * @return The kubeconfig context to create the application&#39;s resources in. Defaults to the kubeconfig&#39;s current context
+     * 
+     */
+    public Optional<Output<String>> context() {
+        return Optional.ofNullable(this.context);
+    }
+
+    /**
+     * A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+     * 
+     */
+    @Import(name="database")
+    private @Nullable Output<DatabaseConnectionArgs> database;
+
+    /**
+     * @return A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+     * 
+     */
+    public Optional<Output<DatabaseConnectionArgs>> database() {
+        return Optional.ofNullable(this.database);
+    }
+
+    /**
+     * The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider&#39;s `environment`; without one, three replicas are deployed
+     * 
+     */
+    @Import(name="environment")
+    private @Nullable Environment environment;
+
+    /**
+     * @return The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider&#39;s `environment`; without one, three replicas are deployed
+     * 
+     */
+    public Optional<Environment> environment() {
+        return Optional.ofNullable(this.environment);
+    }
+
+    /**
+     * The image to deploy in your production application
+     * 
+     */
+    @Import(name="image", required=true)
+    private Output<String> image;
+
+    /**
+     * @return The image to deploy in your production application
+     * 
+     */
+    public Output<String> image() {
+        return this.image;
+    }
+
+    /**
+     * An Ingress that routes a host name to the application. The url becomes the Ingress&#39;s
+     * 
+     */
+    @Import(name="ingress")
+    private @Nullable IngressArgs ingress;
+
+    /**
+     * @return An Ingress that routes a host name to the application. The url becomes the Ingress&#39;s
+     * 
+     */
+    public Optional<IngressArgs> ingress() {
+        return Optional.ofNullable(this.ingress);
+    }
+
+    /**
+     * The contents of a kubeconfig file, or the path to one, to create the application&#39;s resources with. Defaults to the ambient Kubernetes provider
+     * 
+     */
+    @Import(name="kubeconfig")
+    private @Nullable Output<String> kubeconfig;
+
+    /**
+     * @return The contents of a kubeconfig file, or the path to one, to create the application&#39;s resources with. Defaults to the ambient Kubernetes provider
+     * 
+     */
+    public Optional<Output<String>> kubeconfig() {
+        return Optional.ofNullable(this.kubeconfig);
+    }
+
+    /**
+     * Labels added to every resource the component creates. The labels that select the application&#39;s pods cannot be overridden
+     * 
+     */
+    @Import(name="labels")
+    private @Nullable Map<String,String> labels;
+
+    /**
+     * @return Labels added to every resource the component creates. The labels that select the application&#39;s pods cannot be overridden
+     * 
+     */
+    public Optional<Map<String,String>> labels() {
+        return Optional.ofNullable(this.labels);
+    }
+
+    /**
+     * An HTTP check that restarts the application&#39;s container when it fails
+     * 
+     */
+    @Import(name="livenessProbe")
+    private @Nullable ProbeArgs livenessProbe;
+
+    /**
+     * @return An HTTP check that restarts the application&#39;s container when it fails
+     * 
+     */
+    public Optional<ProbeArgs> livenessProbe() {
+        return Optional.ofNullable(this.livenessProbe);
+    }
+
+    /**
+     * Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+     * 
+     */
+    @Import(name="patches")
+    private @Nullable Map<String,PatchArgs> patches;
+
+    /**
+     * @return Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+     * 
+     */
+    public Optional<Map<String,PatchArgs>> patches() {
+        return Optional.ofNullable(this.patches);
+    }
+
+    /**
+     * Annotations added to the application&#39;s pods
+     * 
+     */
+    @Import(name="podAnnotations")
+    private @Nullable Map<String,String> podAnnotations;
+
+    /**
+     * @return Annotations added to the application&#39;s pods
+     * 
+     */
+    public Optional<Map<String,String>> podAnnotations() {
+        return Optional.ofNullable(this.podAnnotations);
+    }
+
+    /**
+     * The port your container listens on
+     * 
+     */
+    @Import(name="port", required=true)
+    private Output<Integer> port;
+
+    /**
+     * @return The port your container listens on
+     * 
+     */
+    public Output<Integer> port() {
+        return this.port;
+    }
+
+    /**
+     * A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+     * 
+     */
+    @Import(name="preDeployJob")
+    private @Nullable PreDeployJobArgs preDeployJob;
+
+    /**
+     * @return A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+     * 
+     */
+    public Optional<PreDeployJobArgs> preDeployJob() {
+        return Optional.ofNullable(this.preDeployJob);
+    }
+
+    /**
+     * Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+     * 
+     */
+    @Import(name="quota")
+    private @Nullable QuotaArgs quota;
+
+    /**
+     * @return Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+     * 
+     */
+    public Optional<QuotaArgs> quota() {
+        return Optional.ofNullable(this.quota);
+    }
+
+    /**
+     * An HTTP check that must pass before the application&#39;s pods receive traffic
+     * 
+     */
+    @Import(name="readinessProbe")
+    private @Nullable ProbeArgs readinessProbe;
+
+    /**
+     * @return An HTTP check that must pass before the application&#39;s pods receive traffic
+     * 
+     */
+    public Optional<ProbeArgs> readinessProbe() {
+        return Optional.ofNullable(this.readinessProbe);
+    }
+
+    /**
+     * Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+     * 
+     */
+    @Import(name="registryCredentials")
+    private @Nullable Output<RegistryCredentialsArgs> registryCredentials;
+
+    /**
+     * @return Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+     * 
+     */
+    public Optional<Output<RegistryCredentialsArgs>> registryCredentials() {
+        return Optional.ofNullable(this.registryCredentials);
+    }
+
+    /**
+     * Resource requests and limits for the application&#39;s container, overriding the ones the environment or size sets
+     * 
+     */
+    @Import(name="resources")
+    private @Nullable ResourcesArgs resources;
+
+    /**
+     * @return Resource requests and limits for the application&#39;s container, overriding the ones the environment or size sets
+     * 
+     */
+    public Optional<ResourcesArgs> resources() {
+        return Optional.ofNullable(this.resources);
+    }
+
+    /**
+     * Derive valid Kubernetes names from the resource&#39;s name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+     * 
+     */
+    @Import(name="sanitizeName")
+    private @Nullable Boolean sanitizeName;
+
+    /**
+     * @return Derive valid Kubernetes names from the resource&#39;s name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+     * 
+     */
+    public Optional<Boolean> sanitizeName() {
+        return Optional.ofNullable(this.sanitizeName);
+    }
+
+    /**
+     * The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+     * 
+     */
+    @Import(name="serviceType")
+    private @Nullable ServiceType serviceType;
+
+    /**
+     * @return The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+     * 
+     */
+    public Optional<ServiceType> serviceType() {
+        return Optional.ofNullable(this.serviceType);
+    }
+
+    /**
+     * How the application&#39;s pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+     * 
+     */
+    @Import(name="shutdown")
+    private @Nullable ShutdownArgs shutdown;
+
+    /**
+     * @return How the application&#39;s pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+     * 
+     */
+    public Optional<ShutdownArgs> shutdown() {
+        return Optional.ofNullable(this.shutdown);
+    }
+
+    /**
+     * The resource preset for the application&#39;s container, replacing the environment&#39;s resources
+     * 
+     */
+    @Import(name="size")
+    private @Nullable Size size;
+
+    /**
+     * @return The resource preset for the application&#39;s container, replacing the environment&#39;s resources
+     * 
+     */
+    public Optional<Size> size() {
+        return Optional.ofNullable(this.size);
+    }
+
+    /**
+     * An HTTP check the application&#39;s URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+     * 
+     */
+    @Import(name="smokeTest")
+    private @Nullable SmokeTestArgs smokeTest;
+
+    /**
+     * @return An HTTP check the application&#39;s URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+     * 
+     */
+    public Optional<SmokeTestArgs> smokeTest() {
+        return Optional.ofNullable(this.smokeTest);
+    }
+
+    /**
+     * Where to mount `volumes` in the application container
+     * 
+     */
+    @Import(name="volumeMounts")
+    private @Nullable List<VolumeMountArgs> volumeMounts;
+
+    /**
+     * @return Where to mount `volumes` in the application container
+     * 
+     */
+    public Optional<List<VolumeMountArgs>> volumeMounts() {
+        return Optional.ofNullable(this.volumeMounts);
+    }
+
+    /**
+     * Volumes available to the application container
+     * 
+     */
+    @Import(name="volumes")
+    private @Nullable List<VolumeArgs> volumes;
+
+    /**
+     * @return Volumes available to the application container
+     * 
+     */
+    public Optional<List<VolumeArgs>> volumes() {
+        return Optional.ofNullable(this.volumes);
+    }
+
+    /**
+     * The working directory of the application container
+     * 
+     */
+    @Import(name="workingDir")
+    private @Nullable Output<String> workingDir;
+
+    /**
+     * @return The working directory of the application container
+     * 
+     */
+    public Optional<Output<String>> workingDir() {
+        return Optional.ofNullable(this.workingDir);
+    }
+
+    private DeploymentArgs() {}
+
+    private DeploymentArgs(DeploymentArgs $) {
+        this.allowHostPath = $.allowHostPath;
+        this.annotations = $.annotations;
+        this.args = $.args;
+        this.autoscaling = $.autoscaling;
+        this.await = $.await;
+        this.cache = $.cache;
+        this.clusters = $.clusters;
+        this.command = $.command;
+        this.context = $.context;
+        this.database = $.database;
+        this.environment = $.environment;
+        this.image = $.image;
+        this.ingress = $.ingress;
+        this.kubeconfig = $.kubeconfig;
+        this.labels = $.labels;
+        this.livenessProbe = $.livenessProbe;
+        this.patches = $.patches;
+        this.podAnnotations = $.podAnnotations;
+        this.port = $.port;
+        this.preDeployJob = $.preDeployJob;
+        this.quota = $.quota;
+        this.readinessProbe = $.readinessProbe;
+        this.registryCredentials = $.registryCredentials;
+        this.resources = $.resources;
+        this.sanitizeName = $.sanitizeName;
+        this.serviceType = $.serviceType;
+        this.shutdown = $.shutdown;
+        this.size = $.size;
+        this.smokeTest = $.smokeTest;
+        this.volumeMounts = $.volumeMounts;
+        this.volumes = $.volumes;
+        this.workingDir = $.workingDir;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(DeploymentArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private DeploymentArgs $;
+
+        public Builder() {
+            $ = new DeploymentArgs();
+        }
+
+        public Builder(DeploymentArgs defaults) {
+            $ = new DeploymentArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param allowHostPath Whether `hostPath` volumes are allowed. They expose the node&#39;s filesystem and are denied by default
+         * 
+         * @return builder
+         * 
+         */
+        public Builder allowHostPath(@Nullable Boolean allowHostPath) {
+            $.allowHostPath = allowHostPath;
+            return this;
+        }
+
+        /**
+         * @param annotations Annotations added to every resource the component creates
+         * 
+         * @return builder
+         * 
+         */
+        public Builder annotations(@Nullable Map<String,String> annotations) {
+            $.annotations = annotations;
+            return this;
+        }
+
+        /**
+         * @param args Overrides the arguments of the application image
+         * 
+         * @return builder
+         * 
+         */
+        public Builder args(@Nullable Output<List<String>> args) {
+            $.args = args;
+            return this;
+        }
+
+        /**
+         * @param args Overrides the arguments of the application image
+         * 
+         * @return builder
+         * 
+         */
+        public Builder args(List<String> args) {
+            return args(Output.of(args));
+        }
+
+        /**
+         * @param args Overrides the arguments of the application image
+         * 
+         * @return builder
+         * 
+         */
+        public Builder args(String... args) {
+            return args(List.of(args));
+        }
+
+        /**
+         * @param autoscaling Scales the application with a HorizontalPodAutoscaler
+         * 
+         * @return builder
+         * 
+         */
+        public Builder autoscaling(@Nullable AutoscalingArgs autoscaling) {
+            $.autoscaling = autoscaling;
+            return this;
+        }
+
+        /**
+         * @param await How long to wait for the application to become ready
+         * 
+         * @return builder
+         * 
+         */
+        public Builder await(@Nullable AwaitArgs await) {
+            $.await = await;
+            return this;
+        }
+
+        /**
+         * @param cache A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cache(@Nullable Output<CacheConnectionArgs> cache) {
+            $.cache = cache;
+            return this;
+        }
+
+        /**
+         * @param cache A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cache(CacheConnectionArgs cache) {
+            return cache(Output.of(cache));
+        }
+
+        /**
+         * @param clusters Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusters(@Nullable List<ClusterArgs> clusters) {
+            $.clusters = clusters;
+            return this;
+        }
+
+        /**
+         * @param clusters Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder clusters(ClusterArgs... clusters) {
+            return clusters(List.of(clusters));
+        }
+
+        /**
+         * @param command Overrides the entrypoint of the application image
+         * 
+         * @return builder
+         * 
+         */
+        public Builder command(@Nullable Output<List<String>> command) {
+            $.command = command;
+            return this;
+        }
+
+        /**
+         * @param command Overrides the entrypoint of the application image
+         * 
+         * @return builder
+         * 
+         */
+        public Builder command(List<String> command) {
+            return command(Output.of(command));
+        }
+
+        /**
+         * @param command Overrides the entrypoint of the application image
+         * 
+         * @return builder
+         * 
+         */
+        public Builder command(String... command) {
+            return command(List.of(command));
+        }
+
+        /**
+         * @param context The kubeconfig context to create the application&#39;s resources in. Defaults to the kubeconfig&#39;s current context
+         * 
+         * @return builder
+         * 
+         */
+        public Builder context(@Nullable Output<String> context) {
+            $.context = context;
+            return this;
+        }
+
+        /**
+         * @param context The kubeconfig context to create the application&#39;s resources in. Defaults to the kubeconfig&#39;s current context
+         * 
+         * @return builder
+         * 
+         */
+        public Builder context(String context) {
+            return context(Output.of(context));
+        }
+
+        /**
+         * @param database A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder database(@Nullable Output<DatabaseConnectionArgs> database) {
+            $.database = database;
+            return this;
+        }
+
+        /**
+         * @param database A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder database(DatabaseConnectionArgs database) {
+            return database(Output.of(database));
+        }
+
+        /**
+         * @param environment The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider&#39;s `environment`; without one, three replicas are deployed
+         * 
+         * @return builder
+         * 
+         */
+        public Builder environment(@Nullable Environment environment) {
+            $.environment = environment;
+            return this;
+        }
+
+        /**
+         * @param image The image to deploy in your production application
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(Output<String> image) {
+            $.image = image;
+            return this;
+        }
+
+        /**
+         * @param image The image to deploy in your production application
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(String image) {
+            return image(Output.of(image));
+        }
+
+        /**
+         * @param ingress An Ingress that routes a host name to the application. The url becomes the Ingress&#39;s
+         * 
+         * @return builder
+         * 
+         */
+        public Builder ingress(@Nullable IngressArgs ingress) {
+            $.ingress = ingress;
+            return this;
+        }
+
+        /**
+         * @param kubeconfig The contents of a kubeconfig file, or the path to one, to create the application&#39;s resources with. Defaults to the ambient Kubernetes provider
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubeconfig(@Nullable Output<String> kubeconfig) {
+            $.kubeconfig = kubeconfig;
+            return this;
+        }
+
+        /**
+         * @param kubeconfig The contents of a kubeconfig file, or the path to one, to create the application&#39;s resources with. Defaults to the ambient Kubernetes provider
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubeconfig(String kubeconfig) {
+            return kubeconfig(Output.of(kubeconfig));
+        }
+
+        /**
+         * @param labels Labels added to every resource the component creates. The labels that select the application&#39;s pods cannot be overridden
+         * 
+         * @return builder
+         * 
+         */
+        public Builder labels(@Nullable Map<String,String> labels) {
+            $.labels = labels;
+            return this;
+        }
+
+        /**
+         * @param livenessProbe An HTTP check that restarts the application&#39;s container when it fails
+         * 
+         * @return builder
+         * 
+         */
+        public Builder livenessProbe(@Nullable ProbeArgs livenessProbe) {
+            $.livenessProbe = livenessProbe;
+            return this;
+        }
+
+        /**
+         * @param patches Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+         * 
+         * @return builder
+         * 
+         */
+        public Builder patches(@Nullable Map<String,PatchArgs> patches) {
+            $.patches = patches;
+            return this;
+        }
+
+        /**
+         * @param podAnnotations Annotations added to the application&#39;s pods
+         * 
+         * @return builder
+         * 
+         */
+        public Builder podAnnotations(@Nullable Map<String,String> podAnnotations) {
+            $.podAnnotations = podAnnotations;
+            return this;
+        }
+
+        /**
+         * @param port The port your container listens on
+         * 
+         * @return builder
+         * 
+         */
+        public Builder port(Output<Integer> port) {
+            $.port = port;
+            return this;
+        }
+
+        /**
+         * @param port The port your container listens on
+         * 
+         * @return builder
+         * 
+         */
+        public Builder port(Integer port) {
+            return port(Output.of(port));
+        }
+
+        /**
+         * @param preDeployJob A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+         * 
+         * @return builder
+         * 
+         */
+        public Builder preDeployJob(@Nullable PreDeployJobArgs preDeployJob) {
+            $.preDeployJob = preDeployJob;
+            return this;
+        }
+
+        /**
+         * @param quota Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+         * 
+         * @return builder
+         * 
+         */
+        public Builder quota(@Nullable QuotaArgs quota) {
+            $.quota = quota;
+            return this;
+        }
+
+        /**
+         * @param readinessProbe An HTTP check that must pass before the application&#39;s pods receive traffic
+         * 
+         * @return builder
+         * 
+         */
+        public Builder readinessProbe(@Nullable ProbeArgs readinessProbe) {
+            $.readinessProbe = readinessProbe;
+            return this;
+        }
+
+        /**
+         * @param registryCredentials Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder registryCredentials(@Nullable Output<RegistryCredentialsArgs> registryCredentials) {
+            $.registryCredentials = registryCredentials;
+            return this;
+        }
+
+        /**
+         * @param registryCredentials Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder registryCredentials(RegistryCredentialsArgs registryCredentials) {
+            return registryCredentials(Output.of(registryCredentials));
+        }
+
+        /**
+         * @param resources Resource requests and limits for the application&#39;s container, overriding the ones the environment or size sets
+         * 
+         * @return builder
+         * 
+         */
+        public Builder resources(@Nullable ResourcesArgs resources) {
+            $.resources = resources;
+            return this;
+        }
+
+        /**
+         * @param sanitizeName Derive valid Kubernetes names from the resource&#39;s name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+         * 
+         * @return builder
+         * 
+         */
+        public Builder sanitizeName(@Nullable Boolean sanitizeName) {
+            $.sanitizeName = sanitizeName;
+            return this;
+        }
+
+        /**
+         * @param serviceType The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+         * 
+         * @return builder
+         * 
+         */
+        public Builder serviceType(@Nullable ServiceType serviceType) {
+            $.serviceType = serviceType;
+            return this;
+        }
+
+        /**
+         * @param shutdown How the application&#39;s pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+         * 
+         * @return builder
+         * 
+         */
+        public Builder shutdown(@Nullable ShutdownArgs shutdown) {
+            $.shutdown = shutdown;
+            return this;
+        }
+
+        /**
+         * @param size The resource preset for the application&#39;s container, replacing the environment&#39;s resources
+         * 
+         * @return builder
+         * 
+         */
+        public Builder size(@Nullable Size size) {
+            $.size = size;
+            return this;
+        }
+
+        /**
+         * @param smokeTest An HTTP check the application&#39;s URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+         * 
+         * @return builder
+         * 
+         */
+        public Builder smokeTest(@Nullable SmokeTestArgs smokeTest) {
+            $.smokeTest = smokeTest;
+            return this;
+        }
+
+        /**
+         * @param volumeMounts Where to mount `volumes` in the application container
+         * 
+         * @return builder
+         * 
+         */
+        public Builder volumeMounts(@Nullable List<VolumeMountArgs> volumeMounts) {
+            $.volumeMounts = volumeMounts;
+            return this;
+        }
+
+        /**
+         * @param volumeMounts Where to mount `volumes` in the application container
+         * 
+         * @return builder
+         * 
+         */
+        public Builder volumeMounts(VolumeMountArgs... volumeMounts) {
+            return volumeMounts(List.of(volumeMounts));
+        }
+
+        /**
+         * @param volumes Volumes available to the application container
+         * 
+         * @return builder
+         * 
+         */
+        public Builder volumes(@Nullable List<VolumeArgs> volumes) {
+            $.volumes = volumes;
+            return this;
+        }
+
+        /**
+         * @param volumes Volumes available to the application container
+         * 
+         * @return builder
+         * 
+         */
+        public Builder volumes(VolumeArgs... volumes) {
+            return volumes(List.of(volumes));
+        }
+
+        /**
+         * @param workingDir The working directory of the application container
+         * 
+         * @return builder
+         * 
+         */
+        public Builder workingDir(@Nullable Output<String> workingDir) {
+            $.workingDir = workingDir;
+            return this;
+        }
+
+        /**
+         * @param workingDir The working directory of the application container
+         * 
+         * @return builder
+         * 
+         */
+        public Builder workingDir(String workingDir) {
+            return workingDir(Output.of(workingDir));
+        }
+
+        public DeploymentArgs build() {
+            $.allowHostPath = Codegen.booleanProp("allowHostPath").arg($.allowHostPath).def(false).getNullable();
+            $.image = Objects.requireNonNull($.image, "expected parameter 'image' to be non-null");
+            $.port = Objects.requireNonNull($.port, "expected parameter 'port' to be non-null");
+            $.serviceType = Codegen.objectProp("serviceType", ServiceType.class).arg($.serviceType).def(ServiceType.LoadBalancer).getNullable();
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,40 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.jaxxstorm.productionapp.Utilities;
+import com.jaxxstorm.productionapp.inputs.ValidateArgsArgs;
+import com.jaxxstorm.productionapp.outputs.GetPresetsResult;
+import com.jaxxstorm.productionapp.outputs.ValidateArgsResult;
+import com.pulumi.core.TypeShape;
+import com.pulumi.deployment.Deployment;
+import com.pulumi.deployment.InvokeOptions;
+import com.pulumi.resources.InvokeArgs;
+import java.util.concurrent.CompletableFuture;
+
+public final class ProductionappFunctions {
+    /**
+     * Returns the environment presets a Deployment can be deployed with and the sizes a Cache or a Deployment&#39;s container can have.
+     * 
+     */
+    public static CompletableFuture<GetPresetsResult> getPresets() {
+        return getPresets(InvokeArgs.Empty, InvokeOptions.Empty);
+    }
+    public static CompletableFuture<GetPresetsResult> getPresets(InvokeArgs args) {
+        return getPresets(args, InvokeOptions.Empty);
+    }
+    public static CompletableFuture<GetPresetsResult> getPresets(InvokeArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invokeAsync("productionapp:index:getPresets", TypeShape.of(GetPresetsResult.class), args, Utilities.withVersion(options));
+    }
+    /**
+     * Checks a Deployment&#39;s name and inputs without creating anything, applying the provider&#39;s configuration as constructing it would.
+     * 
+     */
+    public static CompletableFuture<ValidateArgsResult> validateArgs(ValidateArgsArgs args) {
+        return validateArgs(args, InvokeOptions.Empty);
+    }
+    public static CompletableFuture<ValidateArgsResult> validateArgs(ValidateArgsArgs args, InvokeOptions options) {
+        return Deployment.getInstance().invokeAsync("productionapp:index:validateArgs", TypeShape.of(ValidateArgsResult.class), args, Utilities.withVersion(options));
+    }
+}
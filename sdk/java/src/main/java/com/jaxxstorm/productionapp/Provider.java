@@ -1,15 +1,19 @@
 // *** WARNING: this file was generated by Pulumi SDK Generator. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
-package com.pulumi.productionapp;
+package com.jaxxstorm.productionapp;
 
+import com.jaxxstorm.productionapp.ProviderArgs;
+import com.jaxxstorm.productionapp.Utilities;
 import com.pulumi.core.Output;
 import com.pulumi.core.annotations.ResourceType;
 import com.pulumi.core.internal.Codegen;
-import com.pulumi.productionapp.ProviderArgs;
-import com.pulumi.productionapp.Utilities;
 import javax.annotation.Nullable;
 
+/**
+ * The provider type for the productionapp package. Its configuration sets organisation-wide defaults for the components it creates.
+ * 
+ */
 @ResourceType(type="pulumi:providers:productionapp")
 public class Provider extends com.pulumi.resources.ProviderResource {
     /**
@@ -0,0 +1,158 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final ProviderArgs Empty = new ProviderArgs();
+
+    /**
+     * Labels added to every Deployment&#39;s resources. Labels set on a Deployment take precedence
+     * 
+     */
+    @Import(name="defaultLabels", json=true)
+    private @Nullable Output<Map<String,String>> defaultLabels;
+
+    /**
+     * @return Labels added to every Deployment&#39;s resources. Labels set on a Deployment take precedence
+     * 
+     */
+    public Optional<Output<Map<String,String>>> defaultLabels() {
+        return Optional.ofNullable(this.defaultLabels);
+    }
+
+    /**
+     * A registry to pull images that don&#39;t name one from, such as `registry.example.com/mirror`
+     * 
+     */
+    @Import(name="defaultRegistry")
+    private @Nullable Output<String> defaultRegistry;
+
+    /**
+     * @return A registry to pull images that don&#39;t name one from, such as `registry.example.com/mirror`
+     * 
+     */
+    public Optional<Output<String>> defaultRegistry() {
+        return Optional.ofNullable(this.defaultRegistry);
+    }
+
+    /**
+     * The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+     * 
+     */
+    @Import(name="environment")
+    private @Nullable Output<String> environment;
+
+    /**
+     * @return The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+     * 
+     */
+    public Optional<Output<String>> environment() {
+        return Optional.ofNullable(this.environment);
+    }
+
+    private ProviderArgs() {}
+
+    private ProviderArgs(ProviderArgs $) {
+        this.defaultLabels = $.defaultLabels;
+        this.defaultRegistry = $.defaultRegistry;
+        this.environment = $.environment;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(ProviderArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private ProviderArgs $;
+
+        public Builder() {
+            $ = new ProviderArgs();
+        }
+
+        public Builder(ProviderArgs defaults) {
+            $ = new ProviderArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param defaultLabels Labels added to every Deployment&#39;s resources. Labels set on a Deployment take precedence
+         * 
+         * @return builder
+         * 
+         */
+        public Builder defaultLabels(@Nullable Output<Map<String,String>> defaultLabels) {
+            $.defaultLabels = defaultLabels;
+            return this;
+        }
+
+        /**
+         * @param defaultLabels Labels added to every Deployment&#39;s resources. Labels set on a Deployment take precedence
+         * 
+         * @return builder
+         * 
+         */
+        public Builder defaultLabels(Map<String,String> defaultLabels) {
+            return defaultLabels(Output.of(defaultLabels));
+        }
+
+        /**
+         * @param defaultRegistry A registry to pull images that don&#39;t name one from, such as `registry.example.com/mirror`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder defaultRegistry(@Nullable Output<String> defaultRegistry) {
+            $.defaultRegistry = defaultRegistry;
+            return this;
+        }
+
+        /**
+         * @param defaultRegistry A registry to pull images that don&#39;t name one from, such as `registry.example.com/mirror`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder defaultRegistry(String defaultRegistry) {
+            return defaultRegistry(Output.of(defaultRegistry));
+        }
+
+        /**
+         * @param environment The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+         * 
+         * @return builder
+         * 
+         */
+        public Builder environment(@Nullable Output<String> environment) {
+            $.environment = environment;
+            return this;
+        }
+
+        /**
+         * @param environment The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+         * 
+         * @return builder
+         * 
+         */
+        public Builder environment(String environment) {
+            return environment(Output.of(environment));
+        }
+
+        public ProviderArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -1,7 +1,7 @@
 // *** WARNING: this file was generated by Pulumi SDK Generator. ***
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
-package com.pulumi.productionapp;
+package com.jaxxstorm.productionapp;
 
 
 
@@ -75,7 +75,7 @@ public class Utilities {
     }
 
     static {
-        var resourceName = "com/pulumi/productionapp/version.txt";
+        var resourceName = "com/jaxxstorm/productionapp/version.txt";
         var versionFile = Utilities.class.getClassLoader().getResourceAsStream(resourceName);
         if (versionFile == null) {
             throw new IllegalStateException(
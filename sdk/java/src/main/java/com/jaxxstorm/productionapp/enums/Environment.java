@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    /**
+     * An environment tier, which selects replicas, resources and availability settings.
+     * 
+     */
+    @EnumType
+    public enum Environment {
+        /**
+         * One small replica
+         * 
+         */
+        Dev("dev"),
+        /**
+         * Two replicas spread across nodes with a PodDisruptionBudget
+         * 
+         */
+        Staging("staging"),
+        /**
+         * Three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace
+         * 
+         */
+        Prod("prod");
+
+        private final String value;
+
+        Environment(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public String toString() {
+            return new StringJoiner(", ", "Environment[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
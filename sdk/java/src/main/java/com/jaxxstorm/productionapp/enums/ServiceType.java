@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    /**
+     * The type of Service the application is exposed through.
+     * 
+     */
+    @EnumType
+    public enum ServiceType {
+        /**
+         * Only reachable from inside the cluster
+         * 
+         */
+        ClusterIP("ClusterIP"),
+        /**
+         * Reachable on a port of every node
+         * 
+         */
+        NodePort("NodePort"),
+        /**
+         * Reachable through a cloud load balancer
+         * 
+         */
+        LoadBalancer("LoadBalancer");
+
+        private final String value;
+
+        ServiceType(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public String toString() {
+            return new StringJoiner(", ", "ServiceType[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
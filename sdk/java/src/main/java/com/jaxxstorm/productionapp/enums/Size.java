@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.enums;
+
+import com.pulumi.core.annotations.EnumType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.StringJoiner;
+
+    /**
+     * A preset of container resource requests and limits.
+     * 
+     */
+    @EnumType
+    public enum Size {
+        /**
+         * 100m CPU and 128Mi memory requested, limited to 250m and 256Mi
+         * 
+         */
+        Small("small"),
+        /**
+         * 250m CPU and 512Mi memory requested, limited to 500m and 1Gi
+         * 
+         */
+        Medium("medium"),
+        /**
+         * 500m CPU and 2Gi memory requested, limited to 1 CPU and 4Gi
+         * 
+         */
+        Large("large");
+
+        private final String value;
+
+        Size(String value) {
+            this.value = Objects.requireNonNull(value);
+        }
+
+        @EnumType.Converter
+        public String getValue() {
+            return this.value;
+        }
+
+        @Override
+        public String toString() {
+            return new StringJoiner(", ", "Size[", "]")
+                .add("value='" + this.value + "'")
+                .toString();
+        }
+    }
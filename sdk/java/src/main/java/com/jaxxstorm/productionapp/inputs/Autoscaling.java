@@ -0,0 +1,130 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Scales the application with a HorizontalPodAutoscaler, overriding the environment&#39;s autoscaling or enabling it for environments that don&#39;t autoscale.
+ * 
+ */
+public final class Autoscaling extends com.pulumi.resources.InvokeArgs {
+
+    public static final Autoscaling Empty = new Autoscaling();
+
+    /**
+     * The most replicas to scale to. Defaults to the environment&#39;s maximum, or 10
+     * 
+     */
+    @Import(name="maxReplicas")
+    private @Nullable Integer maxReplicas;
+
+    /**
+     * @return The most replicas to scale to. Defaults to the environment&#39;s maximum, or 10
+     * 
+     */
+    public Optional<Integer> maxReplicas() {
+        return Optional.ofNullable(this.maxReplicas);
+    }
+
+    /**
+     * The fewest replicas to scale to. Defaults to the application&#39;s replicas
+     * 
+     */
+    @Import(name="minReplicas")
+    private @Nullable Integer minReplicas;
+
+    /**
+     * @return The fewest replicas to scale to. Defaults to the application&#39;s replicas
+     * 
+     */
+    public Optional<Integer> minReplicas() {
+        return Optional.ofNullable(this.minReplicas);
+    }
+
+    /**
+     * The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment&#39;s target, or 70
+     * 
+     */
+    @Import(name="targetCpuUtilizationPercentage")
+    private @Nullable Integer targetCpuUtilizationPercentage;
+
+    /**
+     * @return The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment&#39;s target, or 70
+     * 
+     */
+    public Optional<Integer> targetCpuUtilizationPercentage() {
+        return Optional.ofNullable(this.targetCpuUtilizationPercentage);
+    }
+
+    private Autoscaling() {}
+
+    private Autoscaling(Autoscaling $) {
+        this.maxReplicas = $.maxReplicas;
+        this.minReplicas = $.minReplicas;
+        this.targetCpuUtilizationPercentage = $.targetCpuUtilizationPercentage;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Autoscaling defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Autoscaling $;
+
+        public Builder() {
+            $ = new Autoscaling();
+        }
+
+        public Builder(Autoscaling defaults) {
+            $ = new Autoscaling(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param maxReplicas The most replicas to scale to. Defaults to the environment&#39;s maximum, or 10
+         * 
+         * @return builder
+         * 
+         */
+        public Builder maxReplicas(@Nullable Integer maxReplicas) {
+            $.maxReplicas = maxReplicas;
+            return this;
+        }
+
+        /**
+         * @param minReplicas The fewest replicas to scale to. Defaults to the application&#39;s replicas
+         * 
+         * @return builder
+         * 
+         */
+        public Builder minReplicas(@Nullable Integer minReplicas) {
+            $.minReplicas = minReplicas;
+            return this;
+        }
+
+        /**
+         * @param targetCpuUtilizationPercentage The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment&#39;s target, or 70
+         * 
+         * @return builder
+         * 
+         */
+        public Builder targetCpuUtilizationPercentage(@Nullable Integer targetCpuUtilizationPercentage) {
+            $.targetCpuUtilizationPercentage = targetCpuUtilizationPercentage;
+            return this;
+        }
+
+        public Autoscaling build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,158 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Controls how long Pulumi waits for the application to become ready.
+ * 
+ */
+public final class AwaitArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final AwaitArgs Empty = new AwaitArgs();
+
+    /**
+     * How long to wait for the application&#39;s resources to be created. Defaults to 10 minutes
+     * 
+     */
+    @Import(name="createTimeoutSeconds")
+    private @Nullable Integer createTimeoutSeconds;
+
+    /**
+     * @return How long to wait for the application&#39;s resources to be created. Defaults to 10 minutes
+     * 
+     */
+    public Optional<Integer> createTimeoutSeconds() {
+        return Optional.ofNullable(this.createTimeoutSeconds);
+    }
+
+    /**
+     * Don&#39;t wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+     * 
+     */
+    @Import(name="skipAwait")
+    private @Nullable Boolean skipAwait;
+
+    /**
+     * @return Don&#39;t wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+     * 
+     */
+    public Optional<Boolean> skipAwait() {
+        return Optional.ofNullable(this.skipAwait);
+    }
+
+    /**
+     * How long to wait for the application&#39;s resources to be updated. Defaults to 10 minutes
+     * 
+     */
+    @Import(name="updateTimeoutSeconds")
+    private @Nullable Integer updateTimeoutSeconds;
+
+    /**
+     * @return How long to wait for the application&#39;s resources to be updated. Defaults to 10 minutes
+     * 
+     */
+    public Optional<Integer> updateTimeoutSeconds() {
+        return Optional.ofNullable(this.updateTimeoutSeconds);
+    }
+
+    /**
+     * Wait for the Service&#39;s load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+     * 
+     */
+    @Import(name="waitForLoadBalancer")
+    private @Nullable Boolean waitForLoadBalancer;
+
+    /**
+     * @return Wait for the Service&#39;s load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+     * 
+     */
+    public Optional<Boolean> waitForLoadBalancer() {
+        return Optional.ofNullable(this.waitForLoadBalancer);
+    }
+
+    private AwaitArgs() {}
+
+    private AwaitArgs(AwaitArgs $) {
+        this.createTimeoutSeconds = $.createTimeoutSeconds;
+        this.skipAwait = $.skipAwait;
+        this.updateTimeoutSeconds = $.updateTimeoutSeconds;
+        this.waitForLoadBalancer = $.waitForLoadBalancer;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(AwaitArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private AwaitArgs $;
+
+        public Builder() {
+            $ = new AwaitArgs();
+        }
+
+        public Builder(AwaitArgs defaults) {
+            $ = new AwaitArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param createTimeoutSeconds How long to wait for the application&#39;s resources to be created. Defaults to 10 minutes
+         * 
+         * @return builder
+         * 
+         */
+        public Builder createTimeoutSeconds(@Nullable Integer createTimeoutSeconds) {
+            $.createTimeoutSeconds = createTimeoutSeconds;
+            return this;
+        }
+
+        /**
+         * @param skipAwait Don&#39;t wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+         * 
+         * @return builder
+         * 
+         */
+        public Builder skipAwait(@Nullable Boolean skipAwait) {
+            $.skipAwait = skipAwait;
+            return this;
+        }
+
+        /**
+         * @param updateTimeoutSeconds How long to wait for the application&#39;s resources to be updated. Defaults to 10 minutes
+         * 
+         * @return builder
+         * 
+         */
+        public Builder updateTimeoutSeconds(@Nullable Integer updateTimeoutSeconds) {
+            $.updateTimeoutSeconds = updateTimeoutSeconds;
+            return this;
+        }
+
+        /**
+         * @param waitForLoadBalancer Wait for the Service&#39;s load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+         * 
+         * @return builder
+         * 
+         */
+        public Builder waitForLoadBalancer(@Nullable Boolean waitForLoadBalancer) {
+            $.waitForLoadBalancer = waitForLoadBalancer;
+            return this;
+        }
+
+        public AwaitArgs build() {
+            return $;
+        }
+    }
+
+}
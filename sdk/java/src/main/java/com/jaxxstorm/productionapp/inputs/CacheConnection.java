@@ -0,0 +1,132 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * Describes how an application connects to a cache.
+ * 
+ */
+public final class CacheConnection extends com.pulumi.resources.InvokeArgs {
+
+    public static final CacheConnection Empty = new CacheConnection();
+
+    /**
+     * The connection string for the cache, such as the `connectionString` output of a `Cache`
+     * 
+     */
+    @Import(name="connectionString", required=true)
+    private String connectionString;
+
+    /**
+     * @return The connection string for the cache, such as the `connectionString` output of a `Cache`
+     * 
+     */
+    public String connectionString() {
+        return this.connectionString;
+    }
+
+    /**
+     * The hostname of the cache, such as the `host` output of a `Cache`
+     * 
+     */
+    @Import(name="host", required=true)
+    private String host;
+
+    /**
+     * @return The hostname of the cache, such as the `host` output of a `Cache`
+     * 
+     */
+    public String host() {
+        return this.host;
+    }
+
+    /**
+     * The port of the cache, such as the `port` output of a `Cache`
+     * 
+     */
+    @Import(name="port", required=true)
+    private Integer port;
+
+    /**
+     * @return The port of the cache, such as the `port` output of a `Cache`
+     * 
+     */
+    public Integer port() {
+        return this.port;
+    }
+
+    private CacheConnection() {}
+
+    private CacheConnection(CacheConnection $) {
+        this.connectionString = $.connectionString;
+        this.host = $.host;
+        this.port = $.port;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(CacheConnection defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private CacheConnection $;
+
+        public Builder() {
+            $ = new CacheConnection();
+        }
+
+        public Builder(CacheConnection defaults) {
+            $ = new CacheConnection(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connectionString The connection string for the cache, such as the `connectionString` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connectionString(String connectionString) {
+            $.connectionString = connectionString;
+            return this;
+        }
+
+        /**
+         * @param host The hostname of the cache, such as the `host` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder host(String host) {
+            $.host = host;
+            return this;
+        }
+
+        /**
+         * @param port The port of the cache, such as the `port` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder port(Integer port) {
+            $.port = port;
+            return this;
+        }
+
+        public CacheConnection build() {
+            $.connectionString = Objects.requireNonNull($.connectionString, "expected parameter 'connectionString' to be non-null");
+            $.host = Objects.requireNonNull($.host, "expected parameter 'host' to be non-null");
+            $.port = Objects.requireNonNull($.port, "expected parameter 'port' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,163 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * Describes how an application connects to a cache.
+ * 
+ */
+public final class CacheConnectionArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final CacheConnectionArgs Empty = new CacheConnectionArgs();
+
+    /**
+     * The connection string for the cache, such as the `connectionString` output of a `Cache`
+     * 
+     */
+    @Import(name="connectionString", required=true)
+    private Output<String> connectionString;
+
+    /**
+     * @return The connection string for the cache, such as the `connectionString` output of a `Cache`
+     * 
+     */
+    public Output<String> connectionString() {
+        return this.connectionString;
+    }
+
+    /**
+     * The hostname of the cache, such as the `host` output of a `Cache`
+     * 
+     */
+    @Import(name="host", required=true)
+    private Output<String> host;
+
+    /**
+     * @return The hostname of the cache, such as the `host` output of a `Cache`
+     * 
+     */
+    public Output<String> host() {
+        return this.host;
+    }
+
+    /**
+     * The port of the cache, such as the `port` output of a `Cache`
+     * 
+     */
+    @Import(name="port", required=true)
+    private Output<Integer> port;
+
+    /**
+     * @return The port of the cache, such as the `port` output of a `Cache`
+     * 
+     */
+    public Output<Integer> port() {
+        return this.port;
+    }
+
+    private CacheConnectionArgs() {}
+
+    private CacheConnectionArgs(CacheConnectionArgs $) {
+        this.connectionString = $.connectionString;
+        this.host = $.host;
+        this.port = $.port;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(CacheConnectionArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private CacheConnectionArgs $;
+
+        public Builder() {
+            $ = new CacheConnectionArgs();
+        }
+
+        public Builder(CacheConnectionArgs defaults) {
+            $ = new CacheConnectionArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connectionString The connection string for the cache, such as the `connectionString` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connectionString(Output<String> connectionString) {
+            $.connectionString = connectionString;
+            return this;
+        }
+
+        /**
+         * @param connectionString The connection string for the cache, such as the `connectionString` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connectionString(String connectionString) {
+            return connectionString(Output.of(connectionString));
+        }
+
+        /**
+         * @param host The hostname of the cache, such as the `host` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder host(Output<String> host) {
+            $.host = host;
+            return this;
+        }
+
+        /**
+         * @param host The hostname of the cache, such as the `host` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder host(String host) {
+            return host(Output.of(host));
+        }
+
+        /**
+         * @param port The port of the cache, such as the `port` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder port(Output<Integer> port) {
+            $.port = port;
+            return this;
+        }
+
+        /**
+         * @param port The port of the cache, such as the `port` output of a `Cache`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder port(Integer port) {
+            return port(Output.of(port));
+        }
+
+        public CacheConnectionArgs build() {
+            $.connectionString = Objects.requireNonNull($.connectionString, "expected parameter 'connectionString' to be non-null");
+            $.host = Objects.requireNonNull($.host, "expected parameter 'host' to be non-null");
+            $.port = Objects.requireNonNull($.port, "expected parameter 'port' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,186 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * One of the clusters the application is deployed to, with overrides for the application&#39;s settings there.
+ * 
+ */
+public final class Cluster extends com.pulumi.resources.InvokeArgs {
+
+    public static final Cluster Empty = new Cluster();
+
+    /**
+     * The kubeconfig context of the cluster
+     * 
+     */
+    @Import(name="context")
+    private @Nullable String context;
+
+    /**
+     * @return The kubeconfig context of the cluster
+     * 
+     */
+    public Optional<String> context() {
+        return Optional.ofNullable(this.context);
+    }
+
+    /**
+     * The image to deploy to the cluster, overriding `image`
+     * 
+     */
+    @Import(name="image")
+    private @Nullable String image;
+
+    /**
+     * @return The image to deploy to the cluster, overriding `image`
+     * 
+     */
+    public Optional<String> image() {
+        return Optional.ofNullable(this.image);
+    }
+
+    /**
+     * The contents of a kubeconfig file, or the path to one, for the cluster
+     * 
+     */
+    @Import(name="kubeconfig")
+    private @Nullable String kubeconfig;
+
+    /**
+     * @return The contents of a kubeconfig file, or the path to one, for the cluster
+     * 
+     */
+    public Optional<String> kubeconfig() {
+        return Optional.ofNullable(this.kubeconfig);
+    }
+
+    /**
+     * The cluster&#39;s name, unique among the clusters. Used as a suffix for the names of the application&#39;s resources in it
+     * 
+     */
+    @Import(name="name", required=true)
+    private String name;
+
+    /**
+     * @return The cluster&#39;s name, unique among the clusters. Used as a suffix for the names of the application&#39;s resources in it
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+
+    /**
+     * The number of replicas in the cluster, overriding the environment&#39;s. Must be at least 1 when the application autoscales
+     * 
+     */
+    @Import(name="replicas")
+    private @Nullable Integer replicas;
+
+    /**
+     * @return The number of replicas in the cluster, overriding the environment&#39;s. Must be at least 1 when the application autoscales
+     * 
+     */
+    public Optional<Integer> replicas() {
+        return Optional.ofNullable(this.replicas);
+    }
+
+    private Cluster() {}
+
+    private Cluster(Cluster $) {
+        this.context = $.context;
+        this.image = $.image;
+        this.kubeconfig = $.kubeconfig;
+        this.name = $.name;
+        this.replicas = $.replicas;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Cluster defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Cluster $;
+
+        public Builder() {
+            $ = new Cluster();
+        }
+
+        public Builder(Cluster defaults) {
+            $ = new Cluster(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param context The kubeconfig context of the cluster
+         * 
+         * @return builder
+         * 
+         */
+        public Builder context(@Nullable String context) {
+            $.context = context;
+            return this;
+        }
+
+        /**
+         * @param image The image to deploy to the cluster, overriding `image`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(@Nullable String image) {
+            $.image = image;
+            return this;
+        }
+
+        /**
+         * @param kubeconfig The contents of a kubeconfig file, or the path to one, for the cluster
+         * 
+         * @return builder
+         * 
+         */
+        public Builder kubeconfig(@Nullable String kubeconfig) {
+            $.kubeconfig = kubeconfig;
+            return this;
+        }
+
+        /**
+         * @param name The cluster&#39;s name, unique among the clusters. Used as a suffix for the names of the application&#39;s resources in it
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            $.name = name;
+            return this;
+        }
+
+        /**
+         * @param replicas The number of replicas in the cluster, overriding the environment&#39;s. Must be at least 1 when the application autoscales
+         * 
+         * @return builder
+         * 
+         */
+        public Builder replicas(@Nullable Integer replicas) {
+            $.replicas = replicas;
+            return this;
+        }
+
+        public Cluster build() {
+            $.name = Objects.requireNonNull($.name, "expected parameter 'name' to be non-null");
+            return $;
+        }
+    }
+
+}
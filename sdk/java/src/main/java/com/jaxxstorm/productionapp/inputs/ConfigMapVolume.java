@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * A volume populated from a ConfigMap.
+ * 
+ */
+public final class ConfigMapVolume extends com.pulumi.resources.InvokeArgs {
+
+    public static final ConfigMapVolume Empty = new ConfigMapVolume();
+
+    /**
+     * The name of the ConfigMap in the application namespace
+     * 
+     */
+    @Import(name="name", required=true)
+    private String name;
+
+    /**
+     * @return The name of the ConfigMap in the application namespace
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+
+    private ConfigMapVolume() {}
+
+    private ConfigMapVolume(ConfigMapVolume $) {
+        this.name = $.name;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(ConfigMapVolume defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private ConfigMapVolume $;
+
+        public Builder() {
+            $ = new ConfigMapVolume();
+        }
+
+        public Builder(ConfigMapVolume defaults) {
+            $ = new ConfigMapVolume(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param name The name of the ConfigMap in the application namespace
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            $.name = name;
+            return this;
+        }
+
+        public ConfigMapVolume build() {
+            $.name = Objects.requireNonNull($.name, "expected parameter 'name' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * A volume populated from a ConfigMap.
+ * 
+ */
+public final class ConfigMapVolumeArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final ConfigMapVolumeArgs Empty = new ConfigMapVolumeArgs();
+
+    /**
+     * The name of the ConfigMap in the application namespace
+     * 
+     */
+    @Import(name="name", required=true)
+    private String name;
+
+    /**
+     * @return The name of the ConfigMap in the application namespace
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+
+    private ConfigMapVolumeArgs() {}
+
+    private ConfigMapVolumeArgs(ConfigMapVolumeArgs $) {
+        this.name = $.name;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(ConfigMapVolumeArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private ConfigMapVolumeArgs $;
+
+        public Builder() {
+            $ = new ConfigMapVolumeArgs();
+        }
+
+        public Builder(ConfigMapVolumeArgs defaults) {
+            $ = new ConfigMapVolumeArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param name The name of the ConfigMap in the application namespace
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            $.name = name;
+            return this;
+        }
+
+        public ConfigMapVolumeArgs build() {
+            $.name = Objects.requireNonNull($.name, "expected parameter 'name' to be non-null");
+            return $;
+        }
+    }
+
+}
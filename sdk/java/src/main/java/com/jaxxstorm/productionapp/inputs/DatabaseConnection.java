@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * Describes how an application connects to a database.
+ * 
+ */
+public final class DatabaseConnection extends com.pulumi.resources.InvokeArgs {
+
+    public static final DatabaseConnection Empty = new DatabaseConnection();
+
+    /**
+     * The connection string for the database, such as the `connectionString` output of a `Database`
+     * 
+     */
+    @Import(name="connectionString", required=true)
+    private String connectionString;
+
+    /**
+     * @return The connection string for the database, such as the `connectionString` output of a `Database`
+     * 
+     */
+    public String connectionString() {
+        return this.connectionString;
+    }
+
+    private DatabaseConnection() {}
+
+    private DatabaseConnection(DatabaseConnection $) {
+        this.connectionString = $.connectionString;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(DatabaseConnection defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private DatabaseConnection $;
+
+        public Builder() {
+            $ = new DatabaseConnection();
+        }
+
+        public Builder(DatabaseConnection defaults) {
+            $ = new DatabaseConnection(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connectionString The connection string for the database, such as the `connectionString` output of a `Database`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connectionString(String connectionString) {
+            $.connectionString = connectionString;
+            return this;
+        }
+
+        public DatabaseConnection build() {
+            $.connectionString = Objects.requireNonNull($.connectionString, "expected parameter 'connectionString' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,86 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * Describes how an application connects to a database.
+ * 
+ */
+public final class DatabaseConnectionArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final DatabaseConnectionArgs Empty = new DatabaseConnectionArgs();
+
+    /**
+     * The connection string for the database, such as the `connectionString` output of a `Database`
+     * 
+     */
+    @Import(name="connectionString", required=true)
+    private Output<String> connectionString;
+
+    /**
+     * @return The connection string for the database, such as the `connectionString` output of a `Database`
+     * 
+     */
+    public Output<String> connectionString() {
+        return this.connectionString;
+    }
+
+    private DatabaseConnectionArgs() {}
+
+    private DatabaseConnectionArgs(DatabaseConnectionArgs $) {
+        this.connectionString = $.connectionString;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(DatabaseConnectionArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private DatabaseConnectionArgs $;
+
+        public Builder() {
+            $ = new DatabaseConnectionArgs();
+        }
+
+        public Builder(DatabaseConnectionArgs defaults) {
+            $ = new DatabaseConnectionArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param connectionString The connection string for the database, such as the `connectionString` output of a `Database`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connectionString(Output<String> connectionString) {
+            $.connectionString = connectionString;
+            return this;
+        }
+
+        /**
+         * @param connectionString The connection string for the database, such as the `connectionString` output of a `Database`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder connectionString(String connectionString) {
+            return connectionString(Output.of(connectionString));
+        }
+
+        public DatabaseConnectionArgs build() {
+            $.connectionString = Objects.requireNonNull($.connectionString, "expected parameter 'connectionString' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,103 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * A scratch directory that lives as long as the pod.
+ * 
+ */
+public final class EmptyDirVolume extends com.pulumi.resources.InvokeArgs {
+
+    public static final EmptyDirVolume Empty = new EmptyDirVolume();
+
+    /**
+     * Set to `Memory` to back the directory with tmpfs
+     * 
+     */
+    @Import(name="medium")
+    private @Nullable String medium;
+
+    /**
+     * @return Set to `Memory` to back the directory with tmpfs
+     * 
+     */
+    public Optional<String> medium() {
+        return Optional.ofNullable(this.medium);
+    }
+
+    /**
+     * The maximum size of the directory, such as `1Gi`
+     * 
+     */
+    @Import(name="sizeLimit")
+    private @Nullable String sizeLimit;
+
+    /**
+     * @return The maximum size of the directory, such as `1Gi`
+     * 
+     */
+    public Optional<String> sizeLimit() {
+        return Optional.ofNullable(this.sizeLimit);
+    }
+
+    private EmptyDirVolume() {}
+
+    private EmptyDirVolume(EmptyDirVolume $) {
+        this.medium = $.medium;
+        this.sizeLimit = $.sizeLimit;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(EmptyDirVolume defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private EmptyDirVolume $;
+
+        public Builder() {
+            $ = new EmptyDirVolume();
+        }
+
+        public Builder(EmptyDirVolume defaults) {
+            $ = new EmptyDirVolume(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param medium Set to `Memory` to back the directory with tmpfs
+         * 
+         * @return builder
+         * 
+         */
+        public Builder medium(@Nullable String medium) {
+            $.medium = medium;
+            return this;
+        }
+
+        /**
+         * @param sizeLimit The maximum size of the directory, such as `1Gi`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder sizeLimit(@Nullable String sizeLimit) {
+            $.sizeLimit = sizeLimit;
+            return this;
+        }
+
+        public EmptyDirVolume build() {
+            return $;
+        }
+    }
+
+}
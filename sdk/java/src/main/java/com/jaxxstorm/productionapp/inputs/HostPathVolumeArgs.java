@@ -0,0 +1,104 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * A volume backed by a path on the node.
+ * 
+ */
+public final class HostPathVolumeArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final HostPathVolumeArgs Empty = new HostPathVolumeArgs();
+
+    /**
+     * The path on the node
+     * 
+     */
+    @Import(name="path", required=true)
+    private String path;
+
+    /**
+     * @return The path on the node
+     * 
+     */
+    public String path() {
+        return this.path;
+    }
+
+    /**
+     * The type of the path, such as `Directory`
+     * 
+     */
+    @Import(name="type")
+    private @Nullable String type;
+
+    /**
+     * @return The type of the path, such as `Directory`
+     * 
+     */
+    public Optional<String> type() {
+        return Optional.ofNullable(this.type);
+    }
+
+    private HostPathVolumeArgs() {}
+
+    private HostPathVolumeArgs(HostPathVolumeArgs $) {
+        this.path = $.path;
+        this.type = $.type;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(HostPathVolumeArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private HostPathVolumeArgs $;
+
+        public Builder() {
+            $ = new HostPathVolumeArgs();
+        }
+
+        public Builder(HostPathVolumeArgs defaults) {
+            $ = new HostPathVolumeArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param path The path on the node
+         * 
+         * @return builder
+         * 
+         */
+        public Builder path(String path) {
+            $.path = path;
+            return this;
+        }
+
+        /**
+         * @param type The type of the path, such as `Directory`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder type(@Nullable String type) {
+            $.type = type;
+            return this;
+        }
+
+        public HostPathVolumeArgs build() {
+            $.path = Objects.requireNonNull($.path, "expected parameter 'path' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,186 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Routes a host name to the application&#39;s Service.
+ * 
+ */
+public final class IngressArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final IngressArgs Empty = new IngressArgs();
+
+    /**
+     * Annotations added to the Ingress, such as the controller&#39;s settings
+     * 
+     */
+    @Import(name="annotations")
+    private @Nullable Map<String,String> annotations;
+
+    /**
+     * @return Annotations added to the Ingress, such as the controller&#39;s settings
+     * 
+     */
+    public Optional<Map<String,String>> annotations() {
+        return Optional.ofNullable(this.annotations);
+    }
+
+    /**
+     * The IngressClass of the controller that serves the Ingress. Defaults to the cluster&#39;s default class
+     * 
+     */
+    @Import(name="className")
+    private @Nullable String className;
+
+    /**
+     * @return The IngressClass of the controller that serves the Ingress. Defaults to the cluster&#39;s default class
+     * 
+     */
+    public Optional<String> className() {
+        return Optional.ofNullable(this.className);
+    }
+
+    /**
+     * The host name to route, such as `app.example.com`
+     * 
+     */
+    @Import(name="host", required=true)
+    private String host;
+
+    /**
+     * @return The host name to route, such as `app.example.com`
+     * 
+     */
+    public String host() {
+        return this.host;
+    }
+
+    /**
+     * The path prefix to route. Defaults to `/`
+     * 
+     */
+    @Import(name="path")
+    private @Nullable String path;
+
+    /**
+     * @return The path prefix to route. Defaults to `/`
+     * 
+     */
+    public Optional<String> path() {
+        return Optional.ofNullable(this.path);
+    }
+
+    /**
+     * A Secret in the application&#39;s namespace holding the host&#39;s TLS certificate. Setting it serves the application over HTTPS
+     * 
+     */
+    @Import(name="tlsSecretName")
+    private @Nullable String tlsSecretName;
+
+    /**
+     * @return A Secret in the application&#39;s namespace holding the host&#39;s TLS certificate. Setting it serves the application over HTTPS
+     * 
+     */
+    public Optional<String> tlsSecretName() {
+        return Optional.ofNullable(this.tlsSecretName);
+    }
+
+    private IngressArgs() {}
+
+    private IngressArgs(IngressArgs $) {
+        this.annotations = $.annotations;
+        this.className = $.className;
+        this.host = $.host;
+        this.path = $.path;
+        this.tlsSecretName = $.tlsSecretName;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(IngressArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private IngressArgs $;
+
+        public Builder() {
+            $ = new IngressArgs();
+        }
+
+        public Builder(IngressArgs defaults) {
+            $ = new IngressArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param annotations Annotations added to the Ingress, such as the controller&#39;s settings
+         * 
+         * @return builder
+         * 
+         */
+        public Builder annotations(@Nullable Map<String,String> annotations) {
+            $.annotations = annotations;
+            return this;
+        }
+
+        /**
+         * @param className The IngressClass of the controller that serves the Ingress. Defaults to the cluster&#39;s default class
+         * 
+         * @return builder
+         * 
+         */
+        public Builder className(@Nullable String className) {
+            $.className = className;
+            return this;
+        }
+
+        /**
+         * @param host The host name to route, such as `app.example.com`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder host(String host) {
+            $.host = host;
+            return this;
+        }
+
+        /**
+         * @param path The path prefix to route. Defaults to `/`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder path(@Nullable String path) {
+            $.path = path;
+            return this;
+        }
+
+        /**
+         * @param tlsSecretName A Secret in the application&#39;s namespace holding the host&#39;s TLS certificate. Setting it serves the application over HTTPS
+         * 
+         * @return builder
+         * 
+         */
+        public Builder tlsSecretName(@Nullable String tlsSecretName) {
+            $.tlsSecretName = tlsSecretName;
+            return this;
+        }
+
+        public IngressArgs build() {
+            $.host = Objects.requireNonNull($.host, "expected parameter 'host' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Object;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Modifies the arguments of every resource of one kind before it is registered.
+ * 
+ */
+public final class PatchArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final PatchArgs Empty = new PatchArgs();
+
+    /**
+     * The patch, in the shape of the Kubernetes resource. `null` removes a field
+     * 
+     */
+    @Import(name="patch", required=true)
+    private Object patch;
+
+    /**
+     * @return The patch, in the shape of the Kubernetes resource. `null` removes a field
+     * 
+     */
+    public Object patch() {
+        return this.patch;
+    }
+
+    /**
+     * How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+     * 
+     */
+    @Import(name="type")
+    private @Nullable String type;
+
+    /**
+     * @return How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+     * 
+     */
+    public Optional<String> type() {
+        return Optional.ofNullable(this.type);
+    }
+
+    private PatchArgs() {}
+
+    private PatchArgs(PatchArgs $) {
+        this.patch = $.patch;
+        this.type = $.type;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(PatchArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private PatchArgs $;
+
+        public Builder() {
+            $ = new PatchArgs();
+        }
+
+        public Builder(PatchArgs defaults) {
+            $ = new PatchArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param patch The patch, in the shape of the Kubernetes resource. `null` removes a field
+         * 
+         * @return builder
+         * 
+         */
+        public Builder patch(Object patch) {
+            $.patch = patch;
+            return this;
+        }
+
+        /**
+         * @param type How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+         * 
+         * @return builder
+         * 
+         */
+        public Builder type(@Nullable String type) {
+            $.type = type;
+            return this;
+        }
+
+        public PatchArgs build() {
+            $.patch = Objects.requireNonNull($.patch, "expected parameter 'patch' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,170 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+ * 
+ */
+public final class PreDeployJobArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final PreDeployJobArgs Empty = new PreDeployJobArgs();
+
+    /**
+     * The command to run
+     * 
+     */
+    @Import(name="command")
+    private @Nullable List<String> command;
+
+    /**
+     * @return The command to run
+     * 
+     */
+    public Optional<List<String>> command() {
+        return Optional.ofNullable(this.command);
+    }
+
+    /**
+     * Environment variables for the job, in addition to those of the application
+     * 
+     */
+    @Import(name="env")
+    private @Nullable Map<String,String> env;
+
+    /**
+     * @return Environment variables for the job, in addition to those of the application
+     * 
+     */
+    public Optional<Map<String,String>> env() {
+        return Optional.ofNullable(this.env);
+    }
+
+    /**
+     * The image to run. Defaults to the application image
+     * 
+     */
+    @Import(name="image")
+    private @Nullable String image;
+
+    /**
+     * @return The image to run. Defaults to the application image
+     * 
+     */
+    public Optional<String> image() {
+        return Optional.ofNullable(this.image);
+    }
+
+    /**
+     * How long the job may run before it is considered failed
+     * 
+     */
+    @Import(name="timeoutSeconds")
+    private @Nullable Integer timeoutSeconds;
+
+    /**
+     * @return How long the job may run before it is considered failed
+     * 
+     */
+    public Optional<Integer> timeoutSeconds() {
+        return Optional.ofNullable(this.timeoutSeconds);
+    }
+
+    private PreDeployJobArgs() {}
+
+    private PreDeployJobArgs(PreDeployJobArgs $) {
+        this.command = $.command;
+        this.env = $.env;
+        this.image = $.image;
+        this.timeoutSeconds = $.timeoutSeconds;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(PreDeployJobArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private PreDeployJobArgs $;
+
+        public Builder() {
+            $ = new PreDeployJobArgs();
+        }
+
+        public Builder(PreDeployJobArgs defaults) {
+            $ = new PreDeployJobArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param command The command to run
+         * 
+         * @return builder
+         * 
+         */
+        public Builder command(@Nullable List<String> command) {
+            $.command = command;
+            return this;
+        }
+
+        /**
+         * @param command The command to run
+         * 
+         * @return builder
+         * 
+         */
+        public Builder command(String... command) {
+            return command(List.of(command));
+        }
+
+        /**
+         * @param env Environment variables for the job, in addition to those of the application
+         * 
+         * @return builder
+         * 
+         */
+        public Builder env(@Nullable Map<String,String> env) {
+            $.env = env;
+            return this;
+        }
+
+        /**
+         * @param image The image to run. Defaults to the application image
+         * 
+         * @return builder
+         * 
+         */
+        public Builder image(@Nullable String image) {
+            $.image = image;
+            return this;
+        }
+
+        /**
+         * @param timeoutSeconds How long the job may run before it is considered failed
+         * 
+         * @return builder
+         * 
+         */
+        public Builder timeoutSeconds(@Nullable Integer timeoutSeconds) {
+            $.timeoutSeconds = timeoutSeconds;
+            return this;
+        }
+
+        public PreDeployJobArgs build() {
+            return $;
+        }
+    }
+
+}
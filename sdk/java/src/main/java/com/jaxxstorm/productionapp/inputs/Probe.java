@@ -0,0 +1,212 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * An HTTP check the kubelet makes against the application container.
+ * 
+ */
+public final class Probe extends com.pulumi.resources.InvokeArgs {
+
+    public static final Probe Empty = new Probe();
+
+    /**
+     * How many consecutive checks must fail before the probe fails. Defaults to 3
+     * 
+     */
+    @Import(name="failureThreshold")
+    private @Nullable Integer failureThreshold;
+
+    /**
+     * @return How many consecutive checks must fail before the probe fails. Defaults to 3
+     * 
+     */
+    public Optional<Integer> failureThreshold() {
+        return Optional.ofNullable(this.failureThreshold);
+    }
+
+    /**
+     * How long to wait after the container starts before the first check
+     * 
+     */
+    @Import(name="initialDelaySeconds")
+    private @Nullable Integer initialDelaySeconds;
+
+    /**
+     * @return How long to wait after the container starts before the first check
+     * 
+     */
+    public Optional<Integer> initialDelaySeconds() {
+        return Optional.ofNullable(this.initialDelaySeconds);
+    }
+
+    /**
+     * The path to request. Defaults to `/`
+     * 
+     */
+    @Import(name="path")
+    private @Nullable String path;
+
+    /**
+     * @return The path to request. Defaults to `/`
+     * 
+     */
+    public Optional<String> path() {
+        return Optional.ofNullable(this.path);
+    }
+
+    /**
+     * How often to check. Defaults to 10 seconds
+     * 
+     */
+    @Import(name="periodSeconds")
+    private @Nullable Integer periodSeconds;
+
+    /**
+     * @return How often to check. Defaults to 10 seconds
+     * 
+     */
+    public Optional<Integer> periodSeconds() {
+        return Optional.ofNullable(this.periodSeconds);
+    }
+
+    /**
+     * The container port to request. Defaults to the application&#39;s port
+     * 
+     */
+    @Import(name="port")
+    private @Nullable Integer port;
+
+    /**
+     * @return The container port to request. Defaults to the application&#39;s port
+     * 
+     */
+    public Optional<Integer> port() {
+        return Optional.ofNullable(this.port);
+    }
+
+    /**
+     * How long a check may take. Defaults to 1 second
+     * 
+     */
+    @Import(name="timeoutSeconds")
+    private @Nullable Integer timeoutSeconds;
+
+    /**
+     * @return How long a check may take. Defaults to 1 second
+     * 
+     */
+    public Optional<Integer> timeoutSeconds() {
+        return Optional.ofNullable(this.timeoutSeconds);
+    }
+
+    private Probe() {}
+
+    private Probe(Probe $) {
+        this.failureThreshold = $.failureThreshold;
+        this.initialDelaySeconds = $.initialDelaySeconds;
+        this.path = $.path;
+        this.periodSeconds = $.periodSeconds;
+        this.port = $.port;
+        this.timeoutSeconds = $.timeoutSeconds;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Probe defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Probe $;
+
+        public Builder() {
+            $ = new Probe();
+        }
+
+        public Builder(Probe defaults) {
+            $ = new Probe(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param failureThreshold How many consecutive checks must fail before the probe fails. Defaults to 3
+         * 
+         * @return builder
+         * 
+         */
+        public Builder failureThreshold(@Nullable Integer failureThreshold) {
+            $.failureThreshold = failureThreshold;
+            return this;
+        }
+
+        /**
+         * @param initialDelaySeconds How long to wait after the container starts before the first check
+         * 
+         * @return builder
+         * 
+         */
+        public Builder initialDelaySeconds(@Nullable Integer initialDelaySeconds) {
+            $.initialDelaySeconds = initialDelaySeconds;
+            return this;
+        }
+
+        /**
+         * @param path The path to request. Defaults to `/`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder path(@Nullable String path) {
+            $.path = path;
+            return this;
+        }
+
+        /**
+         * @param periodSeconds How often to check. Defaults to 10 seconds
+         * 
+         * @return builder
+         * 
+         */
+        public Builder periodSeconds(@Nullable Integer periodSeconds) {
+            $.periodSeconds = periodSeconds;
+            return this;
+        }
+
+        /**
+         * @param port The container port to request. Defaults to the application&#39;s port
+         * 
+         * @return builder
+         * 
+         */
+        public Builder port(@Nullable Integer port) {
+            $.port = port;
+            return this;
+        }
+
+        /**
+         * @param timeoutSeconds How long a check may take. Defaults to 1 second
+         * 
+         * @return builder
+         * 
+         */
+        public Builder timeoutSeconds(@Nullable Integer timeoutSeconds) {
+            $.timeoutSeconds = timeoutSeconds;
+            return this;
+        }
+
+        public Probe build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,240 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Caps what the application may consume in its namespace.
+ * 
+ */
+public final class Quota extends com.pulumi.resources.InvokeArgs {
+
+    public static final Quota Empty = new Quota();
+
+    /**
+     * The total CPU limit of all pods in the namespace, such as `4`
+     * 
+     */
+    @Import(name="cpu")
+    private @Nullable String cpu;
+
+    /**
+     * @return The total CPU limit of all pods in the namespace, such as `4`
+     * 
+     */
+    public Optional<String> cpu() {
+        return Optional.ofNullable(this.cpu);
+    }
+
+    /**
+     * Resource limits for containers that don&#39;t set their own. Defaults to 500m CPU and 512Mi memory
+     * 
+     */
+    @Import(name="defaultLimits")
+    private @Nullable Map<String,String> defaultLimits;
+
+    /**
+     * @return Resource limits for containers that don&#39;t set their own. Defaults to 500m CPU and 512Mi memory
+     * 
+     */
+    public Optional<Map<String,String>> defaultLimits() {
+        return Optional.ofNullable(this.defaultLimits);
+    }
+
+    /**
+     * Resource requests for containers that don&#39;t set their own. Defaults to 100m CPU and 128Mi memory
+     * 
+     */
+    @Import(name="defaultRequests")
+    private @Nullable Map<String,String> defaultRequests;
+
+    /**
+     * @return Resource requests for containers that don&#39;t set their own. Defaults to 100m CPU and 128Mi memory
+     * 
+     */
+    public Optional<Map<String,String>> defaultRequests() {
+        return Optional.ofNullable(this.defaultRequests);
+    }
+
+    /**
+     * The maximum number of LoadBalancer services in the namespace. Must be at least 1
+     * 
+     */
+    @Import(name="loadBalancers")
+    private @Nullable Integer loadBalancers;
+
+    /**
+     * @return The maximum number of LoadBalancer services in the namespace. Must be at least 1
+     * 
+     */
+    public Optional<Integer> loadBalancers() {
+        return Optional.ofNullable(this.loadBalancers);
+    }
+
+    /**
+     * The total memory limit of all pods in the namespace, such as `8Gi`
+     * 
+     */
+    @Import(name="memory")
+    private @Nullable String memory;
+
+    /**
+     * @return The total memory limit of all pods in the namespace, such as `8Gi`
+     * 
+     */
+    public Optional<String> memory() {
+        return Optional.ofNullable(this.memory);
+    }
+
+    /**
+     * The maximum number of persistent volume claims in the namespace
+     * 
+     */
+    @Import(name="persistentVolumeClaims")
+    private @Nullable Integer persistentVolumeClaims;
+
+    /**
+     * @return The maximum number of persistent volume claims in the namespace
+     * 
+     */
+    public Optional<Integer> persistentVolumeClaims() {
+        return Optional.ofNullable(this.persistentVolumeClaims);
+    }
+
+    /**
+     * The maximum number of pods in the namespace
+     * 
+     */
+    @Import(name="pods")
+    private @Nullable Integer pods;
+
+    /**
+     * @return The maximum number of pods in the namespace
+     * 
+     */
+    public Optional<Integer> pods() {
+        return Optional.ofNullable(this.pods);
+    }
+
+    private Quota() {}
+
+    private Quota(Quota $) {
+        this.cpu = $.cpu;
+        this.defaultLimits = $.defaultLimits;
+        this.defaultRequests = $.defaultRequests;
+        this.loadBalancers = $.loadBalancers;
+        this.memory = $.memory;
+        this.persistentVolumeClaims = $.persistentVolumeClaims;
+        this.pods = $.pods;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Quota defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Quota $;
+
+        public Builder() {
+            $ = new Quota();
+        }
+
+        public Builder(Quota defaults) {
+            $ = new Quota(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param cpu The total CPU limit of all pods in the namespace, such as `4`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder cpu(@Nullable String cpu) {
+            $.cpu = cpu;
+            return this;
+        }
+
+        /**
+         * @param defaultLimits Resource limits for containers that don&#39;t set their own. Defaults to 500m CPU and 512Mi memory
+         * 
+         * @return builder
+         * 
+         */
+        public Builder defaultLimits(@Nullable Map<String,String> defaultLimits) {
+            $.defaultLimits = defaultLimits;
+            return this;
+        }
+
+        /**
+         * @param defaultRequests Resource requests for containers that don&#39;t set their own. Defaults to 100m CPU and 128Mi memory
+         * 
+         * @return builder
+         * 
+         */
+        public Builder defaultRequests(@Nullable Map<String,String> defaultRequests) {
+            $.defaultRequests = defaultRequests;
+            return this;
+        }
+
+        /**
+         * @param loadBalancers The maximum number of LoadBalancer services in the namespace. Must be at least 1
+         * 
+         * @return builder
+         * 
+         */
+        public Builder loadBalancers(@Nullable Integer loadBalancers) {
+            $.loadBalancers = loadBalancers;
+            return this;
+        }
+
+        /**
+         * @param memory The total memory limit of all pods in the namespace, such as `8Gi`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder memory(@Nullable String memory) {
+            $.memory = memory;
+            return this;
+        }
+
+        /**
+         * @param persistentVolumeClaims The maximum number of persistent volume claims in the namespace
+         * 
+         * @return builder
+         * 
+         */
+        public Builder persistentVolumeClaims(@Nullable Integer persistentVolumeClaims) {
+            $.persistentVolumeClaims = persistentVolumeClaims;
+            return this;
+        }
+
+        /**
+         * @param pods The maximum number of pods in the namespace
+         * 
+         * @return builder
+         * 
+         */
+        public Builder pods(@Nullable Integer pods) {
+            $.pods = pods;
+            return this;
+        }
+
+        public Quota build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,157 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * The credentials the application&#39;s pods pull images with.
+ * 
+ */
+public final class RegistryCredentials extends com.pulumi.resources.InvokeArgs {
+
+    public static final RegistryCredentials Empty = new RegistryCredentials();
+
+    /**
+     * The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+     * 
+     */
+    @Import(name="existingSecretName")
+    private @Nullable String existingSecretName;
+
+    /**
+     * @return The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+     * 
+     */
+    public Optional<String> existingSecretName() {
+        return Optional.ofNullable(this.existingSecretName);
+    }
+
+    /**
+     * The password or token to authenticate with
+     * 
+     */
+    @Import(name="password")
+    private @Nullable String password;
+
+    /**
+     * @return The password or token to authenticate with
+     * 
+     */
+    public Optional<String> password() {
+        return Optional.ofNullable(this.password);
+    }
+
+    /**
+     * The registry server, such as `ghcr.io`
+     * 
+     */
+    @Import(name="server")
+    private @Nullable String server;
+
+    /**
+     * @return The registry server, such as `ghcr.io`
+     * 
+     */
+    public Optional<String> server() {
+        return Optional.ofNullable(this.server);
+    }
+
+    /**
+     * The username to authenticate with
+     * 
+     */
+    @Import(name="username")
+    private @Nullable String username;
+
+    /**
+     * @return The username to authenticate with
+     * 
+     */
+    public Optional<String> username() {
+        return Optional.ofNullable(this.username);
+    }
+
+    private RegistryCredentials() {}
+
+    private RegistryCredentials(RegistryCredentials $) {
+        this.existingSecretName = $.existingSecretName;
+        this.password = $.password;
+        this.server = $.server;
+        this.username = $.username;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(RegistryCredentials defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private RegistryCredentials $;
+
+        public Builder() {
+            $ = new RegistryCredentials();
+        }
+
+        public Builder(RegistryCredentials defaults) {
+            $ = new RegistryCredentials(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param existingSecretName The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder existingSecretName(@Nullable String existingSecretName) {
+            $.existingSecretName = existingSecretName;
+            return this;
+        }
+
+        /**
+         * @param password The password or token to authenticate with
+         * 
+         * @return builder
+         * 
+         */
+        public Builder password(@Nullable String password) {
+            $.password = password;
+            return this;
+        }
+
+        /**
+         * @param server The registry server, such as `ghcr.io`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder server(@Nullable String server) {
+            $.server = server;
+            return this;
+        }
+
+        /**
+         * @param username The username to authenticate with
+         * 
+         * @return builder
+         * 
+         */
+        public Builder username(@Nullable String username) {
+            $.username = username;
+            return this;
+        }
+
+        public RegistryCredentials build() {
+            return $;
+        }
+    }
+
+}
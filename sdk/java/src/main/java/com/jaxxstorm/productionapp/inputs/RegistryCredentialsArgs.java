@@ -0,0 +1,198 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.Output;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * The credentials the application&#39;s pods pull images with.
+ * 
+ */
+public final class RegistryCredentialsArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final RegistryCredentialsArgs Empty = new RegistryCredentialsArgs();
+
+    /**
+     * The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+     * 
+     */
+    @Import(name="existingSecretName")
+    private @Nullable Output<String> existingSecretName;
+
+    /**
+     * @return The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+     * 
+     */
+    public Optional<Output<String>> existingSecretName() {
+        return Optional.ofNullable(this.existingSecretName);
+    }
+
+    /**
+     * The password or token to authenticate with
+     * 
+     */
+    @Import(name="password")
+    private @Nullable Output<String> password;
+
+    /**
+     * @return The password or token to authenticate with
+     * 
+     */
+    public Optional<Output<String>> password() {
+        return Optional.ofNullable(this.password);
+    }
+
+    /**
+     * The registry server, such as `ghcr.io`
+     * 
+     */
+    @Import(name="server")
+    private @Nullable Output<String> server;
+
+    /**
+     * @return The registry server, such as `ghcr.io`
+     * 
+     */
+    public Optional<Output<String>> server() {
+        return Optional.ofNullable(this.server);
+    }
+
+    /**
+     * The username to authenticate with
+     * 
+     */
+    @Import(name="username")
+    private @Nullable Output<String> username;
+
+    /**
+     * @return The username to authenticate with
+     * 
+     */
+    public Optional<Output<String>> username() {
+        return Optional.ofNullable(this.username);
+    }
+
+    private RegistryCredentialsArgs() {}
+
+    private RegistryCredentialsArgs(RegistryCredentialsArgs $) {
+        this.existingSecretName = $.existingSecretName;
+        this.password = $.password;
+        this.server = $.server;
+        this.username = $.username;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(RegistryCredentialsArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private RegistryCredentialsArgs $;
+
+        public Builder() {
+            $ = new RegistryCredentialsArgs();
+        }
+
+        public Builder(RegistryCredentialsArgs defaults) {
+            $ = new RegistryCredentialsArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param existingSecretName The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder existingSecretName(@Nullable Output<String> existingSecretName) {
+            $.existingSecretName = existingSecretName;
+            return this;
+        }
+
+        /**
+         * @param existingSecretName The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder existingSecretName(String existingSecretName) {
+            return existingSecretName(Output.of(existingSecretName));
+        }
+
+        /**
+         * @param password The password or token to authenticate with
+         * 
+         * @return builder
+         * 
+         */
+        public Builder password(@Nullable Output<String> password) {
+            $.password = password;
+            return this;
+        }
+
+        /**
+         * @param password The password or token to authenticate with
+         * 
+         * @return builder
+         * 
+         */
+        public Builder password(String password) {
+            return password(Output.of(password));
+        }
+
+        /**
+         * @param server The registry server, such as `ghcr.io`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder server(@Nullable Output<String> server) {
+            $.server = server;
+            return this;
+        }
+
+        /**
+         * @param server The registry server, such as `ghcr.io`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder server(String server) {
+            return server(Output.of(server));
+        }
+
+        /**
+         * @param username The username to authenticate with
+         * 
+         * @return builder
+         * 
+         */
+        public Builder username(@Nullable Output<String> username) {
+            $.username = username;
+            return this;
+        }
+
+        /**
+         * @param username The username to authenticate with
+         * 
+         * @return builder
+         * 
+         */
+        public Builder username(String username) {
+            return username(Output.of(username));
+        }
+
+        public RegistryCredentialsArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,104 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Overrides the application container&#39;s resource requests and limits. Each entry overrides the one the environment or size sets.
+ * 
+ */
+public final class Resources extends com.pulumi.resources.InvokeArgs {
+
+    public static final Resources Empty = new Resources();
+
+    /**
+     * The resource limits, such as `cpu: &#34;1&#34;` or `memory: 1Gi`
+     * 
+     */
+    @Import(name="limits")
+    private @Nullable Map<String,String> limits;
+
+    /**
+     * @return The resource limits, such as `cpu: &#34;1&#34;` or `memory: 1Gi`
+     * 
+     */
+    public Optional<Map<String,String>> limits() {
+        return Optional.ofNullable(this.limits);
+    }
+
+    /**
+     * The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+     * 
+     */
+    @Import(name="requests")
+    private @Nullable Map<String,String> requests;
+
+    /**
+     * @return The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+     * 
+     */
+    public Optional<Map<String,String>> requests() {
+        return Optional.ofNullable(this.requests);
+    }
+
+    private Resources() {}
+
+    private Resources(Resources $) {
+        this.limits = $.limits;
+        this.requests = $.requests;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Resources defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Resources $;
+
+        public Builder() {
+            $ = new Resources();
+        }
+
+        public Builder(Resources defaults) {
+            $ = new Resources(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param limits The resource limits, such as `cpu: &#34;1&#34;` or `memory: 1Gi`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder limits(@Nullable Map<String,String> limits) {
+            $.limits = limits;
+            return this;
+        }
+
+        /**
+         * @param requests The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder requests(@Nullable Map<String,String> requests) {
+            $.requests = requests;
+            return this;
+        }
+
+        public Resources build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * A volume populated from a Secret.
+ * 
+ */
+public final class SecretVolume extends com.pulumi.resources.InvokeArgs {
+
+    public static final SecretVolume Empty = new SecretVolume();
+
+    /**
+     * The name of the Secret in the application namespace
+     * 
+     */
+    @Import(name="secretName", required=true)
+    private String secretName;
+
+    /**
+     * @return The name of the Secret in the application namespace
+     * 
+     */
+    public String secretName() {
+        return this.secretName;
+    }
+
+    private SecretVolume() {}
+
+    private SecretVolume(SecretVolume $) {
+        this.secretName = $.secretName;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(SecretVolume defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private SecretVolume $;
+
+        public Builder() {
+            $ = new SecretVolume();
+        }
+
+        public Builder(SecretVolume defaults) {
+            $ = new SecretVolume(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param secretName The name of the Secret in the application namespace
+         * 
+         * @return builder
+         * 
+         */
+        public Builder secretName(String secretName) {
+            $.secretName = secretName;
+            return this;
+        }
+
+        public SecretVolume build() {
+            $.secretName = Objects.requireNonNull($.secretName, "expected parameter 'secretName' to be non-null");
+            return $;
+        }
+    }
+
+}
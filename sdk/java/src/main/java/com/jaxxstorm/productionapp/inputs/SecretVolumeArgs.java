@@ -0,0 +1,75 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+
+
+/**
+ * A volume populated from a Secret.
+ * 
+ */
+public final class SecretVolumeArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final SecretVolumeArgs Empty = new SecretVolumeArgs();
+
+    /**
+     * The name of the Secret in the application namespace
+     * 
+     */
+    @Import(name="secretName", required=true)
+    private String secretName;
+
+    /**
+     * @return The name of the Secret in the application namespace
+     * 
+     */
+    public String secretName() {
+        return this.secretName;
+    }
+
+    private SecretVolumeArgs() {}
+
+    private SecretVolumeArgs(SecretVolumeArgs $) {
+        this.secretName = $.secretName;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(SecretVolumeArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private SecretVolumeArgs $;
+
+        public Builder() {
+            $ = new SecretVolumeArgs();
+        }
+
+        public Builder(SecretVolumeArgs defaults) {
+            $ = new SecretVolumeArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param secretName The name of the Secret in the application namespace
+         * 
+         * @return builder
+         * 
+         */
+        public Builder secretName(String secretName) {
+            $.secretName = secretName;
+            return this;
+        }
+
+        public SecretVolumeArgs build() {
+            $.secretName = Objects.requireNonNull($.secretName, "expected parameter 'secretName' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,142 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Configures how the application&#39;s pods are stopped.
+ * 
+ */
+public final class Shutdown extends com.pulumi.resources.InvokeArgs {
+
+    public static final Shutdown Empty = new Shutdown();
+
+    /**
+     * A command to run in the preStop hook instead of sleeping
+     * 
+     */
+    @Import(name="preStopCommand")
+    private @Nullable List<String> preStopCommand;
+
+    /**
+     * @return A command to run in the preStop hook instead of sleeping
+     * 
+     */
+    public Optional<List<String>> preStopCommand() {
+        return Optional.ofNullable(this.preStopCommand);
+    }
+
+    /**
+     * How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+     * 
+     */
+    @Import(name="preStopSleepSeconds")
+    private @Nullable Integer preStopSleepSeconds;
+
+    /**
+     * @return How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+     * 
+     */
+    public Optional<Integer> preStopSleepSeconds() {
+        return Optional.ofNullable(this.preStopSleepSeconds);
+    }
+
+    /**
+     * How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+     * 
+     */
+    @Import(name="terminationGracePeriodSeconds")
+    private @Nullable Integer terminationGracePeriodSeconds;
+
+    /**
+     * @return How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+     * 
+     */
+    public Optional<Integer> terminationGracePeriodSeconds() {
+        return Optional.ofNullable(this.terminationGracePeriodSeconds);
+    }
+
+    private Shutdown() {}
+
+    private Shutdown(Shutdown $) {
+        this.preStopCommand = $.preStopCommand;
+        this.preStopSleepSeconds = $.preStopSleepSeconds;
+        this.terminationGracePeriodSeconds = $.terminationGracePeriodSeconds;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Shutdown defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Shutdown $;
+
+        public Builder() {
+            $ = new Shutdown();
+        }
+
+        public Builder(Shutdown defaults) {
+            $ = new Shutdown(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param preStopCommand A command to run in the preStop hook instead of sleeping
+         * 
+         * @return builder
+         * 
+         */
+        public Builder preStopCommand(@Nullable List<String> preStopCommand) {
+            $.preStopCommand = preStopCommand;
+            return this;
+        }
+
+        /**
+         * @param preStopCommand A command to run in the preStop hook instead of sleeping
+         * 
+         * @return builder
+         * 
+         */
+        public Builder preStopCommand(String... preStopCommand) {
+            return preStopCommand(List.of(preStopCommand));
+        }
+
+        /**
+         * @param preStopSleepSeconds How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+         * 
+         * @return builder
+         * 
+         */
+        public Builder preStopSleepSeconds(@Nullable Integer preStopSleepSeconds) {
+            $.preStopSleepSeconds = preStopSleepSeconds;
+            return this;
+        }
+
+        /**
+         * @param terminationGracePeriodSeconds How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+         * 
+         * @return builder
+         * 
+         */
+        public Builder terminationGracePeriodSeconds(@Nullable Integer terminationGracePeriodSeconds) {
+            $.terminationGracePeriodSeconds = terminationGracePeriodSeconds;
+            return this;
+        }
+
+        public Shutdown build() {
+            return $;
+        }
+    }
+
+}
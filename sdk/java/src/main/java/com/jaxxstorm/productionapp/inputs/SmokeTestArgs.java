@@ -0,0 +1,185 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * An HTTP GET request made to the application&#39;s URL once it is deployed. It is not run during previews.
+ * 
+ */
+public final class SmokeTestArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final SmokeTestArgs Empty = new SmokeTestArgs();
+
+    /**
+     * Text the response&#39;s body must contain
+     * 
+     */
+    @Import(name="bodyContains")
+    private @Nullable String bodyContains;
+
+    /**
+     * @return Text the response&#39;s body must contain
+     * 
+     */
+    public Optional<String> bodyContains() {
+        return Optional.ofNullable(this.bodyContains);
+    }
+
+    /**
+     * The status code the response must have. Defaults to 200
+     * 
+     */
+    @Import(name="expectedStatus")
+    private @Nullable Integer expectedStatus;
+
+    /**
+     * @return The status code the response must have. Defaults to 200
+     * 
+     */
+    public Optional<Integer> expectedStatus() {
+        return Optional.ofNullable(this.expectedStatus);
+    }
+
+    /**
+     * How long to wait between attempts. Defaults to 5 seconds
+     * 
+     */
+    @Import(name="intervalSeconds")
+    private @Nullable Integer intervalSeconds;
+
+    /**
+     * @return How long to wait between attempts. Defaults to 5 seconds
+     * 
+     */
+    public Optional<Integer> intervalSeconds() {
+        return Optional.ofNullable(this.intervalSeconds);
+    }
+
+    /**
+     * The path to request. Defaults to `/`
+     * 
+     */
+    @Import(name="path")
+    private @Nullable String path;
+
+    /**
+     * @return The path to request. Defaults to `/`
+     * 
+     */
+    public Optional<String> path() {
+        return Optional.ofNullable(this.path);
+    }
+
+    /**
+     * How many times to retry the request before failing. Defaults to 10
+     * 
+     */
+    @Import(name="retries")
+    private @Nullable Integer retries;
+
+    /**
+     * @return How many times to retry the request before failing. Defaults to 10
+     * 
+     */
+    public Optional<Integer> retries() {
+        return Optional.ofNullable(this.retries);
+    }
+
+    private SmokeTestArgs() {}
+
+    private SmokeTestArgs(SmokeTestArgs $) {
+        this.bodyContains = $.bodyContains;
+        this.expectedStatus = $.expectedStatus;
+        this.intervalSeconds = $.intervalSeconds;
+        this.path = $.path;
+        this.retries = $.retries;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(SmokeTestArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private SmokeTestArgs $;
+
+        public Builder() {
+            $ = new SmokeTestArgs();
+        }
+
+        public Builder(SmokeTestArgs defaults) {
+            $ = new SmokeTestArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param bodyContains Text the response&#39;s body must contain
+         * 
+         * @return builder
+         * 
+         */
+        public Builder bodyContains(@Nullable String bodyContains) {
+            $.bodyContains = bodyContains;
+            return this;
+        }
+
+        /**
+         * @param expectedStatus The status code the response must have. Defaults to 200
+         * 
+         * @return builder
+         * 
+         */
+        public Builder expectedStatus(@Nullable Integer expectedStatus) {
+            $.expectedStatus = expectedStatus;
+            return this;
+        }
+
+        /**
+         * @param intervalSeconds How long to wait between attempts. Defaults to 5 seconds
+         * 
+         * @return builder
+         * 
+         */
+        public Builder intervalSeconds(@Nullable Integer intervalSeconds) {
+            $.intervalSeconds = intervalSeconds;
+            return this;
+        }
+
+        /**
+         * @param path The path to request. Defaults to `/`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder path(@Nullable String path) {
+            $.path = path;
+            return this;
+        }
+
+        /**
+         * @param retries How many times to retry the request before failing. Defaults to 10
+         * 
+         * @return builder
+         * 
+         */
+        public Builder retries(@Nullable Integer retries) {
+            $.retries = retries;
+            return this;
+        }
+
+        public SmokeTestArgs build() {
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,189 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.jaxxstorm.productionapp.inputs.ConfigMapVolume;
+import com.jaxxstorm.productionapp.inputs.EmptyDirVolume;
+import com.jaxxstorm.productionapp.inputs.HostPathVolume;
+import com.jaxxstorm.productionapp.inputs.SecretVolume;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+ * 
+ */
+public final class Volume extends com.pulumi.resources.InvokeArgs {
+
+    public static final Volume Empty = new Volume();
+
+    /**
+     * The contents of a ConfigMap
+     * 
+     */
+    @Import(name="configMap")
+    private @Nullable ConfigMapVolume configMap;
+
+    /**
+     * @return The contents of a ConfigMap
+     * 
+     */
+    public Optional<ConfigMapVolume> configMap() {
+        return Optional.ofNullable(this.configMap);
+    }
+
+    /**
+     * A scratch directory that lives as long as the pod
+     * 
+     */
+    @Import(name="emptyDir")
+    private @Nullable EmptyDirVolume emptyDir;
+
+    /**
+     * @return A scratch directory that lives as long as the pod
+     * 
+     */
+    public Optional<EmptyDirVolume> emptyDir() {
+        return Optional.ofNullable(this.emptyDir);
+    }
+
+    /**
+     * A path on the node. Requires `allowHostPath`
+     * 
+     */
+    @Import(name="hostPath")
+    private @Nullable HostPathVolume hostPath;
+
+    /**
+     * @return A path on the node. Requires `allowHostPath`
+     * 
+     */
+    public Optional<HostPathVolume> hostPath() {
+        return Optional.ofNullable(this.hostPath);
+    }
+
+    /**
+     * The name of the volume, referenced by `volumeMounts`
+     * 
+     */
+    @Import(name="name", required=true)
+    private String name;
+
+    /**
+     * @return The name of the volume, referenced by `volumeMounts`
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+
+    /**
+     * The contents of a Secret
+     * 
+     */
+    @Import(name="secret")
+    private @Nullable SecretVolume secret;
+
+    /**
+     * @return The contents of a Secret
+     * 
+     */
+    public Optional<SecretVolume> secret() {
+        return Optional.ofNullable(this.secret);
+    }
+
+    private Volume() {}
+
+    private Volume(Volume $) {
+        this.configMap = $.configMap;
+        this.emptyDir = $.emptyDir;
+        this.hostPath = $.hostPath;
+        this.name = $.name;
+        this.secret = $.secret;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(Volume defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Volume $;
+
+        public Builder() {
+            $ = new Volume();
+        }
+
+        public Builder(Volume defaults) {
+            $ = new Volume(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param configMap The contents of a ConfigMap
+         * 
+         * @return builder
+         * 
+         */
+        public Builder configMap(@Nullable ConfigMapVolume configMap) {
+            $.configMap = configMap;
+            return this;
+        }
+
+        /**
+         * @param emptyDir A scratch directory that lives as long as the pod
+         * 
+         * @return builder
+         * 
+         */
+        public Builder emptyDir(@Nullable EmptyDirVolume emptyDir) {
+            $.emptyDir = emptyDir;
+            return this;
+        }
+
+        /**
+         * @param hostPath A path on the node. Requires `allowHostPath`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hostPath(@Nullable HostPathVolume hostPath) {
+            $.hostPath = hostPath;
+            return this;
+        }
+
+        /**
+         * @param name The name of the volume, referenced by `volumeMounts`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            $.name = name;
+            return this;
+        }
+
+        /**
+         * @param secret The contents of a Secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder secret(@Nullable SecretVolume secret) {
+            $.secret = secret;
+            return this;
+        }
+
+        public Volume build() {
+            $.name = Objects.requireNonNull($.name, "expected parameter 'name' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,189 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.jaxxstorm.productionapp.inputs.ConfigMapVolumeArgs;
+import com.jaxxstorm.productionapp.inputs.EmptyDirVolumeArgs;
+import com.jaxxstorm.productionapp.inputs.HostPathVolumeArgs;
+import com.jaxxstorm.productionapp.inputs.SecretVolumeArgs;
+import com.pulumi.core.annotations.Import;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+ * 
+ */
+public final class VolumeArgs extends com.pulumi.resources.ResourceArgs {
+
+    public static final VolumeArgs Empty = new VolumeArgs();
+
+    /**
+     * The contents of a ConfigMap
+     * 
+     */
+    @Import(name="configMap")
+    private @Nullable ConfigMapVolumeArgs configMap;
+
+    /**
+     * @return The contents of a ConfigMap
+     * 
+     */
+    public Optional<ConfigMapVolumeArgs> configMap() {
+        return Optional.ofNullable(this.configMap);
+    }
+
+    /**
+     * A scratch directory that lives as long as the pod
+     * 
+     */
+    @Import(name="emptyDir")
+    private @Nullable EmptyDirVolumeArgs emptyDir;
+
+    /**
+     * @return A scratch directory that lives as long as the pod
+     * 
+     */
+    public Optional<EmptyDirVolumeArgs> emptyDir() {
+        return Optional.ofNullable(this.emptyDir);
+    }
+
+    /**
+     * A path on the node. Requires `allowHostPath`
+     * 
+     */
+    @Import(name="hostPath")
+    private @Nullable HostPathVolumeArgs hostPath;
+
+    /**
+     * @return A path on the node. Requires `allowHostPath`
+     * 
+     */
+    public Optional<HostPathVolumeArgs> hostPath() {
+        return Optional.ofNullable(this.hostPath);
+    }
+
+    /**
+     * The name of the volume, referenced by `volumeMounts`
+     * 
+     */
+    @Import(name="name", required=true)
+    private String name;
+
+    /**
+     * @return The name of the volume, referenced by `volumeMounts`
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+
+    /**
+     * The contents of a Secret
+     * 
+     */
+    @Import(name="secret")
+    private @Nullable SecretVolumeArgs secret;
+
+    /**
+     * @return The contents of a Secret
+     * 
+     */
+    public Optional<SecretVolumeArgs> secret() {
+        return Optional.ofNullable(this.secret);
+    }
+
+    private VolumeArgs() {}
+
+    private VolumeArgs(VolumeArgs $) {
+        this.configMap = $.configMap;
+        this.emptyDir = $.emptyDir;
+        this.hostPath = $.hostPath;
+        this.name = $.name;
+        this.secret = $.secret;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(VolumeArgs defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private VolumeArgs $;
+
+        public Builder() {
+            $ = new VolumeArgs();
+        }
+
+        public Builder(VolumeArgs defaults) {
+            $ = new VolumeArgs(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param configMap The contents of a ConfigMap
+         * 
+         * @return builder
+         * 
+         */
+        public Builder configMap(@Nullable ConfigMapVolumeArgs configMap) {
+            $.configMap = configMap;
+            return this;
+        }
+
+        /**
+         * @param emptyDir A scratch directory that lives as long as the pod
+         * 
+         * @return builder
+         * 
+         */
+        public Builder emptyDir(@Nullable EmptyDirVolumeArgs emptyDir) {
+            $.emptyDir = emptyDir;
+            return this;
+        }
+
+        /**
+         * @param hostPath A path on the node. Requires `allowHostPath`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder hostPath(@Nullable HostPathVolumeArgs hostPath) {
+            $.hostPath = hostPath;
+            return this;
+        }
+
+        /**
+         * @param name The name of the volume, referenced by `volumeMounts`
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            $.name = name;
+            return this;
+        }
+
+        /**
+         * @param secret The contents of a Secret
+         * 
+         * @return builder
+         * 
+         */
+        public Builder secret(@Nullable SecretVolumeArgs secret) {
+            $.secret = secret;
+            return this;
+        }
+
+        public VolumeArgs build() {
+            $.name = Objects.requireNonNull($.name, "expected parameter 'name' to be non-null");
+            return $;
+        }
+    }
+
+}
@@ -0,0 +1,160 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.inputs;
+
+import com.pulumi.core.annotations.Import;
+import java.lang.Boolean;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+
+/**
+ * Mounts one of the application&#39;s volumes into its container.
+ * 
+ */
+public final class VolumeMount extends com.pulumi.resources.InvokeArgs {
+
+    public static final VolumeMount Empty = new VolumeMount();
+
+    /**
+     * Where to mount the volume
+     * 
+     */
+    @Import(name="mountPath", required=true)
+    private String mountPath;
+
+    /**
+     * @return Where to mount the volume
+     * 
+     */
+    public String mountPath() {
+        return this.mountPath;
+    }
+
+    /**
+     * The name of the volume to mount
+     * 
+     */
+    @Import(name="name", required=true)
+    private String name;
+
+    /**
+     * @return The name of the volume to mount
+     * 
+     */
+    public String name() {
+        return this.name;
+    }
+
+    /**
+     * Whether to mount the volume read-only
+     * 
+     */
+    @Import(name="readOnly")
+    private @Nullable Boolean readOnly;
+
+    /**
+     * @return Whether to mount the volume read-only
+     * 
+     */
+    public Optional<Boolean> readOnly() {
+        return Optional.ofNullable(this.readOnly);
+    }
+
+    /**
+     * A path within the volume to mount instead of its root
+     * 
+     */
+    @Import(name="subPath")
+    private @Nullable String subPath;
+
+    /**
+     * @return A path within the volume to mount instead of its root
+     * 
+     */
+    public Optional<String> subPath() {
+        return Optional.ofNullable(this.subPath);
+    }
+
+    private VolumeMount() {}
+
+    private VolumeMount(VolumeMount $) {
+        this.mountPath = $.mountPath;
+        this.name = $.name;
+        this.readOnly = $.readOnly;
+        this.subPath = $.subPath;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+    public static Builder builder(VolumeMount defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private VolumeMount $;
+
+        public Builder() {
+            $ = new VolumeMount();
+        }
+
+        public Builder(VolumeMount defaults) {
+            $ = new VolumeMount(Objects.requireNonNull(defaults));
+        }
+
+        /**
+         * @param mountPath Where to mount the volume
+         * 
+         * @return builder
+         * 
+         */
+        public Builder mountPath(String mountPath) {
+            $.mountPath = mountPath;
+            return this;
+        }
+
+        /**
+         * @param name The name of the volume to mount
+         * 
+         * @return builder
+         * 
+         */
+        public Builder name(String name) {
+            $.name = name;
+            return this;
+        }
+
+        /**
+         * @param readOnly Whether to mount the volume read-only
+         * 
+         * @return builder
+         * 
+         */
+        public Builder readOnly(@Nullable Boolean readOnly) {
+            $.readOnly = readOnly;
+            return this;
+        }
+
+        /**
+         * @param subPath A path within the volume to mount instead of its root
+         * 
+         * @return builder
+         * 
+         */
+        public Builder subPath(@Nullable String subPath) {
+            $.subPath = subPath;
+            return this;
+        }
+
+        public VolumeMount build() {
+            $.mountPath = Objects.requireNonNull($.mountPath, "expected parameter 'mountPath' to be non-null");
+            $.name = Objects.requireNonNull($.name, "expected parameter 'name' to be non-null");
+            return $;
+        }
+    }
+
+}
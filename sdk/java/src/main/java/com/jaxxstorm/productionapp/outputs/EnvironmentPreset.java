@@ -0,0 +1,204 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.outputs;
+
+import com.jaxxstorm.productionapp.outputs.ResourcePreset;
+import com.pulumi.core.annotations.CustomType;
+import java.lang.Boolean;
+import java.lang.Integer;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class EnvironmentPreset {
+    /**
+     * @return Whether the pods are spread across nodes
+     * 
+     */
+    private final Boolean antiAffinity;
+    /**
+     * @return The application container&#39;s image pull policy
+     * 
+     */
+    private final String imagePullPolicy;
+    /**
+     * @return The number of pods the HorizontalPodAutoscaler scales up to. Unset if there is no HorizontalPodAutoscaler
+     * 
+     */
+    private final @Nullable Integer maxReplicas;
+    /**
+     * @return The minimum number or percentage of pods the PodDisruptionBudget keeps available. Unset if there is no PodDisruptionBudget
+     * 
+     */
+    private final @Nullable String minAvailable;
+    /**
+     * @return Whether the namespace is protected from deletion
+     * 
+     */
+    private final Boolean protectNamespace;
+    /**
+     * @return The number of pods
+     * 
+     */
+    private final Integer replicas;
+    /**
+     * @return The application container&#39;s resource requests and limits
+     * 
+     */
+    private final ResourcePreset resources;
+    /**
+     * @return The CPU utilization the HorizontalPodAutoscaler scales at. Unset if there is no HorizontalPodAutoscaler
+     * 
+     */
+    private final @Nullable Integer targetCpuUtilizationPercentage;
+
+    @CustomType.Constructor
+    private EnvironmentPreset(
+        @CustomType.Parameter("antiAffinity") Boolean antiAffinity,
+        @CustomType.Parameter("imagePullPolicy") String imagePullPolicy,
+        @CustomType.Parameter("maxReplicas") @Nullable Integer maxReplicas,
+        @CustomType.Parameter("minAvailable") @Nullable String minAvailable,
+        @CustomType.Parameter("protectNamespace") Boolean protectNamespace,
+        @CustomType.Parameter("replicas") Integer replicas,
+        @CustomType.Parameter("resources") ResourcePreset resources,
+        @CustomType.Parameter("targetCpuUtilizationPercentage") @Nullable Integer targetCpuUtilizationPercentage) {
+        this.antiAffinity = antiAffinity;
+        this.imagePullPolicy = imagePullPolicy;
+        this.maxReplicas = maxReplicas;
+        this.minAvailable = minAvailable;
+        this.protectNamespace = protectNamespace;
+        this.replicas = replicas;
+        this.resources = resources;
+        this.targetCpuUtilizationPercentage = targetCpuUtilizationPercentage;
+    }
+
+    /**
+     * @return Whether the pods are spread across nodes
+     * 
+     */
+    public Boolean antiAffinity() {
+        return this.antiAffinity;
+    }
+    /**
+     * @return The application container&#39;s image pull policy
+     * 
+     */
+    public String imagePullPolicy() {
+        return this.imagePullPolicy;
+    }
+    /**
+     * @return The number of pods the HorizontalPodAutoscaler scales up to. Unset if there is no HorizontalPodAutoscaler
+     * 
+     */
+    public Optional<Integer> maxReplicas() {
+        return Optional.ofNullable(this.maxReplicas);
+    }
+    /**
+     * @return The minimum number or percentage of pods the PodDisruptionBudget keeps available. Unset if there is no PodDisruptionBudget
+     * 
+     */
+    public Optional<String> minAvailable() {
+        return Optional.ofNullable(this.minAvailable);
+    }
+    /**
+     * @return Whether the namespace is protected from deletion
+     * 
+     */
+    public Boolean protectNamespace() {
+        return this.protectNamespace;
+    }
+    /**
+     * @return The number of pods
+     * 
+     */
+    public Integer replicas() {
+        return this.replicas;
+    }
+    /**
+     * @return The application container&#39;s resource requests and limits
+     * 
+     */
+    public ResourcePreset resources() {
+        return this.resources;
+    }
+    /**
+     * @return The CPU utilization the HorizontalPodAutoscaler scales at. Unset if there is no HorizontalPodAutoscaler
+     * 
+     */
+    public Optional<Integer> targetCpuUtilizationPercentage() {
+        return Optional.ofNullable(this.targetCpuUtilizationPercentage);
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(EnvironmentPreset defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Boolean antiAffinity;
+        private String imagePullPolicy;
+        private @Nullable Integer maxReplicas;
+        private @Nullable String minAvailable;
+        private Boolean protectNamespace;
+        private Integer replicas;
+        private ResourcePreset resources;
+        private @Nullable Integer targetCpuUtilizationPercentage;
+
+        public Builder() {
+    	      // Empty
+        }
+
+        public Builder(EnvironmentPreset defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.antiAffinity = defaults.antiAffinity;
+    	      this.imagePullPolicy = defaults.imagePullPolicy;
+    	      this.maxReplicas = defaults.maxReplicas;
+    	      this.minAvailable = defaults.minAvailable;
+    	      this.protectNamespace = defaults.protectNamespace;
+    	      this.replicas = defaults.replicas;
+    	      this.resources = defaults.resources;
+    	      this.targetCpuUtilizationPercentage = defaults.targetCpuUtilizationPercentage;
+        }
+
+        public Builder antiAffinity(Boolean antiAffinity) {
+            this.antiAffinity = Objects.requireNonNull(antiAffinity);
+            return this;
+        }
+        public Builder imagePullPolicy(String imagePullPolicy) {
+            this.imagePullPolicy = Objects.requireNonNull(imagePullPolicy);
+            return this;
+        }
+        public Builder maxReplicas(@Nullable Integer maxReplicas) {
+            this.maxReplicas = maxReplicas;
+            return this;
+        }
+        public Builder minAvailable(@Nullable String minAvailable) {
+            this.minAvailable = minAvailable;
+            return this;
+        }
+        public Builder protectNamespace(Boolean protectNamespace) {
+            this.protectNamespace = Objects.requireNonNull(protectNamespace);
+            return this;
+        }
+        public Builder replicas(Integer replicas) {
+            this.replicas = Objects.requireNonNull(replicas);
+            return this;
+        }
+        public Builder resources(ResourcePreset resources) {
+            this.resources = Objects.requireNonNull(resources);
+            return this;
+        }
+        public Builder targetCpuUtilizationPercentage(@Nullable Integer targetCpuUtilizationPercentage) {
+            this.targetCpuUtilizationPercentage = targetCpuUtilizationPercentage;
+            return this;
+        }        public EnvironmentPreset build() {
+            return new EnvironmentPreset(antiAffinity, imagePullPolicy, maxReplicas, minAvailable, protectNamespace, replicas, resources, targetCpuUtilizationPercentage);
+        }
+    }
+}
@@ -0,0 +1,82 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.outputs;
+
+import com.jaxxstorm.productionapp.outputs.EnvironmentPreset;
+import com.jaxxstorm.productionapp.outputs.ResourcePreset;
+import com.pulumi.core.annotations.CustomType;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+
+@CustomType
+public final class GetPresetsResult {
+    /**
+     * @return The environment presets, keyed by the name `environment` selects them with
+     * 
+     */
+    private final Map<String,EnvironmentPreset> environments;
+    /**
+     * @return The sizes, keyed by the name `size` selects them with
+     * 
+     */
+    private final Map<String,ResourcePreset> sizes;
+
+    @CustomType.Constructor
+    private GetPresetsResult(
+        @CustomType.Parameter("environments") Map<String,EnvironmentPreset> environments,
+        @CustomType.Parameter("sizes") Map<String,ResourcePreset> sizes) {
+        this.environments = environments;
+        this.sizes = sizes;
+    }
+
+    /**
+     * @return The environment presets, keyed by the name `environment` selects them with
+     * 
+     */
+    public Map<String,EnvironmentPreset> environments() {
+        return this.environments;
+    }
+    /**
+     * @return The sizes, keyed by the name `size` selects them with
+     * 
+     */
+    public Map<String,ResourcePreset> sizes() {
+        return this.sizes;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(GetPresetsResult defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Map<String,EnvironmentPreset> environments;
+        private Map<String,ResourcePreset> sizes;
+
+        public Builder() {
+    	      // Empty
+        }
+
+        public Builder(GetPresetsResult defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.environments = defaults.environments;
+    	      this.sizes = defaults.sizes;
+        }
+
+        public Builder environments(Map<String,EnvironmentPreset> environments) {
+            this.environments = Objects.requireNonNull(environments);
+            return this;
+        }
+        public Builder sizes(Map<String,ResourcePreset> sizes) {
+            this.sizes = Objects.requireNonNull(sizes);
+            return this;
+        }        public GetPresetsResult build() {
+            return new GetPresetsResult(environments, sizes);
+        }
+    }
+}
@@ -0,0 +1,80 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.String;
+import java.util.Map;
+import java.util.Objects;
+
+@CustomType
+public final class ResourcePreset {
+    /**
+     * @return The resource limits, keyed by resource name
+     * 
+     */
+    private final Map<String,String> limits;
+    /**
+     * @return The resources requested, keyed by resource name
+     * 
+     */
+    private final Map<String,String> requests;
+
+    @CustomType.Constructor
+    private ResourcePreset(
+        @CustomType.Parameter("limits") Map<String,String> limits,
+        @CustomType.Parameter("requests") Map<String,String> requests) {
+        this.limits = limits;
+        this.requests = requests;
+    }
+
+    /**
+     * @return The resource limits, keyed by resource name
+     * 
+     */
+    public Map<String,String> limits() {
+        return this.limits;
+    }
+    /**
+     * @return The resources requested, keyed by resource name
+     * 
+     */
+    public Map<String,String> requests() {
+        return this.requests;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(ResourcePreset defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private Map<String,String> limits;
+        private Map<String,String> requests;
+
+        public Builder() {
+    	      // Empty
+        }
+
+        public Builder(ResourcePreset defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.limits = defaults.limits;
+    	      this.requests = defaults.requests;
+        }
+
+        public Builder limits(Map<String,String> limits) {
+            this.limits = Objects.requireNonNull(limits);
+            return this;
+        }
+        public Builder requests(Map<String,String> requests) {
+            this.requests = Objects.requireNonNull(requests);
+            return this;
+        }        public ResourcePreset build() {
+            return new ResourcePreset(limits, requests);
+        }
+    }
+}
@@ -0,0 +1,62 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.String;
+import java.util.List;
+import java.util.Objects;
+
+@CustomType
+public final class ValidateArgsResult {
+    /**
+     * @return Every problem with the inputs, naming the offending input. Empty if they are valid
+     * 
+     */
+    private final List<String> problems;
+
+    @CustomType.Constructor
+    private ValidateArgsResult(@CustomType.Parameter("problems") List<String> problems) {
+        this.problems = problems;
+    }
+
+    /**
+     * @return Every problem with the inputs, naming the offending input. Empty if they are valid
+     * 
+     */
+    public List<String> problems() {
+        return this.problems;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(ValidateArgsResult defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private List<String> problems;
+
+        public Builder() {
+    	      // Empty
+        }
+
+        public Builder(ValidateArgsResult defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.problems = defaults.problems;
+        }
+
+        public Builder problems(List<String> problems) {
+            this.problems = Objects.requireNonNull(problems);
+            return this;
+        }
+        public Builder problems(String... problems) {
+            return problems(List.of(problems));
+        }        public ValidateArgsResult build() {
+            return new ValidateArgsResult(problems);
+        }
+    }
+}
@@ -0,0 +1,161 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+package com.jaxxstorm.productionapp.outputs;
+
+import com.pulumi.core.annotations.CustomType;
+import java.lang.String;
+import java.util.Objects;
+import java.util.Optional;
+import javax.annotation.Nullable;
+
+@CustomType
+public final class Workload {
+    /**
+     * @return The name of the cluster, when the application is deployed to several
+     * 
+     */
+    private final @Nullable String cluster;
+    /**
+     * @return The kubeconfig context the application was deployed with
+     * 
+     */
+    private final @Nullable String context;
+    /**
+     * @return The name of the application&#39;s Deployment
+     * 
+     */
+    private final String deployment;
+    /**
+     * @return The contents of the kubeconfig file, or the path to it, the application was deployed with
+     * 
+     */
+    private final @Nullable String kubeconfig;
+    /**
+     * @return The namespace the application runs in
+     * 
+     */
+    private final String namespace;
+    /**
+     * @return The URL of the application&#39;s Service
+     * 
+     */
+    private final String url;
+
+    @CustomType.Constructor
+    private Workload(
+        @CustomType.Parameter("cluster") @Nullable String cluster,
+        @CustomType.Parameter("context") @Nullable String context,
+        @CustomType.Parameter("deployment") String deployment,
+        @CustomType.Parameter("kubeconfig") @Nullable String kubeconfig,
+        @CustomType.Parameter("namespace") String namespace,
+        @CustomType.Parameter("url") String url) {
+        this.cluster = cluster;
+        this.context = context;
+        this.deployment = deployment;
+        this.kubeconfig = kubeconfig;
+        this.namespace = namespace;
+        this.url = url;
+    }
+
+    /**
+     * @return The name of the cluster, when the application is deployed to several
+     * 
+     */
+    public Optional<String> cluster() {
+        return Optional.ofNullable(this.cluster);
+    }
+    /**
+     * @return The kubeconfig context the application was deployed with
+     * 
+     */
+    public Optional<String> context() {
+        return Optional.ofNullable(this.context);
+    }
+    /**
+     * @return The name of the application&#39;s Deployment
+     * 
+     */
+    public String deployment() {
+        return this.deployment;
+    }
+    /**
+     * @return The contents of the kubeconfig file, or the path to it, the application was deployed with
+     * 
+     */
+    public Optional<String> kubeconfig() {
+        return Optional.ofNullable(this.kubeconfig);
+    }
+    /**
+     * @return The namespace the application runs in
+     * 
+     */
+    public String namespace() {
+        return this.namespace;
+    }
+    /**
+     * @return The URL of the application&#39;s Service
+     * 
+     */
+    public String url() {
+        return this.url;
+    }
+
+    public static Builder builder() {
+        return new Builder();
+    }
+
+    public static Builder builder(Workload defaults) {
+        return new Builder(defaults);
+    }
+
+    public static final class Builder {
+        private @Nullable String cluster;
+        private @Nullable String context;
+        private String deployment;
+        private @Nullable String kubeconfig;
+        private String namespace;
+        private String url;
+
+        public Builder() {
+    	      // Empty
+        }
+
+        public Builder(Workload defaults) {
+    	      Objects.requireNonNull(defaults);
+    	      this.cluster = defaults.cluster;
+    	      this.context = defaults.context;
+    	      this.deployment = defaults.deployment;
+    	      this.kubeconfig = defaults.kubeconfig;
+    	      this.namespace = defaults.namespace;
+    	      this.url = defaults.url;
+        }
+
+        public Builder cluster(@Nullable String cluster) {
+            this.cluster = cluster;
+            return this;
+        }
+        public Builder context(@Nullable String context) {
+            this.context = context;
+            return this;
+        }
+        public Builder deployment(String deployment) {
+            this.deployment = Objects.requireNonNull(deployment);
+            return this;
+        }
+        public Builder kubeconfig(@Nullable String kubeconfig) {
+            this.kubeconfig = kubeconfig;
+            return this;
+        }
+        public Builder namespace(String namespace) {
+            this.namespace = Objects.requireNonNull(namespace);
+            return this;
+        }
+        public Builder url(String url) {
+            this.url = Objects.requireNonNull(url);
+            return this;
+        }        public Workload build() {
+            return new Workload(cluster, context, deployment, kubeconfig, namespace, url);
+        }
+    }
+}
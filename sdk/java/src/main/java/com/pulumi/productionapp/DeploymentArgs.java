@@ -1,121 +0,0 @@
-// *** WARNING: this file was generated by Pulumi SDK Generator. ***
-// *** Do not edit by hand unless you're certain you know what you are doing! ***
-
-package com.pulumi.productionapp;
-
-import com.pulumi.core.Output;
-import com.pulumi.core.annotations.Import;
-import java.lang.Integer;
-import java.lang.String;
-import java.util.Objects;
-
-
-public final class DeploymentArgs extends com.pulumi.resources.ResourceArgs {
-
-    public static final DeploymentArgs Empty = new DeploymentArgs();
-
-    /**
-     * The image to deploy in your production application
-     * 
-     */
-    @Import(name="image", required=true)
-    private Output<String> image;
-
-    /**
-     * @return The image to deploy in your production application
-     * 
-     */
-    public Output<String> image() {
-        return this.image;
-    }
-
-    /**
-     * The port your container listens on
-     * 
-     */
-    @Import(name="port", required=true)
-    private Output<Integer> port;
-
-    /**
-     * @return The port your container listens on
-     * 
-     */
-    public Output<Integer> port() {
-        return this.port;
-    }
-
-    private DeploymentArgs() {}
-
-    private DeploymentArgs(DeploymentArgs $) {
-        this.image = $.image;
-        this.port = $.port;
-    }
-
-    public static Builder builder() {
-        return new Builder();
-    }
-    public static Builder builder(DeploymentArgs defaults) {
-        return new Builder(defaults);
-    }
-
-    public static final class Builder {
-        private DeploymentArgs $;
-
-        public Builder() {
-            $ = new DeploymentArgs();
-        }
-
-        public Builder(DeploymentArgs defaults) {
-            $ = new DeploymentArgs(Objects.requireNonNull(defaults));
-        }
-
-        /**
-         * @param image The image to deploy in your production application
-         * 
-         * @return builder
-         * 
-         */
-        public Builder image(Output<String> image) {
-            $.image = image;
-            return this;
-        }
-
-        /**
-         * @param image The image to deploy in your production application
-         * 
-         * @return builder
-         * 
-         */
-        public Builder image(String image) {
-            return image(Output.of(image));
-        }
-
-        /**
-         * @param port The port your container listens on
-         * 
-         * @return builder
-         * 
-         */
-        public Builder port(Output<Integer> port) {
-            $.port = port;
-            return this;
-        }
-
-        /**
-         * @param port The port your container listens on
-         * 
-         * @return builder
-         * 
-         */
-        public Builder port(Integer port) {
-            return port(Output.of(port));
-        }
-
-        public DeploymentArgs build() {
-            $.image = Objects.requireNonNull($.image, "expected parameter 'image' to be non-null");
-            $.port = Objects.requireNonNull($.port, "expected parameter 'port' to be non-null");
-            return $;
-        }
-    }
-
-}
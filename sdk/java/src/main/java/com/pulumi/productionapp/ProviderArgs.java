@@ -1,28 +0,0 @@
-// *** WARNING: this file was generated by Pulumi SDK Generator. ***
-// *** Do not edit by hand unless you're certain you know what you are doing! ***
-
-package com.pulumi.productionapp;
-
-
-
-
-public final class ProviderArgs extends com.pulumi.resources.ResourceArgs {
-
-    public static final ProviderArgs Empty = new ProviderArgs();
-
-    public static Builder builder() {
-        return new Builder();
-    }
-
-    public static final class Builder {
-        private ProviderArgs $;
-
-        public Builder() {
-            $ = new ProviderArgs();
-        }
-        public ProviderArgs build() {
-            return $;
-        }
-    }
-
-}
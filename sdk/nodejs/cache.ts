@@ -0,0 +1,90 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * A Redis cache running in the cluster.
+ */
+export class Cache extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'productionapp:index:Cache';
+
+    /**
+     * Returns true if the given object is an instance of Cache.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Cache {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Cache.__pulumiType;
+    }
+
+    /**
+     * A redis:// connection string for the cache
+     */
+    public /*out*/ readonly connectionString!: pulumi.Output<string>;
+    /**
+     * The in-cluster hostname of the cache
+     */
+    public /*out*/ readonly host!: pulumi.Output<string>;
+    /**
+     * The generated password for the cache, when authentication is enabled
+     */
+    public /*out*/ readonly password!: pulumi.Output<string | undefined>;
+    /**
+     * The port the cache listens on
+     */
+    public /*out*/ readonly port!: pulumi.Output<number>;
+
+    /**
+     * Create a Cache resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: CacheArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["enableAuth"] = (args ? args.enableAuth : undefined) ?? false;
+            resourceInputs["image"] = (args ? args.image : undefined) ?? "redis:6";
+            resourceInputs["size"] = (args ? args.size : undefined) ?? "small";
+            resourceInputs["connectionString"] = undefined /*out*/;
+            resourceInputs["host"] = undefined /*out*/;
+            resourceInputs["password"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
+        } else {
+            resourceInputs["connectionString"] = undefined /*out*/;
+            resourceInputs["host"] = undefined /*out*/;
+            resourceInputs["password"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["connectionString", "password"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(Cache.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Cache resource.
+ */
+export interface CacheArgs {
+    /**
+     * Whether to require a generated password to connect to the cache
+     */
+    enableAuth?: boolean;
+    /**
+     * The Redis image to run
+     */
+    image?: pulumi.Input<string>;
+    /**
+     * The resource preset for the cache
+     */
+    size?: enums.Size;
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+declare var exports: any;
+const __config = new pulumi.Config("productionapp");
+
+/**
+ * Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+ */
+export declare const defaultLabels: {[key: string]: string} | undefined;
+Object.defineProperty(exports, "defaultLabels", {
+    get() {
+        return __config.getObject<{[key: string]: string}>("defaultLabels");
+    },
+    enumerable: true,
+});
+
+/**
+ * A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+ */
+export declare const defaultRegistry: string | undefined;
+Object.defineProperty(exports, "defaultRegistry", {
+    get() {
+        return __config.get("defaultRegistry");
+    },
+    enumerable: true,
+});
+
+/**
+ * The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+ */
+export declare const environment: string | undefined;
+Object.defineProperty(exports, "environment", {
+    get() {
+        return __config.get("environment");
+    },
+    enumerable: true,
+});
+
@@ -0,0 +1,109 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A Postgres database running in the cluster, intended for development environments.
+ */
+export class Database extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'productionapp:index:Database';
+
+    /**
+     * Returns true if the given object is an instance of Database.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Database {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Database.__pulumiType;
+    }
+
+    /**
+     * A postgresql:// connection string for the database
+     */
+    public /*out*/ readonly connectionString!: pulumi.Output<string>;
+    /**
+     * The name of the database
+     */
+    public readonly databaseName!: pulumi.Output<string>;
+    /**
+     * The in-cluster hostname of the database
+     */
+    public /*out*/ readonly host!: pulumi.Output<string>;
+    /**
+     * The generated password for the database user
+     */
+    public /*out*/ readonly password!: pulumi.Output<string>;
+    /**
+     * The port the database listens on
+     */
+    public /*out*/ readonly port!: pulumi.Output<number>;
+    /**
+     * The name of the database user
+     */
+    public readonly username!: pulumi.Output<string>;
+
+    /**
+     * Create a Database resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: DatabaseArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["databaseName"] = (args ? args.databaseName : undefined) ?? "app";
+            resourceInputs["image"] = (args ? args.image : undefined) ?? "postgres:14";
+            resourceInputs["storageClassName"] = args ? args.storageClassName : undefined;
+            resourceInputs["storageSize"] = (args ? args.storageSize : undefined) ?? "1Gi";
+            resourceInputs["username"] = (args ? args.username : undefined) ?? "app";
+            resourceInputs["connectionString"] = undefined /*out*/;
+            resourceInputs["host"] = undefined /*out*/;
+            resourceInputs["password"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
+        } else {
+            resourceInputs["connectionString"] = undefined /*out*/;
+            resourceInputs["databaseName"] = undefined /*out*/;
+            resourceInputs["host"] = undefined /*out*/;
+            resourceInputs["password"] = undefined /*out*/;
+            resourceInputs["port"] = undefined /*out*/;
+            resourceInputs["username"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["connectionString", "password"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(Database.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Database resource.
+ */
+export interface DatabaseArgs {
+    /**
+     * The name of the database to create
+     */
+    databaseName?: pulumi.Input<string>;
+    /**
+     * The Postgres image to run
+     */
+    image?: pulumi.Input<string>;
+    /**
+     * The storage class for the persistent volume. Uses the cluster default when unset
+     */
+    storageClassName?: pulumi.Input<string>;
+    /**
+     * The size of the persistent volume backing the database
+     */
+    storageSize?: pulumi.Input<string>;
+    /**
+     * The name of the database user to create
+     */
+    username?: pulumi.Input<string>;
+}
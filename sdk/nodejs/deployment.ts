@@ -2,8 +2,12 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
 import * as utilities from "./utilities";
 
+/**
+ * An application deployed to Kubernetes in a namespace of its own, behind a Service, with the availability settings its environment calls for.
+ */
 export class Deployment extends pulumi.ComponentResource {
     /** @internal */
     public static readonly __pulumiType = 'productionapp:index:Deployment';
@@ -20,9 +24,17 @@ export class Deployment extends pulumi.ComponentResource {
     }
 
     /**
-     * The URL from the generated service
+     * The URL from the generated service, in the first cluster when deployed to several
      */
     public /*out*/ readonly url!: pulumi.Output<string>;
+    /**
+     * The URL of the application in each cluster, keyed by cluster name
+     */
+    public /*out*/ readonly urls!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * Where the application runs, one workload per cluster
+     */
+    public /*out*/ readonly workloads!: pulumi.Output<outputs.Workload[]>;
 
     /**
      * Create a Deployment resource with the given unique name, arguments, and options.
@@ -41,27 +53,257 @@ export class Deployment extends pulumi.ComponentResource {
             if ((!args || args.port === undefined) && !opts.urn) {
                 throw new Error("Missing required property 'port'");
             }
+            resourceInputs["allowHostPath"] = (args ? args.allowHostPath : undefined) ?? false;
+            resourceInputs["annotations"] = args ? args.annotations : undefined;
+            resourceInputs["args"] = args ? args.args : undefined;
+            resourceInputs["autoscaling"] = args ? args.autoscaling : undefined;
+            resourceInputs["await"] = args ? args.await : undefined;
+            resourceInputs["cache"] = args ? args.cache : undefined;
+            resourceInputs["clusters"] = args ? args.clusters : undefined;
+            resourceInputs["command"] = args ? args.command : undefined;
+            resourceInputs["context"] = args ? args.context : undefined;
+            resourceInputs["database"] = args ? args.database : undefined;
+            resourceInputs["environment"] = args ? args.environment : undefined;
             resourceInputs["image"] = args ? args.image : undefined;
+            resourceInputs["ingress"] = args ? args.ingress : undefined;
+            resourceInputs["kubeconfig"] = args?.kubeconfig ? pulumi.secret(args.kubeconfig) : undefined;
+            resourceInputs["labels"] = args ? args.labels : undefined;
+            resourceInputs["livenessProbe"] = args ? args.livenessProbe : undefined;
+            resourceInputs["patches"] = args ? args.patches : undefined;
+            resourceInputs["podAnnotations"] = args ? args.podAnnotations : undefined;
             resourceInputs["port"] = args ? args.port : undefined;
+            resourceInputs["preDeployJob"] = args ? args.preDeployJob : undefined;
+            resourceInputs["quota"] = args ? args.quota : undefined;
+            resourceInputs["readinessProbe"] = args ? args.readinessProbe : undefined;
+            resourceInputs["registryCredentials"] = args ? args.registryCredentials : undefined;
+            resourceInputs["resources"] = args ? args.resources : undefined;
+            resourceInputs["sanitizeName"] = args ? args.sanitizeName : undefined;
+            resourceInputs["serviceType"] = (args ? args.serviceType : undefined) ?? "LoadBalancer";
+            resourceInputs["shutdown"] = args ? args.shutdown : undefined;
+            resourceInputs["size"] = args ? args.size : undefined;
+            resourceInputs["smokeTest"] = args ? args.smokeTest : undefined;
+            resourceInputs["volumeMounts"] = args ? args.volumeMounts : undefined;
+            resourceInputs["volumes"] = args ? args.volumes : undefined;
+            resourceInputs["workingDir"] = args ? args.workingDir : undefined;
             resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["urls"] = undefined /*out*/;
+            resourceInputs["workloads"] = undefined /*out*/;
         } else {
             resourceInputs["url"] = undefined /*out*/;
+            resourceInputs["urls"] = undefined /*out*/;
+            resourceInputs["workloads"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Deployment.__pulumiType, name, resourceInputs, opts, true /*remote*/);
     }
+
+    /**
+     * Returns kubectl commands for inspecting and operating the application. Commands for a workload deployed with the contents of a kubeconfig read them from the file named by `kubeconfig`, which the workload's `kubeconfig` must be saved to.
+     */
+    getKubectlCommands(): pulumi.Output<Deployment.GetKubectlCommandsResult> {
+        return pulumi.runtime.call("productionapp:index:Deployment/getKubectlCommands", {
+            "__self__": this,
+        }, this);
+    }
+
+    /**
+     * Reads the live status of the application's Deployments with kubectl, using the kubeconfig and context each Deployment was deployed with.
+     */
+    getStatus(): pulumi.Output<Deployment.GetStatusResult> {
+        return pulumi.runtime.call("productionapp:index:Deployment/getStatus", {
+            "__self__": this,
+        }, this);
+    }
+
+    /**
+     * Restarts the application's pods with a rolling update, by bumping the `kubectl.kubernetes.io/restartedAt` annotation on the pod template of each of its Deployments. Runs kubectl with the kubeconfig and context each Deployment was deployed with. Previews don't restart anything.
+     */
+    restart(): pulumi.Output<Deployment.RestartResult> {
+        return pulumi.runtime.call("productionapp:index:Deployment/restart", {
+            "__self__": this,
+        }, this);
+    }
 }
 
 /**
  * The set of arguments for constructing a Deployment resource.
  */
 export interface DeploymentArgs {
+    /**
+     * Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+     */
+    allowHostPath?: boolean;
+    /**
+     * Annotations added to every resource the component creates
+     */
+    annotations?: {[key: string]: string};
+    /**
+     * Overrides the arguments of the application image
+     */
+    args?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Scales the application with a HorizontalPodAutoscaler
+     */
+    autoscaling?: inputs.AutoscalingArgs;
+    /**
+     * How long to wait for the application to become ready
+     */
+    await?: inputs.AwaitArgs;
+    /**
+     * A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+     */
+    cache?: pulumi.Input<inputs.CacheConnectionArgs>;
+    /**
+     * Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+     */
+    clusters?: inputs.ClusterArgs[];
+    /**
+     * Overrides the entrypoint of the application image
+     */
+    command?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+     */
+    context?: pulumi.Input<string>;
+    /**
+     * A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+     */
+    database?: pulumi.Input<inputs.DatabaseConnectionArgs>;
+    /**
+     * The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+     */
+    environment?: enums.Environment;
     /**
      * The image to deploy in your production application
      */
     image: pulumi.Input<string>;
+    /**
+     * An Ingress that routes a host name to the application. The url becomes the Ingress's
+     */
+    ingress?: inputs.IngressArgs;
+    /**
+     * The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+     */
+    kubeconfig?: pulumi.Input<string>;
+    /**
+     * Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+     */
+    labels?: {[key: string]: string};
+    /**
+     * An HTTP check that restarts the application's container when it fails
+     */
+    livenessProbe?: inputs.ProbeArgs;
+    /**
+     * Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+     */
+    patches?: {[key: string]: inputs.PatchArgs};
+    /**
+     * Annotations added to the application's pods
+     */
+    podAnnotations?: {[key: string]: string};
     /**
      * The port your container listens on
      */
     port: pulumi.Input<number>;
+    /**
+     * A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+     */
+    preDeployJob?: inputs.PreDeployJobArgs;
+    /**
+     * Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+     */
+    quota?: inputs.QuotaArgs;
+    /**
+     * An HTTP check that must pass before the application's pods receive traffic
+     */
+    readinessProbe?: inputs.ProbeArgs;
+    /**
+     * Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+     */
+    registryCredentials?: pulumi.Input<inputs.RegistryCredentialsArgs>;
+    /**
+     * Resource requests and limits for the application's container, overriding the ones the environment or size sets
+     */
+    resources?: inputs.ResourcesArgs;
+    /**
+     * Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+     */
+    sanitizeName?: boolean;
+    /**
+     * The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+     */
+    serviceType?: enums.ServiceType;
+    /**
+     * How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+     */
+    shutdown?: inputs.ShutdownArgs;
+    /**
+     * The resource preset for the application's container, replacing the environment's resources
+     */
+    size?: enums.Size;
+    /**
+     * An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+     */
+    smokeTest?: inputs.SmokeTestArgs;
+    /**
+     * Where to mount `volumes` in the application container
+     */
+    volumeMounts?: inputs.VolumeMountArgs[];
+    /**
+     * Volumes available to the application container
+     */
+    volumes?: inputs.VolumeArgs[];
+    /**
+     * The working directory of the application container
+     */
+    workingDir?: pulumi.Input<string>;
+}
+
+export namespace Deployment {
+    /**
+     * The results of the Deployment.getKubectlCommands method.
+     */
+    export interface GetKubectlCommandsResult {
+        /**
+         * The commands for each of the application's workloads
+         */
+        readonly commands: outputs.KubectlCommands[];
+    }
+
+    /**
+     * The results of the Deployment.getStatus method.
+     */
+    export interface GetStatusResult {
+        /**
+         * The number of available pods, across all clusters
+         */
+        readonly availableReplicas: number;
+        /**
+         * The status in each cluster, keyed by cluster name, when the application is deployed to several
+         */
+        readonly clusters: {[key: string]: outputs.ReplicaStatus};
+        /**
+         * The number of ready pods, across all clusters
+         */
+        readonly readyReplicas: number;
+        /**
+         * The number of pods, across all clusters
+         */
+        readonly replicas: number;
+        /**
+         * The number of pods running the latest pod template, across all clusters
+         */
+        readonly updatedReplicas: number;
+    }
+
+    /**
+     * The results of the Deployment.restart method.
+     */
+    export interface RestartResult {
+        /**
+         * When the application was restarted, in RFC 3339 format. Empty during previews
+         */
+        readonly restartedAt: string;
+    }
+
 }
@@ -0,0 +1,30 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Returns the environment presets a Deployment can be deployed with and the sizes a Cache or a Deployment's container can have.
+ */
+export function getPresets(opts?: pulumi.InvokeOptions): Promise<GetPresetsResult> {
+    if (!opts) {
+        opts = {}
+    }
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+    return pulumi.runtime.invoke("productionapp:index:getPresets", {
+    }, opts);
+}
+
+export interface GetPresetsResult {
+    /**
+     * The environment presets, keyed by the name `environment` selects them with
+     */
+    readonly environments: {[key: string]: outputs.EnvironmentPreset};
+    /**
+     * The sizes, keyed by the name `size` selects them with
+     */
+    readonly sizes: {[key: string]: outputs.ResourcePreset};
+}
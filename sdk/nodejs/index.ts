@@ -5,16 +5,38 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
+export * from "./cache";
+export * from "./database";
 export * from "./deployment";
+export * from "./getPresets";
 export * from "./provider";
+export * from "./validateArgs";
+
+// Export enums:
+export * from "./types/enums";
+
+// Export sub-modules:
+import * as config from "./config";
+import * as types from "./types";
+
+export {
+    config,
+    types,
+};
 
 // Import resources to register:
+import { Cache } from "./cache";
+import { Database } from "./database";
 import { Deployment } from "./deployment";
 
 const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
+            case "productionapp:index:Cache":
+                return new Cache(name, <any>undefined, { urn })
+            case "productionapp:index:Database":
+                return new Database(name, <any>undefined, { urn })
             case "productionapp:index:Deployment":
                 return new Deployment(name, <any>undefined, { urn })
             default:
@@ -4,6 +4,9 @@
 import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
+/**
+ * The provider type for the productionapp package. Its configuration sets organisation-wide defaults for the components it creates.
+ */
 export class Provider extends pulumi.ProviderResource {
     /** @internal */
     public static readonly __pulumiType = 'productionapp';
@@ -31,6 +34,9 @@ export class Provider extends pulumi.ProviderResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         {
+            resourceInputs["defaultLabels"] = pulumi.output(args ? args.defaultLabels : undefined).apply(JSON.stringify);
+            resourceInputs["defaultRegistry"] = args ? args.defaultRegistry : undefined;
+            resourceInputs["environment"] = args ? args.environment : undefined;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
@@ -41,4 +47,16 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
+    /**
+     * Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+     */
+    defaultLabels?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+     */
+    defaultRegistry?: pulumi.Input<string>;
+    /**
+     * The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+     */
+    environment?: pulumi.Input<string>;
 }
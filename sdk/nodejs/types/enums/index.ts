@@ -0,0 +1,63 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+
+export const Environment = {
+    /**
+     * One small replica
+     */
+    Dev: "dev",
+    /**
+     * Two replicas spread across nodes with a PodDisruptionBudget
+     */
+    Staging: "staging",
+    /**
+     * Three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace
+     */
+    Prod: "prod",
+} as const;
+
+/**
+ * An environment tier, which selects replicas, resources and availability settings.
+ */
+export type Environment = (typeof Environment)[keyof typeof Environment];
+
+export const ServiceType = {
+    /**
+     * Only reachable from inside the cluster
+     */
+    ClusterIP: "ClusterIP",
+    /**
+     * Reachable on a port of every node
+     */
+    NodePort: "NodePort",
+    /**
+     * Reachable through a cloud load balancer
+     */
+    LoadBalancer: "LoadBalancer",
+} as const;
+
+/**
+ * The type of Service the application is exposed through.
+ */
+export type ServiceType = (typeof ServiceType)[keyof typeof ServiceType];
+
+export const Size = {
+    /**
+     * 100m CPU and 128Mi memory requested, limited to 250m and 256Mi
+     */
+    Small: "small",
+    /**
+     * 250m CPU and 512Mi memory requested, limited to 500m and 1Gi
+     */
+    Medium: "medium",
+    /**
+     * 500m CPU and 2Gi memory requested, limited to 1 CPU and 4Gi
+     */
+    Large: "large",
+} as const;
+
+/**
+ * A preset of container resource requests and limits.
+ */
+export type Size = (typeof Size)[keyof typeof Size];
@@ -0,0 +1,13 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+// Export sub-modules:
+import * as enums from "./enums";
+import * as input from "./input";
+import * as output from "./output";
+
+export {
+    enums,
+    input,
+    output,
+};
@@ -0,0 +1,798 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+
+/**
+ * Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+ */
+export interface Autoscaling {
+    /**
+     * The most replicas to scale to. Defaults to the environment's maximum, or 10
+     */
+    maxReplicas?: number;
+    /**
+     * The fewest replicas to scale to. Defaults to the application's replicas
+     */
+    minReplicas?: number;
+    /**
+     * The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+     */
+    targetCpuUtilizationPercentage?: number;
+}
+
+/**
+ * Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+ */
+export interface AutoscalingArgs {
+    /**
+     * The most replicas to scale to. Defaults to the environment's maximum, or 10
+     */
+    maxReplicas?: number;
+    /**
+     * The fewest replicas to scale to. Defaults to the application's replicas
+     */
+    minReplicas?: number;
+    /**
+     * The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+     */
+    targetCpuUtilizationPercentage?: number;
+}
+
+/**
+ * Controls how long Pulumi waits for the application to become ready.
+ */
+export interface Await {
+    /**
+     * How long to wait for the application's resources to be created. Defaults to 10 minutes
+     */
+    createTimeoutSeconds?: number;
+    /**
+     * Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+     */
+    skipAwait?: boolean;
+    /**
+     * How long to wait for the application's resources to be updated. Defaults to 10 minutes
+     */
+    updateTimeoutSeconds?: number;
+    /**
+     * Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+     */
+    waitForLoadBalancer?: boolean;
+}
+
+/**
+ * Controls how long Pulumi waits for the application to become ready.
+ */
+export interface AwaitArgs {
+    /**
+     * How long to wait for the application's resources to be created. Defaults to 10 minutes
+     */
+    createTimeoutSeconds?: number;
+    /**
+     * Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+     */
+    skipAwait?: boolean;
+    /**
+     * How long to wait for the application's resources to be updated. Defaults to 10 minutes
+     */
+    updateTimeoutSeconds?: number;
+    /**
+     * Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+     */
+    waitForLoadBalancer?: boolean;
+}
+
+/**
+ * Describes how an application connects to a cache.
+ */
+export interface CacheConnection {
+    /**
+     * The connection string for the cache, such as the `connectionString` output of a `Cache`
+     */
+    connectionString: string;
+    /**
+     * The hostname of the cache, such as the `host` output of a `Cache`
+     */
+    host: string;
+    /**
+     * The port of the cache, such as the `port` output of a `Cache`
+     */
+    port: number;
+}
+
+/**
+ * Describes how an application connects to a cache.
+ */
+export interface CacheConnectionArgs {
+    /**
+     * The connection string for the cache, such as the `connectionString` output of a `Cache`
+     */
+    connectionString: pulumi.Input<string>;
+    /**
+     * The hostname of the cache, such as the `host` output of a `Cache`
+     */
+    host: pulumi.Input<string>;
+    /**
+     * The port of the cache, such as the `port` output of a `Cache`
+     */
+    port: pulumi.Input<number>;
+}
+
+/**
+ * One of the clusters the application is deployed to, with overrides for the application's settings there.
+ */
+export interface Cluster {
+    /**
+     * The kubeconfig context of the cluster
+     */
+    context?: string;
+    /**
+     * The image to deploy to the cluster, overriding `image`
+     */
+    image?: string;
+    /**
+     * The contents of a kubeconfig file, or the path to one, for the cluster
+     */
+    kubeconfig?: string;
+    /**
+     * The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+     */
+    name: string;
+    /**
+     * The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+     */
+    replicas?: number;
+}
+
+/**
+ * One of the clusters the application is deployed to, with overrides for the application's settings there.
+ */
+export interface ClusterArgs {
+    /**
+     * The kubeconfig context of the cluster
+     */
+    context?: pulumi.Input<string>;
+    /**
+     * The image to deploy to the cluster, overriding `image`
+     */
+    image?: pulumi.Input<string>;
+    /**
+     * The contents of a kubeconfig file, or the path to one, for the cluster
+     */
+    kubeconfig?: pulumi.Input<string>;
+    /**
+     * The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+     */
+    name: string;
+    /**
+     * The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+     */
+    replicas?: number;
+}
+
+/**
+ * A volume populated from a ConfigMap.
+ */
+export interface ConfigMapVolume {
+    /**
+     * The name of the ConfigMap in the application namespace
+     */
+    name: string;
+}
+
+/**
+ * A volume populated from a ConfigMap.
+ */
+export interface ConfigMapVolumeArgs {
+    /**
+     * The name of the ConfigMap in the application namespace
+     */
+    name: string;
+}
+
+/**
+ * Describes how an application connects to a database.
+ */
+export interface DatabaseConnection {
+    /**
+     * The connection string for the database, such as the `connectionString` output of a `Database`
+     */
+    connectionString: string;
+}
+
+/**
+ * Describes how an application connects to a database.
+ */
+export interface DatabaseConnectionArgs {
+    /**
+     * The connection string for the database, such as the `connectionString` output of a `Database`
+     */
+    connectionString: pulumi.Input<string>;
+}
+
+/**
+ * A scratch directory that lives as long as the pod.
+ */
+export interface EmptyDirVolume {
+    /**
+     * Set to `Memory` to back the directory with tmpfs
+     */
+    medium?: string;
+    /**
+     * The maximum size of the directory, such as `1Gi`
+     */
+    sizeLimit?: string;
+}
+
+/**
+ * A scratch directory that lives as long as the pod.
+ */
+export interface EmptyDirVolumeArgs {
+    /**
+     * Set to `Memory` to back the directory with tmpfs
+     */
+    medium?: string;
+    /**
+     * The maximum size of the directory, such as `1Gi`
+     */
+    sizeLimit?: string;
+}
+
+/**
+ * A volume backed by a path on the node.
+ */
+export interface HostPathVolume {
+    /**
+     * The path on the node
+     */
+    path: string;
+    /**
+     * The type of the path, such as `Directory`
+     */
+    type?: string;
+}
+
+/**
+ * A volume backed by a path on the node.
+ */
+export interface HostPathVolumeArgs {
+    /**
+     * The path on the node
+     */
+    path: string;
+    /**
+     * The type of the path, such as `Directory`
+     */
+    type?: string;
+}
+
+/**
+ * Routes a host name to the application's Service.
+ */
+export interface Ingress {
+    /**
+     * Annotations added to the Ingress, such as the controller's settings
+     */
+    annotations?: {[key: string]: string};
+    /**
+     * The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+     */
+    className?: string;
+    /**
+     * The host name to route, such as `app.example.com`
+     */
+    host: string;
+    /**
+     * The path prefix to route. Defaults to `/`
+     */
+    path?: string;
+    /**
+     * A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+     */
+    tlsSecretName?: string;
+}
+
+/**
+ * Routes a host name to the application's Service.
+ */
+export interface IngressArgs {
+    /**
+     * Annotations added to the Ingress, such as the controller's settings
+     */
+    annotations?: {[key: string]: string};
+    /**
+     * The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+     */
+    className?: string;
+    /**
+     * The host name to route, such as `app.example.com`
+     */
+    host: string;
+    /**
+     * The path prefix to route. Defaults to `/`
+     */
+    path?: string;
+    /**
+     * A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+     */
+    tlsSecretName?: string;
+}
+
+/**
+ * Modifies the arguments of every resource of one kind before it is registered.
+ */
+export interface Patch {
+    /**
+     * The patch, in the shape of the Kubernetes resource. `null` removes a field
+     */
+    patch: any;
+    /**
+     * How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+     */
+    type?: string;
+}
+
+/**
+ * Modifies the arguments of every resource of one kind before it is registered.
+ */
+export interface PatchArgs {
+    /**
+     * The patch, in the shape of the Kubernetes resource. `null` removes a field
+     */
+    patch: any;
+    /**
+     * How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+     */
+    type?: string;
+}
+
+/**
+ * A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+ */
+export interface PreDeployJob {
+    /**
+     * The command to run
+     */
+    command?: string[];
+    /**
+     * Environment variables for the job, in addition to those of the application
+     */
+    env?: {[key: string]: string};
+    /**
+     * The image to run. Defaults to the application image
+     */
+    image?: string;
+    /**
+     * How long the job may run before it is considered failed
+     */
+    timeoutSeconds?: number;
+}
+
+/**
+ * A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+ */
+export interface PreDeployJobArgs {
+    /**
+     * The command to run
+     */
+    command?: string[];
+    /**
+     * Environment variables for the job, in addition to those of the application
+     */
+    env?: {[key: string]: string};
+    /**
+     * The image to run. Defaults to the application image
+     */
+    image?: string;
+    /**
+     * How long the job may run before it is considered failed
+     */
+    timeoutSeconds?: number;
+}
+
+/**
+ * An HTTP check the kubelet makes against the application container.
+ */
+export interface Probe {
+    /**
+     * How many consecutive checks must fail before the probe fails. Defaults to 3
+     */
+    failureThreshold?: number;
+    /**
+     * How long to wait after the container starts before the first check
+     */
+    initialDelaySeconds?: number;
+    /**
+     * The path to request. Defaults to `/`
+     */
+    path?: string;
+    /**
+     * How often to check. Defaults to 10 seconds
+     */
+    periodSeconds?: number;
+    /**
+     * The container port to request. Defaults to the application's port
+     */
+    port?: number;
+    /**
+     * How long a check may take. Defaults to 1 second
+     */
+    timeoutSeconds?: number;
+}
+
+/**
+ * An HTTP check the kubelet makes against the application container.
+ */
+export interface ProbeArgs {
+    /**
+     * How many consecutive checks must fail before the probe fails. Defaults to 3
+     */
+    failureThreshold?: number;
+    /**
+     * How long to wait after the container starts before the first check
+     */
+    initialDelaySeconds?: number;
+    /**
+     * The path to request. Defaults to `/`
+     */
+    path?: string;
+    /**
+     * How often to check. Defaults to 10 seconds
+     */
+    periodSeconds?: number;
+    /**
+     * The container port to request. Defaults to the application's port
+     */
+    port?: number;
+    /**
+     * How long a check may take. Defaults to 1 second
+     */
+    timeoutSeconds?: number;
+}
+
+/**
+ * Caps what the application may consume in its namespace.
+ */
+export interface Quota {
+    /**
+     * The total CPU limit of all pods in the namespace, such as `4`
+     */
+    cpu?: string;
+    /**
+     * Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+     */
+    defaultLimits?: {[key: string]: string};
+    /**
+     * Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+     */
+    defaultRequests?: {[key: string]: string};
+    /**
+     * The maximum number of LoadBalancer services in the namespace. Must be at least 1
+     */
+    loadBalancers?: number;
+    /**
+     * The total memory limit of all pods in the namespace, such as `8Gi`
+     */
+    memory?: string;
+    /**
+     * The maximum number of persistent volume claims in the namespace
+     */
+    persistentVolumeClaims?: number;
+    /**
+     * The maximum number of pods in the namespace
+     */
+    pods?: number;
+}
+
+/**
+ * Caps what the application may consume in its namespace.
+ */
+export interface QuotaArgs {
+    /**
+     * The total CPU limit of all pods in the namespace, such as `4`
+     */
+    cpu?: string;
+    /**
+     * Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+     */
+    defaultLimits?: {[key: string]: string};
+    /**
+     * Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+     */
+    defaultRequests?: {[key: string]: string};
+    /**
+     * The maximum number of LoadBalancer services in the namespace. Must be at least 1
+     */
+    loadBalancers?: number;
+    /**
+     * The total memory limit of all pods in the namespace, such as `8Gi`
+     */
+    memory?: string;
+    /**
+     * The maximum number of persistent volume claims in the namespace
+     */
+    persistentVolumeClaims?: number;
+    /**
+     * The maximum number of pods in the namespace
+     */
+    pods?: number;
+}
+
+/**
+ * The credentials the application's pods pull images with.
+ */
+export interface RegistryCredentials {
+    /**
+     * The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+     */
+    existingSecretName?: string;
+    /**
+     * The password or token to authenticate with
+     */
+    password?: string;
+    /**
+     * The registry server, such as `ghcr.io`
+     */
+    server?: string;
+    /**
+     * The username to authenticate with
+     */
+    username?: string;
+}
+
+/**
+ * The credentials the application's pods pull images with.
+ */
+export interface RegistryCredentialsArgs {
+    /**
+     * The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+     */
+    existingSecretName?: pulumi.Input<string>;
+    /**
+     * The password or token to authenticate with
+     */
+    password?: pulumi.Input<string>;
+    /**
+     * The registry server, such as `ghcr.io`
+     */
+    server?: pulumi.Input<string>;
+    /**
+     * The username to authenticate with
+     */
+    username?: pulumi.Input<string>;
+}
+
+/**
+ * Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+ */
+export interface Resources {
+    /**
+     * The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+     */
+    limits?: {[key: string]: string};
+    /**
+     * The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+     */
+    requests?: {[key: string]: string};
+}
+
+/**
+ * Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+ */
+export interface ResourcesArgs {
+    /**
+     * The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+     */
+    limits?: {[key: string]: string};
+    /**
+     * The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+     */
+    requests?: {[key: string]: string};
+}
+
+/**
+ * A volume populated from a Secret.
+ */
+export interface SecretVolume {
+    /**
+     * The name of the Secret in the application namespace
+     */
+    secretName: string;
+}
+
+/**
+ * A volume populated from a Secret.
+ */
+export interface SecretVolumeArgs {
+    /**
+     * The name of the Secret in the application namespace
+     */
+    secretName: string;
+}
+
+/**
+ * Configures how the application's pods are stopped.
+ */
+export interface Shutdown {
+    /**
+     * A command to run in the preStop hook instead of sleeping
+     */
+    preStopCommand?: string[];
+    /**
+     * How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+     */
+    preStopSleepSeconds?: number;
+    /**
+     * How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+     */
+    terminationGracePeriodSeconds?: number;
+}
+
+/**
+ * Configures how the application's pods are stopped.
+ */
+export interface ShutdownArgs {
+    /**
+     * A command to run in the preStop hook instead of sleeping
+     */
+    preStopCommand?: string[];
+    /**
+     * How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+     */
+    preStopSleepSeconds?: number;
+    /**
+     * How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+     */
+    terminationGracePeriodSeconds?: number;
+}
+
+/**
+ * An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+ */
+export interface SmokeTest {
+    /**
+     * Text the response's body must contain
+     */
+    bodyContains?: string;
+    /**
+     * The status code the response must have. Defaults to 200
+     */
+    expectedStatus?: number;
+    /**
+     * How long to wait between attempts. Defaults to 5 seconds
+     */
+    intervalSeconds?: number;
+    /**
+     * The path to request. Defaults to `/`
+     */
+    path?: string;
+    /**
+     * How many times to retry the request before failing. Defaults to 10
+     */
+    retries?: number;
+}
+
+/**
+ * An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+ */
+export interface SmokeTestArgs {
+    /**
+     * Text the response's body must contain
+     */
+    bodyContains?: string;
+    /**
+     * The status code the response must have. Defaults to 200
+     */
+    expectedStatus?: number;
+    /**
+     * How long to wait between attempts. Defaults to 5 seconds
+     */
+    intervalSeconds?: number;
+    /**
+     * The path to request. Defaults to `/`
+     */
+    path?: string;
+    /**
+     * How many times to retry the request before failing. Defaults to 10
+     */
+    retries?: number;
+}
+
+/**
+ * A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+ */
+export interface Volume {
+    /**
+     * The contents of a ConfigMap
+     */
+    configMap?: inputs.ConfigMapVolume;
+    /**
+     * A scratch directory that lives as long as the pod
+     */
+    emptyDir?: inputs.EmptyDirVolume;
+    /**
+     * A path on the node. Requires `allowHostPath`
+     */
+    hostPath?: inputs.HostPathVolume;
+    /**
+     * The name of the volume, referenced by `volumeMounts`
+     */
+    name: string;
+    /**
+     * The contents of a Secret
+     */
+    secret?: inputs.SecretVolume;
+}
+
+/**
+ * A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+ */
+export interface VolumeArgs {
+    /**
+     * The contents of a ConfigMap
+     */
+    configMap?: inputs.ConfigMapVolumeArgs;
+    /**
+     * A scratch directory that lives as long as the pod
+     */
+    emptyDir?: inputs.EmptyDirVolumeArgs;
+    /**
+     * A path on the node. Requires `allowHostPath`
+     */
+    hostPath?: inputs.HostPathVolumeArgs;
+    /**
+     * The name of the volume, referenced by `volumeMounts`
+     */
+    name: string;
+    /**
+     * The contents of a Secret
+     */
+    secret?: inputs.SecretVolumeArgs;
+}
+
+/**
+ * Mounts one of the application's volumes into its container.
+ */
+export interface VolumeMount {
+    /**
+     * Where to mount the volume
+     */
+    mountPath: string;
+    /**
+     * The name of the volume to mount
+     */
+    name: string;
+    /**
+     * Whether to mount the volume read-only
+     */
+    readOnly?: boolean;
+    /**
+     * A path within the volume to mount instead of its root
+     */
+    subPath?: string;
+}
+
+/**
+ * Mounts one of the application's volumes into its container.
+ */
+export interface VolumeMountArgs {
+    /**
+     * Where to mount the volume
+     */
+    mountPath: string;
+    /**
+     * The name of the volume to mount
+     */
+    name: string;
+    /**
+     * Whether to mount the volume read-only
+     */
+    readOnly?: boolean;
+    /**
+     * A path within the volume to mount instead of its root
+     */
+    subPath?: string;
+}
+
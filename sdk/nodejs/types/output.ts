@@ -0,0 +1,144 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+
+/**
+ * The defaults a Deployment gets in one environment tier.
+ */
+export interface EnvironmentPreset {
+    /**
+     * Whether the pods are spread across nodes
+     */
+    antiAffinity: boolean;
+    /**
+     * The application container's image pull policy
+     */
+    imagePullPolicy: string;
+    /**
+     * The number of pods the HorizontalPodAutoscaler scales up to. Unset if there is no HorizontalPodAutoscaler
+     */
+    maxReplicas?: number;
+    /**
+     * The minimum number or percentage of pods the PodDisruptionBudget keeps available. Unset if there is no PodDisruptionBudget
+     */
+    minAvailable?: string;
+    /**
+     * Whether the namespace is protected from deletion
+     */
+    protectNamespace: boolean;
+    /**
+     * The number of pods
+     */
+    replicas: number;
+    /**
+     * The application container's resource requests and limits
+     */
+    resources: outputs.ResourcePreset;
+    /**
+     * The CPU utilization the HorizontalPodAutoscaler scales at. Unset if there is no HorizontalPodAutoscaler
+     */
+    targetCpuUtilizationPercentage?: number;
+}
+
+/**
+ * kubectl commands for one of the application's workloads.
+ */
+export interface KubectlCommands {
+    /**
+     * The name of the cluster, when the application is deployed to several
+     */
+    cluster?: string;
+    /**
+     * Describes the application's Deployment
+     */
+    describe: string;
+    /**
+     * Lists the application's pods
+     */
+    getPods: string;
+    /**
+     * The kubeconfig file the commands use, when the application was deployed with a kubeconfig
+     */
+    kubeconfig?: string;
+    /**
+     * Follows the application's logs
+     */
+    logs: string;
+    /**
+     * Restarts the application's pods
+     */
+    restart: string;
+    /**
+     * Waits for the application's rollout to finish
+     */
+    rolloutStatus: string;
+}
+
+/**
+ * The number of a Deployment's pods in each state.
+ */
+export interface ReplicaStatus {
+    /**
+     * The number of available pods
+     */
+    availableReplicas: number;
+    /**
+     * The number of ready pods
+     */
+    readyReplicas: number;
+    /**
+     * The number of pods
+     */
+    replicas: number;
+    /**
+     * The number of pods running the latest pod template
+     */
+    updatedReplicas: number;
+}
+
+/**
+ * Container resource requests and limits.
+ */
+export interface ResourcePreset {
+    /**
+     * The resource limits, keyed by resource name
+     */
+    limits: {[key: string]: string};
+    /**
+     * The resources requested, keyed by resource name
+     */
+    requests: {[key: string]: string};
+}
+
+/**
+ * Where the application runs in one cluster.
+ */
+export interface Workload {
+    /**
+     * The name of the cluster, when the application is deployed to several
+     */
+    cluster?: string;
+    /**
+     * The kubeconfig context the application was deployed with
+     */
+    context?: string;
+    /**
+     * The name of the application's Deployment
+     */
+    deployment: string;
+    /**
+     * The contents of the kubeconfig file, or the path to it, the application was deployed with
+     */
+    kubeconfig?: string;
+    /**
+     * The namespace the application runs in
+     */
+    namespace: string;
+    /**
+     * The URL of the application's Service
+     */
+    url: string;
+}
+
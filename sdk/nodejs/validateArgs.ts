@@ -0,0 +1,333 @@
+// *** WARNING: this file was generated by Pulumi SDK Generator. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import { input as inputs, output as outputs, enums } from "./types";
+import * as utilities from "./utilities";
+
+/**
+ * Checks a Deployment's name and inputs without creating anything, applying the provider's configuration as constructing it would.
+ */
+export function validateArgs(args: ValidateArgsArgs, opts?: pulumi.InvokeOptions): Promise<ValidateArgsResult> {
+    if (!opts) {
+        opts = {}
+    }
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+    return pulumi.runtime.invoke("productionapp:index:validateArgs", {
+        "allowHostPath": args.allowHostPath,
+        "annotations": args.annotations,
+        "args": args.args,
+        "autoscaling": args.autoscaling,
+        "await": args.await,
+        "cache": args.cache,
+        "clusters": args.clusters,
+        "command": args.command,
+        "context": args.context,
+        "database": args.database,
+        "environment": args.environment,
+        "image": args.image,
+        "ingress": args.ingress,
+        "kubeconfig": args.kubeconfig,
+        "labels": args.labels,
+        "livenessProbe": args.livenessProbe,
+        "name": args.name,
+        "patches": args.patches,
+        "podAnnotations": args.podAnnotations,
+        "port": args.port,
+        "preDeployJob": args.preDeployJob,
+        "quota": args.quota,
+        "readinessProbe": args.readinessProbe,
+        "registryCredentials": args.registryCredentials,
+        "resources": args.resources,
+        "sanitizeName": args.sanitizeName,
+        "serviceType": args.serviceType,
+        "shutdown": args.shutdown,
+        "size": args.size,
+        "smokeTest": args.smokeTest,
+        "volumeMounts": args.volumeMounts,
+        "volumes": args.volumes,
+        "workingDir": args.workingDir,
+    }, opts);
+}
+
+export interface ValidateArgsArgs {
+    /**
+     * Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+     */
+    allowHostPath?: boolean;
+    /**
+     * Annotations added to every resource the component creates
+     */
+    annotations?: {[key: string]: string};
+    /**
+     * Overrides the arguments of the application image
+     */
+    args?: string[];
+    /**
+     * Scales the application with a HorizontalPodAutoscaler
+     */
+    autoscaling?: inputs.Autoscaling;
+    /**
+     * How long to wait for the application to become ready
+     */
+    await?: inputs.Await;
+    /**
+     * A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+     */
+    cache?: inputs.CacheConnection;
+    /**
+     * Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+     */
+    clusters?: inputs.Cluster[];
+    /**
+     * Overrides the entrypoint of the application image
+     */
+    command?: string[];
+    /**
+     * The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+     */
+    context?: string;
+    /**
+     * A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+     */
+    database?: inputs.DatabaseConnection;
+    /**
+     * The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+     */
+    environment?: enums.Environment;
+    /**
+     * The image to deploy in your production application
+     */
+    image?: string;
+    /**
+     * An Ingress that routes a host name to the application. The url becomes the Ingress's
+     */
+    ingress?: inputs.Ingress;
+    /**
+     * The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+     */
+    kubeconfig?: string;
+    /**
+     * Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+     */
+    labels?: {[key: string]: string};
+    /**
+     * An HTTP check that restarts the application's container when it fails
+     */
+    livenessProbe?: inputs.Probe;
+    /**
+     * The name the Deployment would be given
+     */
+    name: string;
+    /**
+     * Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+     */
+    patches?: {[key: string]: inputs.Patch};
+    /**
+     * Annotations added to the application's pods
+     */
+    podAnnotations?: {[key: string]: string};
+    /**
+     * The port your container listens on
+     */
+    port?: number;
+    /**
+     * A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+     */
+    preDeployJob?: inputs.PreDeployJob;
+    /**
+     * Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+     */
+    quota?: inputs.Quota;
+    /**
+     * An HTTP check that must pass before the application's pods receive traffic
+     */
+    readinessProbe?: inputs.Probe;
+    /**
+     * Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+     */
+    registryCredentials?: inputs.RegistryCredentials;
+    /**
+     * Resource requests and limits for the application's container, overriding the ones the environment or size sets
+     */
+    resources?: inputs.Resources;
+    /**
+     * Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+     */
+    sanitizeName?: boolean;
+    /**
+     * The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+     */
+    serviceType?: enums.ServiceType;
+    /**
+     * How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+     */
+    shutdown?: inputs.Shutdown;
+    /**
+     * The resource preset for the application's container, replacing the environment's resources
+     */
+    size?: enums.Size;
+    /**
+     * An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+     */
+    smokeTest?: inputs.SmokeTest;
+    /**
+     * Where to mount `volumes` in the application container
+     */
+    volumeMounts?: inputs.VolumeMount[];
+    /**
+     * Volumes available to the application container
+     */
+    volumes?: inputs.Volume[];
+    /**
+     * The working directory of the application container
+     */
+    workingDir?: string;
+}
+
+export interface ValidateArgsResult {
+    /**
+     * Every problem with the inputs, naming the offending input. Empty if they are valid
+     */
+    readonly problems: string[];
+}
+
+export function validateArgsOutput(args: ValidateArgsOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<ValidateArgsResult> {
+    return pulumi.output(args).apply(a => validateArgs(a, opts))
+}
+
+export interface ValidateArgsOutputArgs {
+    /**
+     * Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+     */
+    allowHostPath?: pulumi.Input<boolean>;
+    /**
+     * Annotations added to every resource the component creates
+     */
+    annotations?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Overrides the arguments of the application image
+     */
+    args?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Scales the application with a HorizontalPodAutoscaler
+     */
+    autoscaling?: pulumi.Input<inputs.AutoscalingArgs>;
+    /**
+     * How long to wait for the application to become ready
+     */
+    await?: pulumi.Input<inputs.AwaitArgs>;
+    /**
+     * A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+     */
+    cache?: pulumi.Input<inputs.CacheConnectionArgs>;
+    /**
+     * Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+     */
+    clusters?: pulumi.Input<pulumi.Input<inputs.ClusterArgs>[]>;
+    /**
+     * Overrides the entrypoint of the application image
+     */
+    command?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+     */
+    context?: pulumi.Input<string>;
+    /**
+     * A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+     */
+    database?: pulumi.Input<inputs.DatabaseConnectionArgs>;
+    /**
+     * The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+     */
+    environment?: pulumi.Input<enums.Environment>;
+    /**
+     * The image to deploy in your production application
+     */
+    image?: pulumi.Input<string>;
+    /**
+     * An Ingress that routes a host name to the application. The url becomes the Ingress's
+     */
+    ingress?: pulumi.Input<inputs.IngressArgs>;
+    /**
+     * The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+     */
+    kubeconfig?: pulumi.Input<string>;
+    /**
+     * Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+     */
+    labels?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * An HTTP check that restarts the application's container when it fails
+     */
+    livenessProbe?: pulumi.Input<inputs.ProbeArgs>;
+    /**
+     * The name the Deployment would be given
+     */
+    name: pulumi.Input<string>;
+    /**
+     * Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+     */
+    patches?: pulumi.Input<{[key: string]: pulumi.Input<inputs.PatchArgs>}>;
+    /**
+     * Annotations added to the application's pods
+     */
+    podAnnotations?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The port your container listens on
+     */
+    port?: pulumi.Input<number>;
+    /**
+     * A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+     */
+    preDeployJob?: pulumi.Input<inputs.PreDeployJobArgs>;
+    /**
+     * Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+     */
+    quota?: pulumi.Input<inputs.QuotaArgs>;
+    /**
+     * An HTTP check that must pass before the application's pods receive traffic
+     */
+    readinessProbe?: pulumi.Input<inputs.ProbeArgs>;
+    /**
+     * Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+     */
+    registryCredentials?: pulumi.Input<inputs.RegistryCredentialsArgs>;
+    /**
+     * Resource requests and limits for the application's container, overriding the ones the environment or size sets
+     */
+    resources?: pulumi.Input<inputs.ResourcesArgs>;
+    /**
+     * Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+     */
+    sanitizeName?: pulumi.Input<boolean>;
+    /**
+     * The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+     */
+    serviceType?: pulumi.Input<enums.ServiceType>;
+    /**
+     * How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+     */
+    shutdown?: pulumi.Input<inputs.ShutdownArgs>;
+    /**
+     * The resource preset for the application's container, replacing the environment's resources
+     */
+    size?: pulumi.Input<enums.Size>;
+    /**
+     * An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+     */
+    smokeTest?: pulumi.Input<inputs.SmokeTestArgs>;
+    /**
+     * Where to mount `volumes` in the application container
+     */
+    volumeMounts?: pulumi.Input<pulumi.Input<inputs.VolumeMountArgs>[]>;
+    /**
+     * Volumes available to the application container
+     */
+    volumes?: pulumi.Input<pulumi.Input<inputs.VolumeArgs>[]>;
+    /**
+     * The working directory of the application container
+     */
+    workingDir?: pulumi.Input<string>;
+}
@@ -5,8 +5,23 @@
 from . import _utilities
 import typing
 # Export this package's modules as members:
+from ._enums import *
+from .cache import *
+from .database import *
 from .deployment import *
+from .get_presets import *
 from .provider import *
+from .validate_args import *
+from ._inputs import *
+from . import outputs
+
+# Make subpackages available:
+if typing.TYPE_CHECKING:
+    import jaxxstorm_pulumi_productionapp.config as __config
+    config = __config
+else:
+    config = _utilities.lazy_import('jaxxstorm_pulumi_productionapp.config')
+
 _utilities.register(
     resource_modules="""
 [
@@ -15,6 +30,8 @@ _utilities.register(
   "mod": "index",
   "fqn": "jaxxstorm_pulumi_productionapp",
   "classes": {
+   "productionapp:index:Cache": "Cache",
+   "productionapp:index:Database": "Database",
    "productionapp:index:Deployment": "Deployment"
   }
  }
@@ -0,0 +1,65 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+from enum import Enum
+
+__all__ = [
+    'Environment',
+    'ServiceType',
+    'Size',
+]
+
+
+class Environment(str, Enum):
+    """
+    An environment tier, which selects replicas, resources and availability settings.
+    """
+    DEV = "dev"
+    """
+    One small replica
+    """
+    STAGING = "staging"
+    """
+    Two replicas spread across nodes with a PodDisruptionBudget
+    """
+    PROD = "prod"
+    """
+    Three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace
+    """
+
+
+class ServiceType(str, Enum):
+    """
+    The type of Service the application is exposed through.
+    """
+    CLUSTER_IP = "ClusterIP"
+    """
+    Only reachable from inside the cluster
+    """
+    NODE_PORT = "NodePort"
+    """
+    Reachable on a port of every node
+    """
+    LOAD_BALANCER = "LoadBalancer"
+    """
+    Reachable through a cloud load balancer
+    """
+
+
+class Size(str, Enum):
+    """
+    A preset of container resource requests and limits.
+    """
+    SMALL = "small"
+    """
+    100m CPU and 128Mi memory requested, limited to 250m and 256Mi
+    """
+    MEDIUM = "medium"
+    """
+    250m CPU and 512Mi memory requested, limited to 500m and 1Gi
+    """
+    LARGE = "large"
+    """
+    500m CPU and 2Gi memory requested, limited to 1 CPU and 4Gi
+    """
@@ -0,0 +1,2556 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._enums import *
+
+__all__ = [
+    'Autoscaling',
+    'AutoscalingArgs',
+    'Await',
+    'AwaitArgs',
+    'CacheConnection',
+    'CacheConnectionArgs',
+    'Cluster',
+    'ClusterArgs',
+    'ConfigMapVolume',
+    'ConfigMapVolumeArgs',
+    'DatabaseConnection',
+    'DatabaseConnectionArgs',
+    'EmptyDirVolume',
+    'EmptyDirVolumeArgs',
+    'HostPathVolume',
+    'HostPathVolumeArgs',
+    'Ingress',
+    'IngressArgs',
+    'Patch',
+    'PatchArgs',
+    'PreDeployJob',
+    'PreDeployJobArgs',
+    'Probe',
+    'ProbeArgs',
+    'Quota',
+    'QuotaArgs',
+    'RegistryCredentials',
+    'RegistryCredentialsArgs',
+    'Resources',
+    'ResourcesArgs',
+    'SecretVolume',
+    'SecretVolumeArgs',
+    'Shutdown',
+    'ShutdownArgs',
+    'SmokeTest',
+    'SmokeTestArgs',
+    'Volume',
+    'VolumeMount',
+    'VolumeMountArgs',
+    'VolumeArgs',
+]
+
+@pulumi.input_type
+class Autoscaling:
+    def __init__(__self__, *,
+                 max_replicas: Optional[int] = None,
+                 min_replicas: Optional[int] = None,
+                 target_cpu_utilization_percentage: Optional[int] = None):
+        """
+        Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+        :param int max_replicas: The most replicas to scale to. Defaults to the environment's maximum, or 10
+        :param int min_replicas: The fewest replicas to scale to. Defaults to the application's replicas
+        :param int target_cpu_utilization_percentage: The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+        """
+        if max_replicas is not None:
+            pulumi.set(__self__, "max_replicas", max_replicas)
+        if min_replicas is not None:
+            pulumi.set(__self__, "min_replicas", min_replicas)
+        if target_cpu_utilization_percentage is not None:
+            pulumi.set(__self__, "target_cpu_utilization_percentage", target_cpu_utilization_percentage)
+
+    @property
+    @pulumi.getter(name="maxReplicas")
+    def max_replicas(self) -> Optional[int]:
+        """
+        The most replicas to scale to. Defaults to the environment's maximum, or 10
+        """
+        return pulumi.get(self, "max_replicas")
+
+    @max_replicas.setter
+    def max_replicas(self, value: Optional[int]):
+        pulumi.set(self, "max_replicas", value)
+
+    @property
+    @pulumi.getter(name="minReplicas")
+    def min_replicas(self) -> Optional[int]:
+        """
+        The fewest replicas to scale to. Defaults to the application's replicas
+        """
+        return pulumi.get(self, "min_replicas")
+
+    @min_replicas.setter
+    def min_replicas(self, value: Optional[int]):
+        pulumi.set(self, "min_replicas", value)
+
+    @property
+    @pulumi.getter(name="targetCpuUtilizationPercentage")
+    def target_cpu_utilization_percentage(self) -> Optional[int]:
+        """
+        The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+        """
+        return pulumi.get(self, "target_cpu_utilization_percentage")
+
+    @target_cpu_utilization_percentage.setter
+    def target_cpu_utilization_percentage(self, value: Optional[int]):
+        pulumi.set(self, "target_cpu_utilization_percentage", value)
+
+
+@pulumi.input_type
+class AutoscalingArgs:
+    def __init__(__self__, *,
+                 max_replicas: Optional[int] = None,
+                 min_replicas: Optional[int] = None,
+                 target_cpu_utilization_percentage: Optional[int] = None):
+        """
+        Scales the application with a HorizontalPodAutoscaler, overriding the environment's autoscaling or enabling it for environments that don't autoscale.
+        :param int max_replicas: The most replicas to scale to. Defaults to the environment's maximum, or 10
+        :param int min_replicas: The fewest replicas to scale to. Defaults to the application's replicas
+        :param int target_cpu_utilization_percentage: The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+        """
+        if max_replicas is not None:
+            pulumi.set(__self__, "max_replicas", max_replicas)
+        if min_replicas is not None:
+            pulumi.set(__self__, "min_replicas", min_replicas)
+        if target_cpu_utilization_percentage is not None:
+            pulumi.set(__self__, "target_cpu_utilization_percentage", target_cpu_utilization_percentage)
+
+    @property
+    @pulumi.getter(name="maxReplicas")
+    def max_replicas(self) -> Optional[int]:
+        """
+        The most replicas to scale to. Defaults to the environment's maximum, or 10
+        """
+        return pulumi.get(self, "max_replicas")
+
+    @max_replicas.setter
+    def max_replicas(self, value: Optional[int]):
+        pulumi.set(self, "max_replicas", value)
+
+    @property
+    @pulumi.getter(name="minReplicas")
+    def min_replicas(self) -> Optional[int]:
+        """
+        The fewest replicas to scale to. Defaults to the application's replicas
+        """
+        return pulumi.get(self, "min_replicas")
+
+    @min_replicas.setter
+    def min_replicas(self, value: Optional[int]):
+        pulumi.set(self, "min_replicas", value)
+
+    @property
+    @pulumi.getter(name="targetCpuUtilizationPercentage")
+    def target_cpu_utilization_percentage(self) -> Optional[int]:
+        """
+        The average CPU utilization to scale at, as a percentage of the requested CPU. Defaults to the environment's target, or 70
+        """
+        return pulumi.get(self, "target_cpu_utilization_percentage")
+
+    @target_cpu_utilization_percentage.setter
+    def target_cpu_utilization_percentage(self, value: Optional[int]):
+        pulumi.set(self, "target_cpu_utilization_percentage", value)
+
+
+@pulumi.input_type
+class Await:
+    def __init__(__self__, *,
+                 create_timeout_seconds: Optional[int] = None,
+                 skip_await: Optional[bool] = None,
+                 update_timeout_seconds: Optional[int] = None,
+                 wait_for_load_balancer: Optional[bool] = None):
+        """
+        Controls how long Pulumi waits for the application to become ready.
+        :param int create_timeout_seconds: How long to wait for the application's resources to be created. Defaults to 10 minutes
+        :param bool skip_await: Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+        :param int update_timeout_seconds: How long to wait for the application's resources to be updated. Defaults to 10 minutes
+        :param bool wait_for_load_balancer: Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+        """
+        if create_timeout_seconds is not None:
+            pulumi.set(__self__, "create_timeout_seconds", create_timeout_seconds)
+        if skip_await is not None:
+            pulumi.set(__self__, "skip_await", skip_await)
+        if update_timeout_seconds is not None:
+            pulumi.set(__self__, "update_timeout_seconds", update_timeout_seconds)
+        if wait_for_load_balancer is not None:
+            pulumi.set(__self__, "wait_for_load_balancer", wait_for_load_balancer)
+
+    @property
+    @pulumi.getter(name="createTimeoutSeconds")
+    def create_timeout_seconds(self) -> Optional[int]:
+        """
+        How long to wait for the application's resources to be created. Defaults to 10 minutes
+        """
+        return pulumi.get(self, "create_timeout_seconds")
+
+    @create_timeout_seconds.setter
+    def create_timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "create_timeout_seconds", value)
+
+    @property
+    @pulumi.getter(name="skipAwait")
+    def skip_await(self) -> Optional[bool]:
+        """
+        Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+        """
+        return pulumi.get(self, "skip_await")
+
+    @skip_await.setter
+    def skip_await(self, value: Optional[bool]):
+        pulumi.set(self, "skip_await", value)
+
+    @property
+    @pulumi.getter(name="updateTimeoutSeconds")
+    def update_timeout_seconds(self) -> Optional[int]:
+        """
+        How long to wait for the application's resources to be updated. Defaults to 10 minutes
+        """
+        return pulumi.get(self, "update_timeout_seconds")
+
+    @update_timeout_seconds.setter
+    def update_timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "update_timeout_seconds", value)
+
+    @property
+    @pulumi.getter(name="waitForLoadBalancer")
+    def wait_for_load_balancer(self) -> Optional[bool]:
+        """
+        Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+        """
+        return pulumi.get(self, "wait_for_load_balancer")
+
+    @wait_for_load_balancer.setter
+    def wait_for_load_balancer(self, value: Optional[bool]):
+        pulumi.set(self, "wait_for_load_balancer", value)
+
+
+@pulumi.input_type
+class AwaitArgs:
+    def __init__(__self__, *,
+                 create_timeout_seconds: Optional[int] = None,
+                 skip_await: Optional[bool] = None,
+                 update_timeout_seconds: Optional[int] = None,
+                 wait_for_load_balancer: Optional[bool] = None):
+        """
+        Controls how long Pulumi waits for the application to become ready.
+        :param int create_timeout_seconds: How long to wait for the application's resources to be created. Defaults to 10 minutes
+        :param bool skip_await: Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+        :param int update_timeout_seconds: How long to wait for the application's resources to be updated. Defaults to 10 minutes
+        :param bool wait_for_load_balancer: Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+        """
+        if create_timeout_seconds is not None:
+            pulumi.set(__self__, "create_timeout_seconds", create_timeout_seconds)
+        if skip_await is not None:
+            pulumi.set(__self__, "skip_await", skip_await)
+        if update_timeout_seconds is not None:
+            pulumi.set(__self__, "update_timeout_seconds", update_timeout_seconds)
+        if wait_for_load_balancer is not None:
+            pulumi.set(__self__, "wait_for_load_balancer", wait_for_load_balancer)
+
+    @property
+    @pulumi.getter(name="createTimeoutSeconds")
+    def create_timeout_seconds(self) -> Optional[int]:
+        """
+        How long to wait for the application's resources to be created. Defaults to 10 minutes
+        """
+        return pulumi.get(self, "create_timeout_seconds")
+
+    @create_timeout_seconds.setter
+    def create_timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "create_timeout_seconds", value)
+
+    @property
+    @pulumi.getter(name="skipAwait")
+    def skip_await(self) -> Optional[bool]:
+        """
+        Don't wait for the Deployment and Service to become ready. The pre-deploy job is still awaited
+        """
+        return pulumi.get(self, "skip_await")
+
+    @skip_await.setter
+    def skip_await(self, value: Optional[bool]):
+        pulumi.set(self, "skip_await", value)
+
+    @property
+    @pulumi.getter(name="updateTimeoutSeconds")
+    def update_timeout_seconds(self) -> Optional[int]:
+        """
+        How long to wait for the application's resources to be updated. Defaults to 10 minutes
+        """
+        return pulumi.get(self, "update_timeout_seconds")
+
+    @update_timeout_seconds.setter
+    def update_timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "update_timeout_seconds", value)
+
+    @property
+    @pulumi.getter(name="waitForLoadBalancer")
+    def wait_for_load_balancer(self) -> Optional[bool]:
+        """
+        Wait for the Service's load balancer to be provisioned. Defaults to true; when false, `url` may be empty
+        """
+        return pulumi.get(self, "wait_for_load_balancer")
+
+    @wait_for_load_balancer.setter
+    def wait_for_load_balancer(self, value: Optional[bool]):
+        pulumi.set(self, "wait_for_load_balancer", value)
+
+
+@pulumi.input_type
+class CacheConnection:
+    def __init__(__self__, *,
+                 connection_string: str,
+                 host: str,
+                 port: int):
+        """
+        Describes how an application connects to a cache.
+        :param str connection_string: The connection string for the cache, such as the `connectionString` output of a `Cache`
+        :param str host: The hostname of the cache, such as the `host` output of a `Cache`
+        :param int port: The port of the cache, such as the `port` output of a `Cache`
+        """
+        pulumi.set(__self__, "connection_string", connection_string)
+        pulumi.set(__self__, "host", host)
+        pulumi.set(__self__, "port", port)
+
+    @property
+    @pulumi.getter(name="connectionString")
+    def connection_string(self) -> str:
+        """
+        The connection string for the cache, such as the `connectionString` output of a `Cache`
+        """
+        return pulumi.get(self, "connection_string")
+
+    @connection_string.setter
+    def connection_string(self, value: str):
+        pulumi.set(self, "connection_string", value)
+
+    @property
+    @pulumi.getter
+    def host(self) -> str:
+        """
+        The hostname of the cache, such as the `host` output of a `Cache`
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: str):
+        pulumi.set(self, "host", value)
+
+    @property
+    @pulumi.getter
+    def port(self) -> int:
+        """
+        The port of the cache, such as the `port` output of a `Cache`
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: int):
+        pulumi.set(self, "port", value)
+
+
+@pulumi.input_type
+class CacheConnectionArgs:
+    def __init__(__self__, *,
+                 connection_string: pulumi.Input[str],
+                 host: pulumi.Input[str],
+                 port: pulumi.Input[int]):
+        """
+        Describes how an application connects to a cache.
+        :param pulumi.Input[str] connection_string: The connection string for the cache, such as the `connectionString` output of a `Cache`
+        :param pulumi.Input[str] host: The hostname of the cache, such as the `host` output of a `Cache`
+        :param pulumi.Input[int] port: The port of the cache, such as the `port` output of a `Cache`
+        """
+        pulumi.set(__self__, "connection_string", connection_string)
+        pulumi.set(__self__, "host", host)
+        pulumi.set(__self__, "port", port)
+
+    @property
+    @pulumi.getter(name="connectionString")
+    def connection_string(self) -> pulumi.Input[str]:
+        """
+        The connection string for the cache, such as the `connectionString` output of a `Cache`
+        """
+        return pulumi.get(self, "connection_string")
+
+    @connection_string.setter
+    def connection_string(self, value: pulumi.Input[str]):
+        pulumi.set(self, "connection_string", value)
+
+    @property
+    @pulumi.getter
+    def host(self) -> pulumi.Input[str]:
+        """
+        The hostname of the cache, such as the `host` output of a `Cache`
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: pulumi.Input[str]):
+        pulumi.set(self, "host", value)
+
+    @property
+    @pulumi.getter
+    def port(self) -> pulumi.Input[int]:
+        """
+        The port of the cache, such as the `port` output of a `Cache`
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: pulumi.Input[int]):
+        pulumi.set(self, "port", value)
+
+
+@pulumi.input_type
+class Cluster:
+    def __init__(__self__, *,
+                 name: str,
+                 context: Optional[str] = None,
+                 image: Optional[str] = None,
+                 kubeconfig: Optional[str] = None,
+                 replicas: Optional[int] = None):
+        """
+        One of the clusters the application is deployed to, with overrides for the application's settings there.
+        :param str name: The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+        :param str context: The kubeconfig context of the cluster
+        :param str image: The image to deploy to the cluster, overriding `image`
+        :param str kubeconfig: The contents of a kubeconfig file, or the path to one, for the cluster
+        :param int replicas: The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+        """
+        pulumi.set(__self__, "name", name)
+        if context is not None:
+            pulumi.set(__self__, "context", context)
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if kubeconfig is not None:
+            pulumi.set(__self__, "kubeconfig", kubeconfig)
+        if replicas is not None:
+            pulumi.set(__self__, "replicas", replicas)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def context(self) -> Optional[str]:
+        """
+        The kubeconfig context of the cluster
+        """
+        return pulumi.get(self, "context")
+
+    @context.setter
+    def context(self, value: Optional[str]):
+        pulumi.set(self, "context", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[str]:
+        """
+        The image to deploy to the cluster, overriding `image`
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[str]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter
+    def kubeconfig(self) -> Optional[str]:
+        """
+        The contents of a kubeconfig file, or the path to one, for the cluster
+        """
+        return pulumi.get(self, "kubeconfig")
+
+    @kubeconfig.setter
+    def kubeconfig(self, value: Optional[str]):
+        pulumi.set(self, "kubeconfig", value)
+
+    @property
+    @pulumi.getter
+    def replicas(self) -> Optional[int]:
+        """
+        The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+        """
+        return pulumi.get(self, "replicas")
+
+    @replicas.setter
+    def replicas(self, value: Optional[int]):
+        pulumi.set(self, "replicas", value)
+
+
+@pulumi.input_type
+class ClusterArgs:
+    def __init__(__self__, *,
+                 name: str,
+                 context: Optional[pulumi.Input[str]] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 kubeconfig: Optional[pulumi.Input[str]] = None,
+                 replicas: Optional[int] = None):
+        """
+        One of the clusters the application is deployed to, with overrides for the application's settings there.
+        :param str name: The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+        :param pulumi.Input[str] context: The kubeconfig context of the cluster
+        :param pulumi.Input[str] image: The image to deploy to the cluster, overriding `image`
+        :param pulumi.Input[str] kubeconfig: The contents of a kubeconfig file, or the path to one, for the cluster
+        :param int replicas: The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+        """
+        pulumi.set(__self__, "name", name)
+        if context is not None:
+            pulumi.set(__self__, "context", context)
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if kubeconfig is not None:
+            pulumi.set(__self__, "kubeconfig", kubeconfig)
+        if replicas is not None:
+            pulumi.set(__self__, "replicas", replicas)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The cluster's name, unique among the clusters. Used as a suffix for the names of the application's resources in it
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def context(self) -> Optional[pulumi.Input[str]]:
+        """
+        The kubeconfig context of the cluster
+        """
+        return pulumi.get(self, "context")
+
+    @context.setter
+    def context(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "context", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[pulumi.Input[str]]:
+        """
+        The image to deploy to the cluster, overriding `image`
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter
+    def kubeconfig(self) -> Optional[pulumi.Input[str]]:
+        """
+        The contents of a kubeconfig file, or the path to one, for the cluster
+        """
+        return pulumi.get(self, "kubeconfig")
+
+    @kubeconfig.setter
+    def kubeconfig(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "kubeconfig", value)
+
+    @property
+    @pulumi.getter
+    def replicas(self) -> Optional[int]:
+        """
+        The number of replicas in the cluster, overriding the environment's. Must be at least 1 when the application autoscales
+        """
+        return pulumi.get(self, "replicas")
+
+    @replicas.setter
+    def replicas(self, value: Optional[int]):
+        pulumi.set(self, "replicas", value)
+
+
+@pulumi.input_type
+class ConfigMapVolume:
+    def __init__(__self__, *,
+                 name: str):
+        """
+        A volume populated from a ConfigMap.
+        :param str name: The name of the ConfigMap in the application namespace
+        """
+        pulumi.set(__self__, "name", name)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the ConfigMap in the application namespace
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+
+@pulumi.input_type
+class ConfigMapVolumeArgs:
+    def __init__(__self__, *,
+                 name: str):
+        """
+        A volume populated from a ConfigMap.
+        :param str name: The name of the ConfigMap in the application namespace
+        """
+        pulumi.set(__self__, "name", name)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the ConfigMap in the application namespace
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+
+@pulumi.input_type
+class DatabaseConnection:
+    def __init__(__self__, *,
+                 connection_string: str):
+        """
+        Describes how an application connects to a database.
+        :param str connection_string: The connection string for the database, such as the `connectionString` output of a `Database`
+        """
+        pulumi.set(__self__, "connection_string", connection_string)
+
+    @property
+    @pulumi.getter(name="connectionString")
+    def connection_string(self) -> str:
+        """
+        The connection string for the database, such as the `connectionString` output of a `Database`
+        """
+        return pulumi.get(self, "connection_string")
+
+    @connection_string.setter
+    def connection_string(self, value: str):
+        pulumi.set(self, "connection_string", value)
+
+
+@pulumi.input_type
+class DatabaseConnectionArgs:
+    def __init__(__self__, *,
+                 connection_string: pulumi.Input[str]):
+        """
+        Describes how an application connects to a database.
+        :param pulumi.Input[str] connection_string: The connection string for the database, such as the `connectionString` output of a `Database`
+        """
+        pulumi.set(__self__, "connection_string", connection_string)
+
+    @property
+    @pulumi.getter(name="connectionString")
+    def connection_string(self) -> pulumi.Input[str]:
+        """
+        The connection string for the database, such as the `connectionString` output of a `Database`
+        """
+        return pulumi.get(self, "connection_string")
+
+    @connection_string.setter
+    def connection_string(self, value: pulumi.Input[str]):
+        pulumi.set(self, "connection_string", value)
+
+
+@pulumi.input_type
+class EmptyDirVolume:
+    def __init__(__self__, *,
+                 medium: Optional[str] = None,
+                 size_limit: Optional[str] = None):
+        """
+        A scratch directory that lives as long as the pod.
+        :param str medium: Set to `Memory` to back the directory with tmpfs
+        :param str size_limit: The maximum size of the directory, such as `1Gi`
+        """
+        if medium is not None:
+            pulumi.set(__self__, "medium", medium)
+        if size_limit is not None:
+            pulumi.set(__self__, "size_limit", size_limit)
+
+    @property
+    @pulumi.getter
+    def medium(self) -> Optional[str]:
+        """
+        Set to `Memory` to back the directory with tmpfs
+        """
+        return pulumi.get(self, "medium")
+
+    @medium.setter
+    def medium(self, value: Optional[str]):
+        pulumi.set(self, "medium", value)
+
+    @property
+    @pulumi.getter(name="sizeLimit")
+    def size_limit(self) -> Optional[str]:
+        """
+        The maximum size of the directory, such as `1Gi`
+        """
+        return pulumi.get(self, "size_limit")
+
+    @size_limit.setter
+    def size_limit(self, value: Optional[str]):
+        pulumi.set(self, "size_limit", value)
+
+
+@pulumi.input_type
+class EmptyDirVolumeArgs:
+    def __init__(__self__, *,
+                 medium: Optional[str] = None,
+                 size_limit: Optional[str] = None):
+        """
+        A scratch directory that lives as long as the pod.
+        :param str medium: Set to `Memory` to back the directory with tmpfs
+        :param str size_limit: The maximum size of the directory, such as `1Gi`
+        """
+        if medium is not None:
+            pulumi.set(__self__, "medium", medium)
+        if size_limit is not None:
+            pulumi.set(__self__, "size_limit", size_limit)
+
+    @property
+    @pulumi.getter
+    def medium(self) -> Optional[str]:
+        """
+        Set to `Memory` to back the directory with tmpfs
+        """
+        return pulumi.get(self, "medium")
+
+    @medium.setter
+    def medium(self, value: Optional[str]):
+        pulumi.set(self, "medium", value)
+
+    @property
+    @pulumi.getter(name="sizeLimit")
+    def size_limit(self) -> Optional[str]:
+        """
+        The maximum size of the directory, such as `1Gi`
+        """
+        return pulumi.get(self, "size_limit")
+
+    @size_limit.setter
+    def size_limit(self, value: Optional[str]):
+        pulumi.set(self, "size_limit", value)
+
+
+@pulumi.input_type
+class HostPathVolume:
+    def __init__(__self__, *,
+                 path: str,
+                 type: Optional[str] = None):
+        """
+        A volume backed by a path on the node.
+        :param str path: The path on the node
+        :param str type: The type of the path, such as `Directory`
+        """
+        pulumi.set(__self__, "path", path)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def path(self) -> str:
+        """
+        The path on the node
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: str):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        """
+        The type of the path, such as `Directory`
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[str]):
+        pulumi.set(self, "type", value)
+
+
+@pulumi.input_type
+class HostPathVolumeArgs:
+    def __init__(__self__, *,
+                 path: str,
+                 type: Optional[str] = None):
+        """
+        A volume backed by a path on the node.
+        :param str path: The path on the node
+        :param str type: The type of the path, such as `Directory`
+        """
+        pulumi.set(__self__, "path", path)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def path(self) -> str:
+        """
+        The path on the node
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: str):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        """
+        The type of the path, such as `Directory`
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[str]):
+        pulumi.set(self, "type", value)
+
+
+@pulumi.input_type
+class Ingress:
+    def __init__(__self__, *,
+                 host: str,
+                 annotations: Optional[Mapping[str, str]] = None,
+                 class_name: Optional[str] = None,
+                 path: Optional[str] = None,
+                 tls_secret_name: Optional[str] = None):
+        """
+        Routes a host name to the application's Service.
+        :param str host: The host name to route, such as `app.example.com`
+        :param Mapping[str, str] annotations: Annotations added to the Ingress, such as the controller's settings
+        :param str class_name: The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+        :param str path: The path prefix to route. Defaults to `/`
+        :param str tls_secret_name: A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+        """
+        pulumi.set(__self__, "host", host)
+        if annotations is not None:
+            pulumi.set(__self__, "annotations", annotations)
+        if class_name is not None:
+            pulumi.set(__self__, "class_name", class_name)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if tls_secret_name is not None:
+            pulumi.set(__self__, "tls_secret_name", tls_secret_name)
+
+    @property
+    @pulumi.getter
+    def host(self) -> str:
+        """
+        The host name to route, such as `app.example.com`
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: str):
+        pulumi.set(self, "host", value)
+
+    @property
+    @pulumi.getter
+    def annotations(self) -> Optional[Mapping[str, str]]:
+        """
+        Annotations added to the Ingress, such as the controller's settings
+        """
+        return pulumi.get(self, "annotations")
+
+    @annotations.setter
+    def annotations(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "annotations", value)
+
+    @property
+    @pulumi.getter(name="className")
+    def class_name(self) -> Optional[str]:
+        """
+        The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+        """
+        return pulumi.get(self, "class_name")
+
+    @class_name.setter
+    def class_name(self, value: Optional[str]):
+        pulumi.set(self, "class_name", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[str]:
+        """
+        The path prefix to route. Defaults to `/`
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter(name="tlsSecretName")
+    def tls_secret_name(self) -> Optional[str]:
+        """
+        A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+        """
+        return pulumi.get(self, "tls_secret_name")
+
+    @tls_secret_name.setter
+    def tls_secret_name(self, value: Optional[str]):
+        pulumi.set(self, "tls_secret_name", value)
+
+
+@pulumi.input_type
+class IngressArgs:
+    def __init__(__self__, *,
+                 host: str,
+                 annotations: Optional[Mapping[str, str]] = None,
+                 class_name: Optional[str] = None,
+                 path: Optional[str] = None,
+                 tls_secret_name: Optional[str] = None):
+        """
+        Routes a host name to the application's Service.
+        :param str host: The host name to route, such as `app.example.com`
+        :param Mapping[str, str] annotations: Annotations added to the Ingress, such as the controller's settings
+        :param str class_name: The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+        :param str path: The path prefix to route. Defaults to `/`
+        :param str tls_secret_name: A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+        """
+        pulumi.set(__self__, "host", host)
+        if annotations is not None:
+            pulumi.set(__self__, "annotations", annotations)
+        if class_name is not None:
+            pulumi.set(__self__, "class_name", class_name)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if tls_secret_name is not None:
+            pulumi.set(__self__, "tls_secret_name", tls_secret_name)
+
+    @property
+    @pulumi.getter
+    def host(self) -> str:
+        """
+        The host name to route, such as `app.example.com`
+        """
+        return pulumi.get(self, "host")
+
+    @host.setter
+    def host(self, value: str):
+        pulumi.set(self, "host", value)
+
+    @property
+    @pulumi.getter
+    def annotations(self) -> Optional[Mapping[str, str]]:
+        """
+        Annotations added to the Ingress, such as the controller's settings
+        """
+        return pulumi.get(self, "annotations")
+
+    @annotations.setter
+    def annotations(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "annotations", value)
+
+    @property
+    @pulumi.getter(name="className")
+    def class_name(self) -> Optional[str]:
+        """
+        The IngressClass of the controller that serves the Ingress. Defaults to the cluster's default class
+        """
+        return pulumi.get(self, "class_name")
+
+    @class_name.setter
+    def class_name(self, value: Optional[str]):
+        pulumi.set(self, "class_name", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[str]:
+        """
+        The path prefix to route. Defaults to `/`
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter(name="tlsSecretName")
+    def tls_secret_name(self) -> Optional[str]:
+        """
+        A Secret in the application's namespace holding the host's TLS certificate. Setting it serves the application over HTTPS
+        """
+        return pulumi.get(self, "tls_secret_name")
+
+    @tls_secret_name.setter
+    def tls_secret_name(self, value: Optional[str]):
+        pulumi.set(self, "tls_secret_name", value)
+
+
+@pulumi.input_type
+class Patch:
+    def __init__(__self__, *,
+                 patch: Any,
+                 type: Optional[str] = None):
+        """
+        Modifies the arguments of every resource of one kind before it is registered.
+        :param Any patch: The patch, in the shape of the Kubernetes resource. `null` removes a field
+        :param str type: How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+        """
+        pulumi.set(__self__, "patch", patch)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def patch(self) -> Any:
+        """
+        The patch, in the shape of the Kubernetes resource. `null` removes a field
+        """
+        return pulumi.get(self, "patch")
+
+    @patch.setter
+    def patch(self, value: Any):
+        pulumi.set(self, "patch", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        """
+        How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[str]):
+        pulumi.set(self, "type", value)
+
+
+@pulumi.input_type
+class PatchArgs:
+    def __init__(__self__, *,
+                 patch: Any,
+                 type: Optional[str] = None):
+        """
+        Modifies the arguments of every resource of one kind before it is registered.
+        :param Any patch: The patch, in the shape of the Kubernetes resource. `null` removes a field
+        :param str type: How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+        """
+        pulumi.set(__self__, "patch", patch)
+        if type is not None:
+            pulumi.set(__self__, "type", type)
+
+    @property
+    @pulumi.getter
+    def patch(self) -> Any:
+        """
+        The patch, in the shape of the Kubernetes resource. `null` removes a field
+        """
+        return pulumi.get(self, "patch")
+
+    @patch.setter
+    def patch(self, value: Any):
+        pulumi.set(self, "patch", value)
+
+    @property
+    @pulumi.getter
+    def type(self) -> Optional[str]:
+        """
+        How the patch is applied: `strategic` (the default) merges lists of named elements such as containers and env by key, `merge` applies a JSON merge patch that replaces lists
+        """
+        return pulumi.get(self, "type")
+
+    @type.setter
+    def type(self, value: Optional[str]):
+        pulumi.set(self, "type", value)
+
+
+@pulumi.input_type
+class PreDeployJob:
+    def __init__(__self__, *,
+                 command: Optional[Sequence[str]] = None,
+                 env: Optional[Mapping[str, str]] = None,
+                 image: Optional[str] = None,
+                 timeout_seconds: Optional[int] = None):
+        """
+        A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+        :param Sequence[str] command: The command to run
+        :param Mapping[str, str] env: Environment variables for the job, in addition to those of the application
+        :param str image: The image to run. Defaults to the application image
+        :param int timeout_seconds: How long the job may run before it is considered failed
+        """
+        if command is not None:
+            pulumi.set(__self__, "command", command)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if timeout_seconds is not None:
+            pulumi.set(__self__, "timeout_seconds", timeout_seconds)
+
+    @property
+    @pulumi.getter
+    def command(self) -> Optional[Sequence[str]]:
+        """
+        The command to run
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter
+    def env(self) -> Optional[Mapping[str, str]]:
+        """
+        Environment variables for the job, in addition to those of the application
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "env", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[str]:
+        """
+        The image to run. Defaults to the application image
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[str]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter(name="timeoutSeconds")
+    def timeout_seconds(self) -> Optional[int]:
+        """
+        How long the job may run before it is considered failed
+        """
+        return pulumi.get(self, "timeout_seconds")
+
+    @timeout_seconds.setter
+    def timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "timeout_seconds", value)
+
+
+@pulumi.input_type
+class PreDeployJobArgs:
+    def __init__(__self__, *,
+                 command: Optional[Sequence[str]] = None,
+                 env: Optional[Mapping[str, str]] = None,
+                 image: Optional[str] = None,
+                 timeout_seconds: Optional[int] = None):
+        """
+        A one-shot Job, such as a database migration, that must succeed before the application is rolled out.
+        :param Sequence[str] command: The command to run
+        :param Mapping[str, str] env: Environment variables for the job, in addition to those of the application
+        :param str image: The image to run. Defaults to the application image
+        :param int timeout_seconds: How long the job may run before it is considered failed
+        """
+        if command is not None:
+            pulumi.set(__self__, "command", command)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if timeout_seconds is not None:
+            pulumi.set(__self__, "timeout_seconds", timeout_seconds)
+
+    @property
+    @pulumi.getter
+    def command(self) -> Optional[Sequence[str]]:
+        """
+        The command to run
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter
+    def env(self) -> Optional[Mapping[str, str]]:
+        """
+        Environment variables for the job, in addition to those of the application
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "env", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[str]:
+        """
+        The image to run. Defaults to the application image
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[str]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter(name="timeoutSeconds")
+    def timeout_seconds(self) -> Optional[int]:
+        """
+        How long the job may run before it is considered failed
+        """
+        return pulumi.get(self, "timeout_seconds")
+
+    @timeout_seconds.setter
+    def timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "timeout_seconds", value)
+
+
+@pulumi.input_type
+class Probe:
+    def __init__(__self__, *,
+                 failure_threshold: Optional[int] = None,
+                 initial_delay_seconds: Optional[int] = None,
+                 path: Optional[str] = None,
+                 period_seconds: Optional[int] = None,
+                 port: Optional[int] = None,
+                 timeout_seconds: Optional[int] = None):
+        """
+        An HTTP check the kubelet makes against the application container.
+        :param int failure_threshold: How many consecutive checks must fail before the probe fails. Defaults to 3
+        :param int initial_delay_seconds: How long to wait after the container starts before the first check
+        :param str path: The path to request. Defaults to `/`
+        :param int period_seconds: How often to check. Defaults to 10 seconds
+        :param int port: The container port to request. Defaults to the application's port
+        :param int timeout_seconds: How long a check may take. Defaults to 1 second
+        """
+        if failure_threshold is not None:
+            pulumi.set(__self__, "failure_threshold", failure_threshold)
+        if initial_delay_seconds is not None:
+            pulumi.set(__self__, "initial_delay_seconds", initial_delay_seconds)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if period_seconds is not None:
+            pulumi.set(__self__, "period_seconds", period_seconds)
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if timeout_seconds is not None:
+            pulumi.set(__self__, "timeout_seconds", timeout_seconds)
+
+    @property
+    @pulumi.getter(name="failureThreshold")
+    def failure_threshold(self) -> Optional[int]:
+        """
+        How many consecutive checks must fail before the probe fails. Defaults to 3
+        """
+        return pulumi.get(self, "failure_threshold")
+
+    @failure_threshold.setter
+    def failure_threshold(self, value: Optional[int]):
+        pulumi.set(self, "failure_threshold", value)
+
+    @property
+    @pulumi.getter(name="initialDelaySeconds")
+    def initial_delay_seconds(self) -> Optional[int]:
+        """
+        How long to wait after the container starts before the first check
+        """
+        return pulumi.get(self, "initial_delay_seconds")
+
+    @initial_delay_seconds.setter
+    def initial_delay_seconds(self, value: Optional[int]):
+        pulumi.set(self, "initial_delay_seconds", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[str]:
+        """
+        The path to request. Defaults to `/`
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter(name="periodSeconds")
+    def period_seconds(self) -> Optional[int]:
+        """
+        How often to check. Defaults to 10 seconds
+        """
+        return pulumi.get(self, "period_seconds")
+
+    @period_seconds.setter
+    def period_seconds(self, value: Optional[int]):
+        pulumi.set(self, "period_seconds", value)
+
+    @property
+    @pulumi.getter
+    def port(self) -> Optional[int]:
+        """
+        The container port to request. Defaults to the application's port
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: Optional[int]):
+        pulumi.set(self, "port", value)
+
+    @property
+    @pulumi.getter(name="timeoutSeconds")
+    def timeout_seconds(self) -> Optional[int]:
+        """
+        How long a check may take. Defaults to 1 second
+        """
+        return pulumi.get(self, "timeout_seconds")
+
+    @timeout_seconds.setter
+    def timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "timeout_seconds", value)
+
+
+@pulumi.input_type
+class ProbeArgs:
+    def __init__(__self__, *,
+                 failure_threshold: Optional[int] = None,
+                 initial_delay_seconds: Optional[int] = None,
+                 path: Optional[str] = None,
+                 period_seconds: Optional[int] = None,
+                 port: Optional[int] = None,
+                 timeout_seconds: Optional[int] = None):
+        """
+        An HTTP check the kubelet makes against the application container.
+        :param int failure_threshold: How many consecutive checks must fail before the probe fails. Defaults to 3
+        :param int initial_delay_seconds: How long to wait after the container starts before the first check
+        :param str path: The path to request. Defaults to `/`
+        :param int period_seconds: How often to check. Defaults to 10 seconds
+        :param int port: The container port to request. Defaults to the application's port
+        :param int timeout_seconds: How long a check may take. Defaults to 1 second
+        """
+        if failure_threshold is not None:
+            pulumi.set(__self__, "failure_threshold", failure_threshold)
+        if initial_delay_seconds is not None:
+            pulumi.set(__self__, "initial_delay_seconds", initial_delay_seconds)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if period_seconds is not None:
+            pulumi.set(__self__, "period_seconds", period_seconds)
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if timeout_seconds is not None:
+            pulumi.set(__self__, "timeout_seconds", timeout_seconds)
+
+    @property
+    @pulumi.getter(name="failureThreshold")
+    def failure_threshold(self) -> Optional[int]:
+        """
+        How many consecutive checks must fail before the probe fails. Defaults to 3
+        """
+        return pulumi.get(self, "failure_threshold")
+
+    @failure_threshold.setter
+    def failure_threshold(self, value: Optional[int]):
+        pulumi.set(self, "failure_threshold", value)
+
+    @property
+    @pulumi.getter(name="initialDelaySeconds")
+    def initial_delay_seconds(self) -> Optional[int]:
+        """
+        How long to wait after the container starts before the first check
+        """
+        return pulumi.get(self, "initial_delay_seconds")
+
+    @initial_delay_seconds.setter
+    def initial_delay_seconds(self, value: Optional[int]):
+        pulumi.set(self, "initial_delay_seconds", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[str]:
+        """
+        The path to request. Defaults to `/`
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter(name="periodSeconds")
+    def period_seconds(self) -> Optional[int]:
+        """
+        How often to check. Defaults to 10 seconds
+        """
+        return pulumi.get(self, "period_seconds")
+
+    @period_seconds.setter
+    def period_seconds(self, value: Optional[int]):
+        pulumi.set(self, "period_seconds", value)
+
+    @property
+    @pulumi.getter
+    def port(self) -> Optional[int]:
+        """
+        The container port to request. Defaults to the application's port
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: Optional[int]):
+        pulumi.set(self, "port", value)
+
+    @property
+    @pulumi.getter(name="timeoutSeconds")
+    def timeout_seconds(self) -> Optional[int]:
+        """
+        How long a check may take. Defaults to 1 second
+        """
+        return pulumi.get(self, "timeout_seconds")
+
+    @timeout_seconds.setter
+    def timeout_seconds(self, value: Optional[int]):
+        pulumi.set(self, "timeout_seconds", value)
+
+
+@pulumi.input_type
+class Quota:
+    def __init__(__self__, *,
+                 cpu: Optional[str] = None,
+                 default_limits: Optional[Mapping[str, str]] = None,
+                 default_requests: Optional[Mapping[str, str]] = None,
+                 load_balancers: Optional[int] = None,
+                 memory: Optional[str] = None,
+                 persistent_volume_claims: Optional[int] = None,
+                 pods: Optional[int] = None):
+        """
+        Caps what the application may consume in its namespace.
+        :param str cpu: The total CPU limit of all pods in the namespace, such as `4`
+        :param Mapping[str, str] default_limits: Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+        :param Mapping[str, str] default_requests: Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+        :param int load_balancers: The maximum number of LoadBalancer services in the namespace. Must be at least 1
+        :param str memory: The total memory limit of all pods in the namespace, such as `8Gi`
+        :param int persistent_volume_claims: The maximum number of persistent volume claims in the namespace
+        :param int pods: The maximum number of pods in the namespace
+        """
+        if cpu is not None:
+            pulumi.set(__self__, "cpu", cpu)
+        if default_limits is not None:
+            pulumi.set(__self__, "default_limits", default_limits)
+        if default_requests is not None:
+            pulumi.set(__self__, "default_requests", default_requests)
+        if load_balancers is not None:
+            pulumi.set(__self__, "load_balancers", load_balancers)
+        if memory is not None:
+            pulumi.set(__self__, "memory", memory)
+        if persistent_volume_claims is not None:
+            pulumi.set(__self__, "persistent_volume_claims", persistent_volume_claims)
+        if pods is not None:
+            pulumi.set(__self__, "pods", pods)
+
+    @property
+    @pulumi.getter
+    def cpu(self) -> Optional[str]:
+        """
+        The total CPU limit of all pods in the namespace, such as `4`
+        """
+        return pulumi.get(self, "cpu")
+
+    @cpu.setter
+    def cpu(self, value: Optional[str]):
+        pulumi.set(self, "cpu", value)
+
+    @property
+    @pulumi.getter(name="defaultLimits")
+    def default_limits(self) -> Optional[Mapping[str, str]]:
+        """
+        Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+        """
+        return pulumi.get(self, "default_limits")
+
+    @default_limits.setter
+    def default_limits(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "default_limits", value)
+
+    @property
+    @pulumi.getter(name="defaultRequests")
+    def default_requests(self) -> Optional[Mapping[str, str]]:
+        """
+        Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+        """
+        return pulumi.get(self, "default_requests")
+
+    @default_requests.setter
+    def default_requests(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "default_requests", value)
+
+    @property
+    @pulumi.getter(name="loadBalancers")
+    def load_balancers(self) -> Optional[int]:
+        """
+        The maximum number of LoadBalancer services in the namespace. Must be at least 1
+        """
+        return pulumi.get(self, "load_balancers")
+
+    @load_balancers.setter
+    def load_balancers(self, value: Optional[int]):
+        pulumi.set(self, "load_balancers", value)
+
+    @property
+    @pulumi.getter
+    def memory(self) -> Optional[str]:
+        """
+        The total memory limit of all pods in the namespace, such as `8Gi`
+        """
+        return pulumi.get(self, "memory")
+
+    @memory.setter
+    def memory(self, value: Optional[str]):
+        pulumi.set(self, "memory", value)
+
+    @property
+    @pulumi.getter(name="persistentVolumeClaims")
+    def persistent_volume_claims(self) -> Optional[int]:
+        """
+        The maximum number of persistent volume claims in the namespace
+        """
+        return pulumi.get(self, "persistent_volume_claims")
+
+    @persistent_volume_claims.setter
+    def persistent_volume_claims(self, value: Optional[int]):
+        pulumi.set(self, "persistent_volume_claims", value)
+
+    @property
+    @pulumi.getter
+    def pods(self) -> Optional[int]:
+        """
+        The maximum number of pods in the namespace
+        """
+        return pulumi.get(self, "pods")
+
+    @pods.setter
+    def pods(self, value: Optional[int]):
+        pulumi.set(self, "pods", value)
+
+
+@pulumi.input_type
+class QuotaArgs:
+    def __init__(__self__, *,
+                 cpu: Optional[str] = None,
+                 default_limits: Optional[Mapping[str, str]] = None,
+                 default_requests: Optional[Mapping[str, str]] = None,
+                 load_balancers: Optional[int] = None,
+                 memory: Optional[str] = None,
+                 persistent_volume_claims: Optional[int] = None,
+                 pods: Optional[int] = None):
+        """
+        Caps what the application may consume in its namespace.
+        :param str cpu: The total CPU limit of all pods in the namespace, such as `4`
+        :param Mapping[str, str] default_limits: Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+        :param Mapping[str, str] default_requests: Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+        :param int load_balancers: The maximum number of LoadBalancer services in the namespace. Must be at least 1
+        :param str memory: The total memory limit of all pods in the namespace, such as `8Gi`
+        :param int persistent_volume_claims: The maximum number of persistent volume claims in the namespace
+        :param int pods: The maximum number of pods in the namespace
+        """
+        if cpu is not None:
+            pulumi.set(__self__, "cpu", cpu)
+        if default_limits is not None:
+            pulumi.set(__self__, "default_limits", default_limits)
+        if default_requests is not None:
+            pulumi.set(__self__, "default_requests", default_requests)
+        if load_balancers is not None:
+            pulumi.set(__self__, "load_balancers", load_balancers)
+        if memory is not None:
+            pulumi.set(__self__, "memory", memory)
+        if persistent_volume_claims is not None:
+            pulumi.set(__self__, "persistent_volume_claims", persistent_volume_claims)
+        if pods is not None:
+            pulumi.set(__self__, "pods", pods)
+
+    @property
+    @pulumi.getter
+    def cpu(self) -> Optional[str]:
+        """
+        The total CPU limit of all pods in the namespace, such as `4`
+        """
+        return pulumi.get(self, "cpu")
+
+    @cpu.setter
+    def cpu(self, value: Optional[str]):
+        pulumi.set(self, "cpu", value)
+
+    @property
+    @pulumi.getter(name="defaultLimits")
+    def default_limits(self) -> Optional[Mapping[str, str]]:
+        """
+        Resource limits for containers that don't set their own. Defaults to 500m CPU and 512Mi memory
+        """
+        return pulumi.get(self, "default_limits")
+
+    @default_limits.setter
+    def default_limits(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "default_limits", value)
+
+    @property
+    @pulumi.getter(name="defaultRequests")
+    def default_requests(self) -> Optional[Mapping[str, str]]:
+        """
+        Resource requests for containers that don't set their own. Defaults to 100m CPU and 128Mi memory
+        """
+        return pulumi.get(self, "default_requests")
+
+    @default_requests.setter
+    def default_requests(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "default_requests", value)
+
+    @property
+    @pulumi.getter(name="loadBalancers")
+    def load_balancers(self) -> Optional[int]:
+        """
+        The maximum number of LoadBalancer services in the namespace. Must be at least 1
+        """
+        return pulumi.get(self, "load_balancers")
+
+    @load_balancers.setter
+    def load_balancers(self, value: Optional[int]):
+        pulumi.set(self, "load_balancers", value)
+
+    @property
+    @pulumi.getter
+    def memory(self) -> Optional[str]:
+        """
+        The total memory limit of all pods in the namespace, such as `8Gi`
+        """
+        return pulumi.get(self, "memory")
+
+    @memory.setter
+    def memory(self, value: Optional[str]):
+        pulumi.set(self, "memory", value)
+
+    @property
+    @pulumi.getter(name="persistentVolumeClaims")
+    def persistent_volume_claims(self) -> Optional[int]:
+        """
+        The maximum number of persistent volume claims in the namespace
+        """
+        return pulumi.get(self, "persistent_volume_claims")
+
+    @persistent_volume_claims.setter
+    def persistent_volume_claims(self, value: Optional[int]):
+        pulumi.set(self, "persistent_volume_claims", value)
+
+    @property
+    @pulumi.getter
+    def pods(self) -> Optional[int]:
+        """
+        The maximum number of pods in the namespace
+        """
+        return pulumi.get(self, "pods")
+
+    @pods.setter
+    def pods(self, value: Optional[int]):
+        pulumi.set(self, "pods", value)
+
+
+@pulumi.input_type
+class RegistryCredentials:
+    def __init__(__self__, *,
+                 existing_secret_name: Optional[str] = None,
+                 password: Optional[str] = None,
+                 server: Optional[str] = None,
+                 username: Optional[str] = None):
+        """
+        The credentials the application's pods pull images with.
+        :param str existing_secret_name: The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+        :param str password: The password or token to authenticate with
+        :param str server: The registry server, such as `ghcr.io`
+        :param str username: The username to authenticate with
+        """
+        if existing_secret_name is not None:
+            pulumi.set(__self__, "existing_secret_name", existing_secret_name)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if server is not None:
+            pulumi.set(__self__, "server", server)
+        if username is not None:
+            pulumi.set(__self__, "username", username)
+
+    @property
+    @pulumi.getter(name="existingSecretName")
+    def existing_secret_name(self) -> Optional[str]:
+        """
+        The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+        """
+        return pulumi.get(self, "existing_secret_name")
+
+    @existing_secret_name.setter
+    def existing_secret_name(self, value: Optional[str]):
+        pulumi.set(self, "existing_secret_name", value)
+
+    @property
+    @pulumi.getter
+    def password(self) -> Optional[str]:
+        """
+        The password or token to authenticate with
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[str]):
+        pulumi.set(self, "password", value)
+
+    @property
+    @pulumi.getter
+    def server(self) -> Optional[str]:
+        """
+        The registry server, such as `ghcr.io`
+        """
+        return pulumi.get(self, "server")
+
+    @server.setter
+    def server(self, value: Optional[str]):
+        pulumi.set(self, "server", value)
+
+    @property
+    @pulumi.getter
+    def username(self) -> Optional[str]:
+        """
+        The username to authenticate with
+        """
+        return pulumi.get(self, "username")
+
+    @username.setter
+    def username(self, value: Optional[str]):
+        pulumi.set(self, "username", value)
+
+
+@pulumi.input_type
+class RegistryCredentialsArgs:
+    def __init__(__self__, *,
+                 existing_secret_name: Optional[pulumi.Input[str]] = None,
+                 password: Optional[pulumi.Input[str]] = None,
+                 server: Optional[pulumi.Input[str]] = None,
+                 username: Optional[pulumi.Input[str]] = None):
+        """
+        The credentials the application's pods pull images with.
+        :param pulumi.Input[str] existing_secret_name: The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+        :param pulumi.Input[str] password: The password or token to authenticate with
+        :param pulumi.Input[str] server: The registry server, such as `ghcr.io`
+        :param pulumi.Input[str] username: The username to authenticate with
+        """
+        if existing_secret_name is not None:
+            pulumi.set(__self__, "existing_secret_name", existing_secret_name)
+        if password is not None:
+            pulumi.set(__self__, "password", password)
+        if server is not None:
+            pulumi.set(__self__, "server", server)
+        if username is not None:
+            pulumi.set(__self__, "username", username)
+
+    @property
+    @pulumi.getter(name="existingSecretName")
+    def existing_secret_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of an existing image pull secret in the application namespace, instead of `server`, `username` and `password`
+        """
+        return pulumi.get(self, "existing_secret_name")
+
+    @existing_secret_name.setter
+    def existing_secret_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "existing_secret_name", value)
+
+    @property
+    @pulumi.getter
+    def password(self) -> Optional[pulumi.Input[str]]:
+        """
+        The password or token to authenticate with
+        """
+        return pulumi.get(self, "password")
+
+    @password.setter
+    def password(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "password", value)
+
+    @property
+    @pulumi.getter
+    def server(self) -> Optional[pulumi.Input[str]]:
+        """
+        The registry server, such as `ghcr.io`
+        """
+        return pulumi.get(self, "server")
+
+    @server.setter
+    def server(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "server", value)
+
+    @property
+    @pulumi.getter
+    def username(self) -> Optional[pulumi.Input[str]]:
+        """
+        The username to authenticate with
+        """
+        return pulumi.get(self, "username")
+
+    @username.setter
+    def username(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "username", value)
+
+
+@pulumi.input_type
+class Resources:
+    def __init__(__self__, *,
+                 limits: Optional[Mapping[str, str]] = None,
+                 requests: Optional[Mapping[str, str]] = None):
+        """
+        Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+        :param Mapping[str, str] limits: The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+        :param Mapping[str, str] requests: The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+        """
+        if limits is not None:
+            pulumi.set(__self__, "limits", limits)
+        if requests is not None:
+            pulumi.set(__self__, "requests", requests)
+
+    @property
+    @pulumi.getter
+    def limits(self) -> Optional[Mapping[str, str]]:
+        """
+        The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+        """
+        return pulumi.get(self, "limits")
+
+    @limits.setter
+    def limits(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "limits", value)
+
+    @property
+    @pulumi.getter
+    def requests(self) -> Optional[Mapping[str, str]]:
+        """
+        The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+        """
+        return pulumi.get(self, "requests")
+
+    @requests.setter
+    def requests(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "requests", value)
+
+
+@pulumi.input_type
+class ResourcesArgs:
+    def __init__(__self__, *,
+                 limits: Optional[Mapping[str, str]] = None,
+                 requests: Optional[Mapping[str, str]] = None):
+        """
+        Overrides the application container's resource requests and limits. Each entry overrides the one the environment or size sets.
+        :param Mapping[str, str] limits: The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+        :param Mapping[str, str] requests: The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+        """
+        if limits is not None:
+            pulumi.set(__self__, "limits", limits)
+        if requests is not None:
+            pulumi.set(__self__, "requests", requests)
+
+    @property
+    @pulumi.getter
+    def limits(self) -> Optional[Mapping[str, str]]:
+        """
+        The resource limits, such as `cpu: "1"` or `memory: 1Gi`
+        """
+        return pulumi.get(self, "limits")
+
+    @limits.setter
+    def limits(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "limits", value)
+
+    @property
+    @pulumi.getter
+    def requests(self) -> Optional[Mapping[str, str]]:
+        """
+        The resources requested, such as `cpu: 250m` or `memory: 512Mi`
+        """
+        return pulumi.get(self, "requests")
+
+    @requests.setter
+    def requests(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "requests", value)
+
+
+@pulumi.input_type
+class SecretVolume:
+    def __init__(__self__, *,
+                 secret_name: str):
+        """
+        A volume populated from a Secret.
+        :param str secret_name: The name of the Secret in the application namespace
+        """
+        pulumi.set(__self__, "secret_name", secret_name)
+
+    @property
+    @pulumi.getter(name="secretName")
+    def secret_name(self) -> str:
+        """
+        The name of the Secret in the application namespace
+        """
+        return pulumi.get(self, "secret_name")
+
+    @secret_name.setter
+    def secret_name(self, value: str):
+        pulumi.set(self, "secret_name", value)
+
+
+@pulumi.input_type
+class SecretVolumeArgs:
+    def __init__(__self__, *,
+                 secret_name: str):
+        """
+        A volume populated from a Secret.
+        :param str secret_name: The name of the Secret in the application namespace
+        """
+        pulumi.set(__self__, "secret_name", secret_name)
+
+    @property
+    @pulumi.getter(name="secretName")
+    def secret_name(self) -> str:
+        """
+        The name of the Secret in the application namespace
+        """
+        return pulumi.get(self, "secret_name")
+
+    @secret_name.setter
+    def secret_name(self, value: str):
+        pulumi.set(self, "secret_name", value)
+
+
+@pulumi.input_type
+class Shutdown:
+    def __init__(__self__, *,
+                 pre_stop_command: Optional[Sequence[str]] = None,
+                 pre_stop_sleep_seconds: Optional[int] = None,
+                 termination_grace_period_seconds: Optional[int] = None):
+        """
+        Configures how the application's pods are stopped.
+        :param Sequence[str] pre_stop_command: A command to run in the preStop hook instead of sleeping
+        :param int pre_stop_sleep_seconds: How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+        :param int termination_grace_period_seconds: How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+        """
+        if pre_stop_command is not None:
+            pulumi.set(__self__, "pre_stop_command", pre_stop_command)
+        if pre_stop_sleep_seconds is not None:
+            pulumi.set(__self__, "pre_stop_sleep_seconds", pre_stop_sleep_seconds)
+        if termination_grace_period_seconds is not None:
+            pulumi.set(__self__, "termination_grace_period_seconds", termination_grace_period_seconds)
+
+    @property
+    @pulumi.getter(name="preStopCommand")
+    def pre_stop_command(self) -> Optional[Sequence[str]]:
+        """
+        A command to run in the preStop hook instead of sleeping
+        """
+        return pulumi.get(self, "pre_stop_command")
+
+    @pre_stop_command.setter
+    def pre_stop_command(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "pre_stop_command", value)
+
+    @property
+    @pulumi.getter(name="preStopSleepSeconds")
+    def pre_stop_sleep_seconds(self) -> Optional[int]:
+        """
+        How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+        """
+        return pulumi.get(self, "pre_stop_sleep_seconds")
+
+    @pre_stop_sleep_seconds.setter
+    def pre_stop_sleep_seconds(self, value: Optional[int]):
+        pulumi.set(self, "pre_stop_sleep_seconds", value)
+
+    @property
+    @pulumi.getter(name="terminationGracePeriodSeconds")
+    def termination_grace_period_seconds(self) -> Optional[int]:
+        """
+        How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+        """
+        return pulumi.get(self, "termination_grace_period_seconds")
+
+    @termination_grace_period_seconds.setter
+    def termination_grace_period_seconds(self, value: Optional[int]):
+        pulumi.set(self, "termination_grace_period_seconds", value)
+
+
+@pulumi.input_type
+class ShutdownArgs:
+    def __init__(__self__, *,
+                 pre_stop_command: Optional[Sequence[str]] = None,
+                 pre_stop_sleep_seconds: Optional[int] = None,
+                 termination_grace_period_seconds: Optional[int] = None):
+        """
+        Configures how the application's pods are stopped.
+        :param Sequence[str] pre_stop_command: A command to run in the preStop hook instead of sleeping
+        :param int pre_stop_sleep_seconds: How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+        :param int termination_grace_period_seconds: How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+        """
+        if pre_stop_command is not None:
+            pulumi.set(__self__, "pre_stop_command", pre_stop_command)
+        if pre_stop_sleep_seconds is not None:
+            pulumi.set(__self__, "pre_stop_sleep_seconds", pre_stop_sleep_seconds)
+        if termination_grace_period_seconds is not None:
+            pulumi.set(__self__, "termination_grace_period_seconds", termination_grace_period_seconds)
+
+    @property
+    @pulumi.getter(name="preStopCommand")
+    def pre_stop_command(self) -> Optional[Sequence[str]]:
+        """
+        A command to run in the preStop hook instead of sleeping
+        """
+        return pulumi.get(self, "pre_stop_command")
+
+    @pre_stop_command.setter
+    def pre_stop_command(self, value: Optional[Sequence[str]]):
+        pulumi.set(self, "pre_stop_command", value)
+
+    @property
+    @pulumi.getter(name="preStopSleepSeconds")
+    def pre_stop_sleep_seconds(self) -> Optional[int]:
+        """
+        How long to sleep in the preStop hook before the application receives SIGTERM. Requires a `sleep` binary in the image. Set to 0 to disable the hook
+        """
+        return pulumi.get(self, "pre_stop_sleep_seconds")
+
+    @pre_stop_sleep_seconds.setter
+    def pre_stop_sleep_seconds(self, value: Optional[int]):
+        pulumi.set(self, "pre_stop_sleep_seconds", value)
+
+    @property
+    @pulumi.getter(name="terminationGracePeriodSeconds")
+    def termination_grace_period_seconds(self) -> Optional[int]:
+        """
+        How long pods have to stop, including the preStop hook. Defaults to the preStop sleep plus 30 seconds
+        """
+        return pulumi.get(self, "termination_grace_period_seconds")
+
+    @termination_grace_period_seconds.setter
+    def termination_grace_period_seconds(self, value: Optional[int]):
+        pulumi.set(self, "termination_grace_period_seconds", value)
+
+
+@pulumi.input_type
+class SmokeTest:
+    def __init__(__self__, *,
+                 body_contains: Optional[str] = None,
+                 expected_status: Optional[int] = None,
+                 interval_seconds: Optional[int] = None,
+                 path: Optional[str] = None,
+                 retries: Optional[int] = None):
+        """
+        An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+        :param str body_contains: Text the response's body must contain
+        :param int expected_status: The status code the response must have. Defaults to 200
+        :param int interval_seconds: How long to wait between attempts. Defaults to 5 seconds
+        :param str path: The path to request. Defaults to `/`
+        :param int retries: How many times to retry the request before failing. Defaults to 10
+        """
+        if body_contains is not None:
+            pulumi.set(__self__, "body_contains", body_contains)
+        if expected_status is not None:
+            pulumi.set(__self__, "expected_status", expected_status)
+        if interval_seconds is not None:
+            pulumi.set(__self__, "interval_seconds", interval_seconds)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if retries is not None:
+            pulumi.set(__self__, "retries", retries)
+
+    @property
+    @pulumi.getter(name="bodyContains")
+    def body_contains(self) -> Optional[str]:
+        """
+        Text the response's body must contain
+        """
+        return pulumi.get(self, "body_contains")
+
+    @body_contains.setter
+    def body_contains(self, value: Optional[str]):
+        pulumi.set(self, "body_contains", value)
+
+    @property
+    @pulumi.getter(name="expectedStatus")
+    def expected_status(self) -> Optional[int]:
+        """
+        The status code the response must have. Defaults to 200
+        """
+        return pulumi.get(self, "expected_status")
+
+    @expected_status.setter
+    def expected_status(self, value: Optional[int]):
+        pulumi.set(self, "expected_status", value)
+
+    @property
+    @pulumi.getter(name="intervalSeconds")
+    def interval_seconds(self) -> Optional[int]:
+        """
+        How long to wait between attempts. Defaults to 5 seconds
+        """
+        return pulumi.get(self, "interval_seconds")
+
+    @interval_seconds.setter
+    def interval_seconds(self, value: Optional[int]):
+        pulumi.set(self, "interval_seconds", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[str]:
+        """
+        The path to request. Defaults to `/`
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def retries(self) -> Optional[int]:
+        """
+        How many times to retry the request before failing. Defaults to 10
+        """
+        return pulumi.get(self, "retries")
+
+    @retries.setter
+    def retries(self, value: Optional[int]):
+        pulumi.set(self, "retries", value)
+
+
+@pulumi.input_type
+class SmokeTestArgs:
+    def __init__(__self__, *,
+                 body_contains: Optional[str] = None,
+                 expected_status: Optional[int] = None,
+                 interval_seconds: Optional[int] = None,
+                 path: Optional[str] = None,
+                 retries: Optional[int] = None):
+        """
+        An HTTP GET request made to the application's URL once it is deployed. It is not run during previews.
+        :param str body_contains: Text the response's body must contain
+        :param int expected_status: The status code the response must have. Defaults to 200
+        :param int interval_seconds: How long to wait between attempts. Defaults to 5 seconds
+        :param str path: The path to request. Defaults to `/`
+        :param int retries: How many times to retry the request before failing. Defaults to 10
+        """
+        if body_contains is not None:
+            pulumi.set(__self__, "body_contains", body_contains)
+        if expected_status is not None:
+            pulumi.set(__self__, "expected_status", expected_status)
+        if interval_seconds is not None:
+            pulumi.set(__self__, "interval_seconds", interval_seconds)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+        if retries is not None:
+            pulumi.set(__self__, "retries", retries)
+
+    @property
+    @pulumi.getter(name="bodyContains")
+    def body_contains(self) -> Optional[str]:
+        """
+        Text the response's body must contain
+        """
+        return pulumi.get(self, "body_contains")
+
+    @body_contains.setter
+    def body_contains(self, value: Optional[str]):
+        pulumi.set(self, "body_contains", value)
+
+    @property
+    @pulumi.getter(name="expectedStatus")
+    def expected_status(self) -> Optional[int]:
+        """
+        The status code the response must have. Defaults to 200
+        """
+        return pulumi.get(self, "expected_status")
+
+    @expected_status.setter
+    def expected_status(self, value: Optional[int]):
+        pulumi.set(self, "expected_status", value)
+
+    @property
+    @pulumi.getter(name="intervalSeconds")
+    def interval_seconds(self) -> Optional[int]:
+        """
+        How long to wait between attempts. Defaults to 5 seconds
+        """
+        return pulumi.get(self, "interval_seconds")
+
+    @interval_seconds.setter
+    def interval_seconds(self, value: Optional[int]):
+        pulumi.set(self, "interval_seconds", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[str]:
+        """
+        The path to request. Defaults to `/`
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[str]):
+        pulumi.set(self, "path", value)
+
+    @property
+    @pulumi.getter
+    def retries(self) -> Optional[int]:
+        """
+        How many times to retry the request before failing. Defaults to 10
+        """
+        return pulumi.get(self, "retries")
+
+    @retries.setter
+    def retries(self, value: Optional[int]):
+        pulumi.set(self, "retries", value)
+
+
+@pulumi.input_type
+class Volume:
+    def __init__(__self__, *,
+                 name: str,
+                 config_map: Optional['ConfigMapVolume'] = None,
+                 empty_dir: Optional['EmptyDirVolume'] = None,
+                 host_path: Optional['HostPathVolume'] = None,
+                 secret: Optional['SecretVolume'] = None):
+        """
+        A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+        :param str name: The name of the volume, referenced by `volumeMounts`
+        :param 'ConfigMapVolume' config_map: The contents of a ConfigMap
+        :param 'EmptyDirVolume' empty_dir: A scratch directory that lives as long as the pod
+        :param 'HostPathVolume' host_path: A path on the node. Requires `allowHostPath`
+        :param 'SecretVolume' secret: The contents of a Secret
+        """
+        pulumi.set(__self__, "name", name)
+        if config_map is not None:
+            pulumi.set(__self__, "config_map", config_map)
+        if empty_dir is not None:
+            pulumi.set(__self__, "empty_dir", empty_dir)
+        if host_path is not None:
+            pulumi.set(__self__, "host_path", host_path)
+        if secret is not None:
+            pulumi.set(__self__, "secret", secret)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the volume, referenced by `volumeMounts`
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="configMap")
+    def config_map(self) -> Optional['ConfigMapVolume']:
+        """
+        The contents of a ConfigMap
+        """
+        return pulumi.get(self, "config_map")
+
+    @config_map.setter
+    def config_map(self, value: Optional['ConfigMapVolume']):
+        pulumi.set(self, "config_map", value)
+
+    @property
+    @pulumi.getter(name="emptyDir")
+    def empty_dir(self) -> Optional['EmptyDirVolume']:
+        """
+        A scratch directory that lives as long as the pod
+        """
+        return pulumi.get(self, "empty_dir")
+
+    @empty_dir.setter
+    def empty_dir(self, value: Optional['EmptyDirVolume']):
+        pulumi.set(self, "empty_dir", value)
+
+    @property
+    @pulumi.getter(name="hostPath")
+    def host_path(self) -> Optional['HostPathVolume']:
+        """
+        A path on the node. Requires `allowHostPath`
+        """
+        return pulumi.get(self, "host_path")
+
+    @host_path.setter
+    def host_path(self, value: Optional['HostPathVolume']):
+        pulumi.set(self, "host_path", value)
+
+    @property
+    @pulumi.getter
+    def secret(self) -> Optional['SecretVolume']:
+        """
+        The contents of a Secret
+        """
+        return pulumi.get(self, "secret")
+
+    @secret.setter
+    def secret(self, value: Optional['SecretVolume']):
+        pulumi.set(self, "secret", value)
+
+
+@pulumi.input_type
+class VolumeMount:
+    def __init__(__self__, *,
+                 mount_path: str,
+                 name: str,
+                 read_only: Optional[bool] = None,
+                 sub_path: Optional[str] = None):
+        """
+        Mounts one of the application's volumes into its container.
+        :param str mount_path: Where to mount the volume
+        :param str name: The name of the volume to mount
+        :param bool read_only: Whether to mount the volume read-only
+        :param str sub_path: A path within the volume to mount instead of its root
+        """
+        pulumi.set(__self__, "mount_path", mount_path)
+        pulumi.set(__self__, "name", name)
+        if read_only is not None:
+            pulumi.set(__self__, "read_only", read_only)
+        if sub_path is not None:
+            pulumi.set(__self__, "sub_path", sub_path)
+
+    @property
+    @pulumi.getter(name="mountPath")
+    def mount_path(self) -> str:
+        """
+        Where to mount the volume
+        """
+        return pulumi.get(self, "mount_path")
+
+    @mount_path.setter
+    def mount_path(self, value: str):
+        pulumi.set(self, "mount_path", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the volume to mount
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="readOnly")
+    def read_only(self) -> Optional[bool]:
+        """
+        Whether to mount the volume read-only
+        """
+        return pulumi.get(self, "read_only")
+
+    @read_only.setter
+    def read_only(self, value: Optional[bool]):
+        pulumi.set(self, "read_only", value)
+
+    @property
+    @pulumi.getter(name="subPath")
+    def sub_path(self) -> Optional[str]:
+        """
+        A path within the volume to mount instead of its root
+        """
+        return pulumi.get(self, "sub_path")
+
+    @sub_path.setter
+    def sub_path(self, value: Optional[str]):
+        pulumi.set(self, "sub_path", value)
+
+
+@pulumi.input_type
+class VolumeMountArgs:
+    def __init__(__self__, *,
+                 mount_path: str,
+                 name: str,
+                 read_only: Optional[bool] = None,
+                 sub_path: Optional[str] = None):
+        """
+        Mounts one of the application's volumes into its container.
+        :param str mount_path: Where to mount the volume
+        :param str name: The name of the volume to mount
+        :param bool read_only: Whether to mount the volume read-only
+        :param str sub_path: A path within the volume to mount instead of its root
+        """
+        pulumi.set(__self__, "mount_path", mount_path)
+        pulumi.set(__self__, "name", name)
+        if read_only is not None:
+            pulumi.set(__self__, "read_only", read_only)
+        if sub_path is not None:
+            pulumi.set(__self__, "sub_path", sub_path)
+
+    @property
+    @pulumi.getter(name="mountPath")
+    def mount_path(self) -> str:
+        """
+        Where to mount the volume
+        """
+        return pulumi.get(self, "mount_path")
+
+    @mount_path.setter
+    def mount_path(self, value: str):
+        pulumi.set(self, "mount_path", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the volume to mount
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="readOnly")
+    def read_only(self) -> Optional[bool]:
+        """
+        Whether to mount the volume read-only
+        """
+        return pulumi.get(self, "read_only")
+
+    @read_only.setter
+    def read_only(self, value: Optional[bool]):
+        pulumi.set(self, "read_only", value)
+
+    @property
+    @pulumi.getter(name="subPath")
+    def sub_path(self) -> Optional[str]:
+        """
+        A path within the volume to mount instead of its root
+        """
+        return pulumi.get(self, "sub_path")
+
+    @sub_path.setter
+    def sub_path(self, value: Optional[str]):
+        pulumi.set(self, "sub_path", value)
+
+
+@pulumi.input_type
+class VolumeArgs:
+    def __init__(__self__, *,
+                 name: str,
+                 config_map: Optional['ConfigMapVolumeArgs'] = None,
+                 empty_dir: Optional['EmptyDirVolumeArgs'] = None,
+                 host_path: Optional['HostPathVolumeArgs'] = None,
+                 secret: Optional['SecretVolumeArgs'] = None):
+        """
+        A volume that can be mounted into the application container. Exactly one of `emptyDir`, `configMap`, `secret` or `hostPath` must be set.
+        :param str name: The name of the volume, referenced by `volumeMounts`
+        :param 'ConfigMapVolumeArgs' config_map: The contents of a ConfigMap
+        :param 'EmptyDirVolumeArgs' empty_dir: A scratch directory that lives as long as the pod
+        :param 'HostPathVolumeArgs' host_path: A path on the node. Requires `allowHostPath`
+        :param 'SecretVolumeArgs' secret: The contents of a Secret
+        """
+        pulumi.set(__self__, "name", name)
+        if config_map is not None:
+            pulumi.set(__self__, "config_map", config_map)
+        if empty_dir is not None:
+            pulumi.set(__self__, "empty_dir", empty_dir)
+        if host_path is not None:
+            pulumi.set(__self__, "host_path", host_path)
+        if secret is not None:
+            pulumi.set(__self__, "secret", secret)
+
+    @property
+    @pulumi.getter
+    def name(self) -> str:
+        """
+        The name of the volume, referenced by `volumeMounts`
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: str):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter(name="configMap")
+    def config_map(self) -> Optional['ConfigMapVolumeArgs']:
+        """
+        The contents of a ConfigMap
+        """
+        return pulumi.get(self, "config_map")
+
+    @config_map.setter
+    def config_map(self, value: Optional['ConfigMapVolumeArgs']):
+        pulumi.set(self, "config_map", value)
+
+    @property
+    @pulumi.getter(name="emptyDir")
+    def empty_dir(self) -> Optional['EmptyDirVolumeArgs']:
+        """
+        A scratch directory that lives as long as the pod
+        """
+        return pulumi.get(self, "empty_dir")
+
+    @empty_dir.setter
+    def empty_dir(self, value: Optional['EmptyDirVolumeArgs']):
+        pulumi.set(self, "empty_dir", value)
+
+    @property
+    @pulumi.getter(name="hostPath")
+    def host_path(self) -> Optional['HostPathVolumeArgs']:
+        """
+        A path on the node. Requires `allowHostPath`
+        """
+        return pulumi.get(self, "host_path")
+
+    @host_path.setter
+    def host_path(self, value: Optional['HostPathVolumeArgs']):
+        pulumi.set(self, "host_path", value)
+
+    @property
+    @pulumi.getter
+    def secret(self) -> Optional['SecretVolumeArgs']:
+        """
+        The contents of a Secret
+        """
+        return pulumi.get(self, "secret")
+
+    @secret.setter
+    def secret(self, value: Optional['SecretVolumeArgs']):
+        pulumi.set(self, "secret", value)
+
+
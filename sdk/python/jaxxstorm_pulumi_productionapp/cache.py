@@ -0,0 +1,188 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._enums import *
+
+__all__ = ['CacheArgs', 'Cache']
+
+@pulumi.input_type
+class CacheArgs:
+    def __init__(__self__, *,
+                 enable_auth: Optional[bool] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 size: Optional['Size'] = None):
+        """
+        The set of arguments for constructing a Cache resource.
+        :param bool enable_auth: Whether to require a generated password to connect to the cache
+        :param pulumi.Input[str] image: The Redis image to run
+        :param 'Size' size: The resource preset for the cache
+        """
+        if enable_auth is None:
+            enable_auth = False
+        if enable_auth is not None:
+            pulumi.set(__self__, "enable_auth", enable_auth)
+        if image is None:
+            image = 'redis:6'
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if size is None:
+            size = 'small'
+        if size is not None:
+            pulumi.set(__self__, "size", size)
+
+    @property
+    @pulumi.getter(name="enableAuth")
+    def enable_auth(self) -> Optional[bool]:
+        """
+        Whether to require a generated password to connect to the cache
+        """
+        return pulumi.get(self, "enable_auth")
+
+    @enable_auth.setter
+    def enable_auth(self, value: Optional[bool]):
+        pulumi.set(self, "enable_auth", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[pulumi.Input[str]]:
+        """
+        The Redis image to run
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter
+    def size(self) -> Optional['Size']:
+        """
+        The resource preset for the cache
+        """
+        return pulumi.get(self, "size")
+
+    @size.setter
+    def size(self, value: Optional['Size']):
+        pulumi.set(self, "size", value)
+
+
+class Cache(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 enable_auth: Optional[bool] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 size: Optional['Size'] = None,
+                 __props__=None):
+        """
+        A Redis cache running in the cluster.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param bool enable_auth: Whether to require a generated password to connect to the cache
+        :param pulumi.Input[str] image: The Redis image to run
+        :param 'Size' size: The resource preset for the cache
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[CacheArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A Redis cache running in the cluster.
+
+        :param str resource_name: The name of the resource.
+        :param CacheArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(CacheArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 enable_auth: Optional[bool] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 size: Optional['Size'] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = CacheArgs.__new__(CacheArgs)
+
+            if enable_auth is None:
+                enable_auth = False
+            __props__.__dict__["enable_auth"] = enable_auth
+            if image is None:
+                image = 'redis:6'
+            __props__.__dict__["image"] = image
+            if size is None:
+                size = 'small'
+            __props__.__dict__["size"] = size
+            __props__.__dict__["connection_string"] = None
+            __props__.__dict__["host"] = None
+            __props__.__dict__["password"] = None
+            __props__.__dict__["port"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connectionString", "password"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Cache, __self__).__init__(
+            'productionapp:index:Cache',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="connectionString")
+    def connection_string(self) -> pulumi.Output[str]:
+        """
+        A redis:// connection string for the cache
+        """
+        return pulumi.get(self, "connection_string")
+
+    @property
+    @pulumi.getter
+    def host(self) -> pulumi.Output[str]:
+        """
+        The in-cluster hostname of the cache
+        """
+        return pulumi.get(self, "host")
+
+    @property
+    @pulumi.getter
+    def password(self) -> pulumi.Output[Optional[str]]:
+        """
+        The generated password for the cache, when authentication is enabled
+        """
+        return pulumi.get(self, "password")
+
+    @property
+    @pulumi.getter
+    def port(self) -> pulumi.Output[int]:
+        """
+        The port the cache listens on
+        """
+        return pulumi.get(self, "port")
+
@@ -0,0 +1,25 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+defaultLabels: Optional[str]
+"""
+Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+"""
+
+defaultRegistry: Optional[str]
+"""
+A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+"""
+
+environment: Optional[str]
+"""
+The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+"""
+
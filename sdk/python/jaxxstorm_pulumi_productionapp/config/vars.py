@@ -0,0 +1,37 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+import types
+
+__config__ = pulumi.Config('productionapp')
+
+
+class _ExportableConfig(types.ModuleType):
+    @property
+    def default_labels(self) -> Optional[str]:
+        """
+        Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+        """
+        return __config__.get('defaultLabels')
+
+    @property
+    def default_registry(self) -> Optional[str]:
+        """
+        A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+        """
+        return __config__.get('defaultRegistry')
+
+    @property
+    def environment(self) -> Optional[str]:
+        """
+        The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+        """
+        return __config__.get('environment')
+
@@ -0,0 +1,247 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['DatabaseArgs', 'Database']
+
+@pulumi.input_type
+class DatabaseArgs:
+    def __init__(__self__, *,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 storage_class_name: Optional[pulumi.Input[str]] = None,
+                 storage_size: Optional[pulumi.Input[str]] = None,
+                 username: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a Database resource.
+        :param pulumi.Input[str] database_name: The name of the database to create
+        :param pulumi.Input[str] image: The Postgres image to run
+        :param pulumi.Input[str] storage_class_name: The storage class for the persistent volume. Uses the cluster default when unset
+        :param pulumi.Input[str] storage_size: The size of the persistent volume backing the database
+        :param pulumi.Input[str] username: The name of the database user to create
+        """
+        if database_name is None:
+            database_name = 'app'
+        if database_name is not None:
+            pulumi.set(__self__, "database_name", database_name)
+        if image is None:
+            image = 'postgres:14'
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if storage_class_name is not None:
+            pulumi.set(__self__, "storage_class_name", storage_class_name)
+        if storage_size is None:
+            storage_size = '1Gi'
+        if storage_size is not None:
+            pulumi.set(__self__, "storage_size", storage_size)
+        if username is None:
+            username = 'app'
+        if username is not None:
+            pulumi.set(__self__, "username", username)
+
+    @property
+    @pulumi.getter(name="databaseName")
+    def database_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the database to create
+        """
+        return pulumi.get(self, "database_name")
+
+    @database_name.setter
+    def database_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "database_name", value)
+
+    @property
+    @pulumi.getter
+    def image(self) -> Optional[pulumi.Input[str]]:
+        """
+        The Postgres image to run
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "image", value)
+
+    @property
+    @pulumi.getter(name="storageClassName")
+    def storage_class_name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The storage class for the persistent volume. Uses the cluster default when unset
+        """
+        return pulumi.get(self, "storage_class_name")
+
+    @storage_class_name.setter
+    def storage_class_name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "storage_class_name", value)
+
+    @property
+    @pulumi.getter(name="storageSize")
+    def storage_size(self) -> Optional[pulumi.Input[str]]:
+        """
+        The size of the persistent volume backing the database
+        """
+        return pulumi.get(self, "storage_size")
+
+    @storage_size.setter
+    def storage_size(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "storage_size", value)
+
+    @property
+    @pulumi.getter
+    def username(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of the database user to create
+        """
+        return pulumi.get(self, "username")
+
+    @username.setter
+    def username(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "username", value)
+
+
+class Database(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 storage_class_name: Optional[pulumi.Input[str]] = None,
+                 storage_size: Optional[pulumi.Input[str]] = None,
+                 username: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A Postgres database running in the cluster, intended for development environments.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] database_name: The name of the database to create
+        :param pulumi.Input[str] image: The Postgres image to run
+        :param pulumi.Input[str] storage_class_name: The storage class for the persistent volume. Uses the cluster default when unset
+        :param pulumi.Input[str] storage_size: The size of the persistent volume backing the database
+        :param pulumi.Input[str] username: The name of the database user to create
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[DatabaseArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A Postgres database running in the cluster, intended for development environments.
+
+        :param str resource_name: The name of the resource.
+        :param DatabaseArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(DatabaseArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 database_name: Optional[pulumi.Input[str]] = None,
+                 image: Optional[pulumi.Input[str]] = None,
+                 storage_class_name: Optional[pulumi.Input[str]] = None,
+                 storage_size: Optional[pulumi.Input[str]] = None,
+                 username: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        if opts is None:
+            opts = pulumi.ResourceOptions()
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.version is None:
+            opts.version = _utilities.get_version()
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = DatabaseArgs.__new__(DatabaseArgs)
+
+            if database_name is None:
+                database_name = 'app'
+            __props__.__dict__["database_name"] = database_name
+            if image is None:
+                image = 'postgres:14'
+            __props__.__dict__["image"] = image
+            __props__.__dict__["storage_class_name"] = storage_class_name
+            if storage_size is None:
+                storage_size = '1Gi'
+            __props__.__dict__["storage_size"] = storage_size
+            if username is None:
+                username = 'app'
+            __props__.__dict__["username"] = username
+            __props__.__dict__["connection_string"] = None
+            __props__.__dict__["host"] = None
+            __props__.__dict__["password"] = None
+            __props__.__dict__["port"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["connectionString", "password"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(Database, __self__).__init__(
+            'productionapp:index:Database',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="connectionString")
+    def connection_string(self) -> pulumi.Output[str]:
+        """
+        A postgresql:// connection string for the database
+        """
+        return pulumi.get(self, "connection_string")
+
+    @property
+    @pulumi.getter(name="databaseName")
+    def database_name(self) -> pulumi.Output[str]:
+        """
+        The name of the database
+        """
+        return pulumi.get(self, "database_name")
+
+    @property
+    @pulumi.getter
+    def host(self) -> pulumi.Output[str]:
+        """
+        The in-cluster hostname of the database
+        """
+        return pulumi.get(self, "host")
+
+    @property
+    @pulumi.getter
+    def password(self) -> pulumi.Output[str]:
+        """
+        The generated password for the database user
+        """
+        return pulumi.get(self, "password")
+
+    @property
+    @pulumi.getter
+    def port(self) -> pulumi.Output[int]:
+        """
+        The port the database listens on
+        """
+        return pulumi.get(self, "port")
+
+    @property
+    @pulumi.getter
+    def username(self) -> pulumi.Output[str]:
+        """
+        The name of the database user
+        """
+        return pulumi.get(self, "username")
+
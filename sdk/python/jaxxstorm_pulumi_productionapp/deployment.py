@@ -7,6 +7,9 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from . import outputs
+from ._enums import *
+from ._inputs import *
 
 __all__ = ['DeploymentArgs', 'Deployment']
 
@@ -14,14 +17,138 @@ __all__ = ['DeploymentArgs', 'Deployment']
 class DeploymentArgs:
     def __init__(__self__, *,
                  image: pulumi.Input[str],
-                 port: pulumi.Input[int]):
+                 port: pulumi.Input[int],
+                 allow_host_path: Optional[bool] = None,
+                 annotations: Optional[Mapping[str, str]] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 autoscaling: Optional['AutoscalingArgs'] = None,
+                 await_: Optional['AwaitArgs'] = None,
+                 cache: Optional[pulumi.Input['CacheConnectionArgs']] = None,
+                 clusters: Optional[Sequence['ClusterArgs']] = None,
+                 command: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 context: Optional[pulumi.Input[str]] = None,
+                 database: Optional[pulumi.Input['DatabaseConnectionArgs']] = None,
+                 environment: Optional['Environment'] = None,
+                 ingress: Optional['IngressArgs'] = None,
+                 kubeconfig: Optional[pulumi.Input[str]] = None,
+                 labels: Optional[Mapping[str, str]] = None,
+                 liveness_probe: Optional['ProbeArgs'] = None,
+                 patches: Optional[Mapping[str, 'PatchArgs']] = None,
+                 pod_annotations: Optional[Mapping[str, str]] = None,
+                 pre_deploy_job: Optional['PreDeployJobArgs'] = None,
+                 quota: Optional['QuotaArgs'] = None,
+                 readiness_probe: Optional['ProbeArgs'] = None,
+                 registry_credentials: Optional[pulumi.Input['RegistryCredentialsArgs']] = None,
+                 resources: Optional['ResourcesArgs'] = None,
+                 sanitize_name: Optional[bool] = None,
+                 service_type: Optional['ServiceType'] = None,
+                 shutdown: Optional['ShutdownArgs'] = None,
+                 size: Optional['Size'] = None,
+                 smoke_test: Optional['SmokeTestArgs'] = None,
+                 volume_mounts: Optional[Sequence['VolumeMountArgs']] = None,
+                 volumes: Optional[Sequence['VolumeArgs']] = None,
+                 working_dir: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a Deployment resource.
         :param pulumi.Input[str] image: The image to deploy in your production application
         :param pulumi.Input[int] port: The port your container listens on
+        :param bool allow_host_path: Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+        :param Mapping[str, str] annotations: Annotations added to every resource the component creates
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] args: Overrides the arguments of the application image
+        :param 'AutoscalingArgs' autoscaling: Scales the application with a HorizontalPodAutoscaler
+        :param 'AwaitArgs' await_: How long to wait for the application to become ready
+        :param pulumi.Input['CacheConnectionArgs'] cache: A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+        :param Sequence['ClusterArgs'] clusters: Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] command: Overrides the entrypoint of the application image
+        :param pulumi.Input[str] context: The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+        :param pulumi.Input['DatabaseConnectionArgs'] database: A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+        :param 'Environment' environment: The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+        :param 'IngressArgs' ingress: An Ingress that routes a host name to the application. The url becomes the Ingress's
+        :param pulumi.Input[str] kubeconfig: The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+        :param Mapping[str, str] labels: Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+        :param 'ProbeArgs' liveness_probe: An HTTP check that restarts the application's container when it fails
+        :param Mapping[str, 'PatchArgs'] patches: Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+        :param Mapping[str, str] pod_annotations: Annotations added to the application's pods
+        :param 'PreDeployJobArgs' pre_deploy_job: A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+        :param 'QuotaArgs' quota: Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+        :param 'ProbeArgs' readiness_probe: An HTTP check that must pass before the application's pods receive traffic
+        :param pulumi.Input['RegistryCredentialsArgs'] registry_credentials: Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+        :param 'ResourcesArgs' resources: Resource requests and limits for the application's container, overriding the ones the environment or size sets
+        :param bool sanitize_name: Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+        :param 'ServiceType' service_type: The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+        :param 'ShutdownArgs' shutdown: How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+        :param 'Size' size: The resource preset for the application's container, replacing the environment's resources
+        :param 'SmokeTestArgs' smoke_test: An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+        :param Sequence['VolumeMountArgs'] volume_mounts: Where to mount `volumes` in the application container
+        :param Sequence['VolumeArgs'] volumes: Volumes available to the application container
+        :param pulumi.Input[str] working_dir: The working directory of the application container
         """
         pulumi.set(__self__, "image", image)
         pulumi.set(__self__, "port", port)
+        if allow_host_path is None:
+            allow_host_path = False
+        if allow_host_path is not None:
+            pulumi.set(__self__, "allow_host_path", allow_host_path)
+        if annotations is not None:
+            pulumi.set(__self__, "annotations", annotations)
+        if args is not None:
+            pulumi.set(__self__, "args", args)
+        if autoscaling is not None:
+            pulumi.set(__self__, "autoscaling", autoscaling)
+        if await_ is not None:
+            pulumi.set(__self__, "await_", await_)
+        if cache is not None:
+            pulumi.set(__self__, "cache", cache)
+        if clusters is not None:
+            pulumi.set(__self__, "clusters", clusters)
+        if command is not None:
+            pulumi.set(__self__, "command", command)
+        if context is not None:
+            pulumi.set(__self__, "context", context)
+        if database is not None:
+            pulumi.set(__self__, "database", database)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+        if ingress is not None:
+            pulumi.set(__self__, "ingress", ingress)
+        if kubeconfig is not None:
+            pulumi.set(__self__, "kubeconfig", kubeconfig)
+        if labels is not None:
+            pulumi.set(__self__, "labels", labels)
+        if liveness_probe is not None:
+            pulumi.set(__self__, "liveness_probe", liveness_probe)
+        if patches is not None:
+            pulumi.set(__self__, "patches", patches)
+        if pod_annotations is not None:
+            pulumi.set(__self__, "pod_annotations", pod_annotations)
+        if pre_deploy_job is not None:
+            pulumi.set(__self__, "pre_deploy_job", pre_deploy_job)
+        if quota is not None:
+            pulumi.set(__self__, "quota", quota)
+        if readiness_probe is not None:
+            pulumi.set(__self__, "readiness_probe", readiness_probe)
+        if registry_credentials is not None:
+            pulumi.set(__self__, "registry_credentials", registry_credentials)
+        if resources is not None:
+            pulumi.set(__self__, "resources", resources)
+        if sanitize_name is not None:
+            pulumi.set(__self__, "sanitize_name", sanitize_name)
+        if service_type is None:
+            service_type = 'LoadBalancer'
+        if service_type is not None:
+            pulumi.set(__self__, "service_type", service_type)
+        if shutdown is not None:
+            pulumi.set(__self__, "shutdown", shutdown)
+        if size is not None:
+            pulumi.set(__self__, "size", size)
+        if smoke_test is not None:
+            pulumi.set(__self__, "smoke_test", smoke_test)
+        if volume_mounts is not None:
+            pulumi.set(__self__, "volume_mounts", volume_mounts)
+        if volumes is not None:
+            pulumi.set(__self__, "volumes", volumes)
+        if working_dir is not None:
+            pulumi.set(__self__, "working_dir", working_dir)
 
     @property
     @pulumi.getter
@@ -47,21 +174,442 @@ class DeploymentArgs:
     def port(self, value: pulumi.Input[int]):
         pulumi.set(self, "port", value)
 
+    @property
+    @pulumi.getter(name="allowHostPath")
+    def allow_host_path(self) -> Optional[bool]:
+        """
+        Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+        """
+        return pulumi.get(self, "allow_host_path")
+
+    @allow_host_path.setter
+    def allow_host_path(self, value: Optional[bool]):
+        pulumi.set(self, "allow_host_path", value)
+
+    @property
+    @pulumi.getter
+    def annotations(self) -> Optional[Mapping[str, str]]:
+        """
+        Annotations added to every resource the component creates
+        """
+        return pulumi.get(self, "annotations")
+
+    @annotations.setter
+    def annotations(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "annotations", value)
+
+    @property
+    @pulumi.getter
+    def args(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Overrides the arguments of the application image
+        """
+        return pulumi.get(self, "args")
+
+    @args.setter
+    def args(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "args", value)
+
+    @property
+    @pulumi.getter
+    def autoscaling(self) -> Optional['AutoscalingArgs']:
+        """
+        Scales the application with a HorizontalPodAutoscaler
+        """
+        return pulumi.get(self, "autoscaling")
+
+    @autoscaling.setter
+    def autoscaling(self, value: Optional['AutoscalingArgs']):
+        pulumi.set(self, "autoscaling", value)
+
+    @property
+    @pulumi.getter(name="await")
+    def await_(self) -> Optional['AwaitArgs']:
+        """
+        How long to wait for the application to become ready
+        """
+        return pulumi.get(self, "await_")
+
+    @await_.setter
+    def await_(self, value: Optional['AwaitArgs']):
+        pulumi.set(self, "await_", value)
+
+    @property
+    @pulumi.getter
+    def cache(self) -> Optional[pulumi.Input['CacheConnectionArgs']]:
+        """
+        A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+        """
+        return pulumi.get(self, "cache")
+
+    @cache.setter
+    def cache(self, value: Optional[pulumi.Input['CacheConnectionArgs']]):
+        pulumi.set(self, "cache", value)
+
+    @property
+    @pulumi.getter
+    def clusters(self) -> Optional[Sequence['ClusterArgs']]:
+        """
+        Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+        """
+        return pulumi.get(self, "clusters")
+
+    @clusters.setter
+    def clusters(self, value: Optional[Sequence['ClusterArgs']]):
+        pulumi.set(self, "clusters", value)
+
+    @property
+    @pulumi.getter
+    def command(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Overrides the entrypoint of the application image
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter
+    def context(self) -> Optional[pulumi.Input[str]]:
+        """
+        The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+        """
+        return pulumi.get(self, "context")
+
+    @context.setter
+    def context(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "context", value)
+
+    @property
+    @pulumi.getter
+    def database(self) -> Optional[pulumi.Input['DatabaseConnectionArgs']]:
+        """
+        A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+        """
+        return pulumi.get(self, "database")
+
+    @database.setter
+    def database(self, value: Optional[pulumi.Input['DatabaseConnectionArgs']]):
+        pulumi.set(self, "database", value)
+
+    @property
+    @pulumi.getter
+    def environment(self) -> Optional['Environment']:
+        """
+        The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: Optional['Environment']):
+        pulumi.set(self, "environment", value)
+
+    @property
+    @pulumi.getter
+    def ingress(self) -> Optional['IngressArgs']:
+        """
+        An Ingress that routes a host name to the application. The url becomes the Ingress's
+        """
+        return pulumi.get(self, "ingress")
+
+    @ingress.setter
+    def ingress(self, value: Optional['IngressArgs']):
+        pulumi.set(self, "ingress", value)
+
+    @property
+    @pulumi.getter
+    def kubeconfig(self) -> Optional[pulumi.Input[str]]:
+        """
+        The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+        """
+        return pulumi.get(self, "kubeconfig")
+
+    @kubeconfig.setter
+    def kubeconfig(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "kubeconfig", value)
+
+    @property
+    @pulumi.getter
+    def labels(self) -> Optional[Mapping[str, str]]:
+        """
+        Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+        """
+        return pulumi.get(self, "labels")
+
+    @labels.setter
+    def labels(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "labels", value)
+
+    @property
+    @pulumi.getter(name="livenessProbe")
+    def liveness_probe(self) -> Optional['ProbeArgs']:
+        """
+        An HTTP check that restarts the application's container when it fails
+        """
+        return pulumi.get(self, "liveness_probe")
+
+    @liveness_probe.setter
+    def liveness_probe(self, value: Optional['ProbeArgs']):
+        pulumi.set(self, "liveness_probe", value)
+
+    @property
+    @pulumi.getter
+    def patches(self) -> Optional[Mapping[str, 'PatchArgs']]:
+        """
+        Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+        """
+        return pulumi.get(self, "patches")
+
+    @patches.setter
+    def patches(self, value: Optional[Mapping[str, 'PatchArgs']]):
+        pulumi.set(self, "patches", value)
+
+    @property
+    @pulumi.getter(name="podAnnotations")
+    def pod_annotations(self) -> Optional[Mapping[str, str]]:
+        """
+        Annotations added to the application's pods
+        """
+        return pulumi.get(self, "pod_annotations")
+
+    @pod_annotations.setter
+    def pod_annotations(self, value: Optional[Mapping[str, str]]):
+        pulumi.set(self, "pod_annotations", value)
+
+    @property
+    @pulumi.getter(name="preDeployJob")
+    def pre_deploy_job(self) -> Optional['PreDeployJobArgs']:
+        """
+        A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+        """
+        return pulumi.get(self, "pre_deploy_job")
+
+    @pre_deploy_job.setter
+    def pre_deploy_job(self, value: Optional['PreDeployJobArgs']):
+        pulumi.set(self, "pre_deploy_job", value)
+
+    @property
+    @pulumi.getter
+    def quota(self) -> Optional['QuotaArgs']:
+        """
+        Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+        """
+        return pulumi.get(self, "quota")
+
+    @quota.setter
+    def quota(self, value: Optional['QuotaArgs']):
+        pulumi.set(self, "quota", value)
+
+    @property
+    @pulumi.getter(name="readinessProbe")
+    def readiness_probe(self) -> Optional['ProbeArgs']:
+        """
+        An HTTP check that must pass before the application's pods receive traffic
+        """
+        return pulumi.get(self, "readiness_probe")
+
+    @readiness_probe.setter
+    def readiness_probe(self, value: Optional['ProbeArgs']):
+        pulumi.set(self, "readiness_probe", value)
+
+    @property
+    @pulumi.getter(name="registryCredentials")
+    def registry_credentials(self) -> Optional[pulumi.Input['RegistryCredentialsArgs']]:
+        """
+        Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+        """
+        return pulumi.get(self, "registry_credentials")
+
+    @registry_credentials.setter
+    def registry_credentials(self, value: Optional[pulumi.Input['RegistryCredentialsArgs']]):
+        pulumi.set(self, "registry_credentials", value)
+
+    @property
+    @pulumi.getter
+    def resources(self) -> Optional['ResourcesArgs']:
+        """
+        Resource requests and limits for the application's container, overriding the ones the environment or size sets
+        """
+        return pulumi.get(self, "resources")
+
+    @resources.setter
+    def resources(self, value: Optional['ResourcesArgs']):
+        pulumi.set(self, "resources", value)
+
+    @property
+    @pulumi.getter(name="sanitizeName")
+    def sanitize_name(self) -> Optional[bool]:
+        """
+        Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+        """
+        return pulumi.get(self, "sanitize_name")
+
+    @sanitize_name.setter
+    def sanitize_name(self, value: Optional[bool]):
+        pulumi.set(self, "sanitize_name", value)
+
+    @property
+    @pulumi.getter(name="serviceType")
+    def service_type(self) -> Optional['ServiceType']:
+        """
+        The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+        """
+        return pulumi.get(self, "service_type")
+
+    @service_type.setter
+    def service_type(self, value: Optional['ServiceType']):
+        pulumi.set(self, "service_type", value)
+
+    @property
+    @pulumi.getter
+    def shutdown(self) -> Optional['ShutdownArgs']:
+        """
+        How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+        """
+        return pulumi.get(self, "shutdown")
+
+    @shutdown.setter
+    def shutdown(self, value: Optional['ShutdownArgs']):
+        pulumi.set(self, "shutdown", value)
+
+    @property
+    @pulumi.getter
+    def size(self) -> Optional['Size']:
+        """
+        The resource preset for the application's container, replacing the environment's resources
+        """
+        return pulumi.get(self, "size")
+
+    @size.setter
+    def size(self, value: Optional['Size']):
+        pulumi.set(self, "size", value)
+
+    @property
+    @pulumi.getter(name="smokeTest")
+    def smoke_test(self) -> Optional['SmokeTestArgs']:
+        """
+        An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+        """
+        return pulumi.get(self, "smoke_test")
+
+    @smoke_test.setter
+    def smoke_test(self, value: Optional['SmokeTestArgs']):
+        pulumi.set(self, "smoke_test", value)
+
+    @property
+    @pulumi.getter(name="volumeMounts")
+    def volume_mounts(self) -> Optional[Sequence['VolumeMountArgs']]:
+        """
+        Where to mount `volumes` in the application container
+        """
+        return pulumi.get(self, "volume_mounts")
+
+    @volume_mounts.setter
+    def volume_mounts(self, value: Optional[Sequence['VolumeMountArgs']]):
+        pulumi.set(self, "volume_mounts", value)
+
+    @property
+    @pulumi.getter
+    def volumes(self) -> Optional[Sequence['VolumeArgs']]:
+        """
+        Volumes available to the application container
+        """
+        return pulumi.get(self, "volumes")
+
+    @volumes.setter
+    def volumes(self, value: Optional[Sequence['VolumeArgs']]):
+        pulumi.set(self, "volumes", value)
+
+    @property
+    @pulumi.getter(name="workingDir")
+    def working_dir(self) -> Optional[pulumi.Input[str]]:
+        """
+        The working directory of the application container
+        """
+        return pulumi.get(self, "working_dir")
+
+    @working_dir.setter
+    def working_dir(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "working_dir", value)
+
 
 class Deployment(pulumi.ComponentResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_host_path: Optional[bool] = None,
+                 annotations: Optional[Mapping[str, str]] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 autoscaling: Optional[pulumi.InputType['AutoscalingArgs']] = None,
+                 await_: Optional[pulumi.InputType['AwaitArgs']] = None,
+                 cache: Optional[pulumi.Input[pulumi.InputType['CacheConnectionArgs']]] = None,
+                 clusters: Optional[Sequence[pulumi.InputType['ClusterArgs']]] = None,
+                 command: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 context: Optional[pulumi.Input[str]] = None,
+                 database: Optional[pulumi.Input[pulumi.InputType['DatabaseConnectionArgs']]] = None,
+                 environment: Optional['Environment'] = None,
                  image: Optional[pulumi.Input[str]] = None,
+                 ingress: Optional[pulumi.InputType['IngressArgs']] = None,
+                 kubeconfig: Optional[pulumi.Input[str]] = None,
+                 labels: Optional[Mapping[str, str]] = None,
+                 liveness_probe: Optional[pulumi.InputType['ProbeArgs']] = None,
+                 patches: Optional[Mapping[str, pulumi.InputType['PatchArgs']]] = None,
+                 pod_annotations: Optional[Mapping[str, str]] = None,
                  port: Optional[pulumi.Input[int]] = None,
+                 pre_deploy_job: Optional[pulumi.InputType['PreDeployJobArgs']] = None,
+                 quota: Optional[pulumi.InputType['QuotaArgs']] = None,
+                 readiness_probe: Optional[pulumi.InputType['ProbeArgs']] = None,
+                 registry_credentials: Optional[pulumi.Input[pulumi.InputType['RegistryCredentialsArgs']]] = None,
+                 resources: Optional[pulumi.InputType['ResourcesArgs']] = None,
+                 sanitize_name: Optional[bool] = None,
+                 service_type: Optional['ServiceType'] = None,
+                 shutdown: Optional[pulumi.InputType['ShutdownArgs']] = None,
+                 size: Optional['Size'] = None,
+                 smoke_test: Optional[pulumi.InputType['SmokeTestArgs']] = None,
+                 volume_mounts: Optional[Sequence[pulumi.InputType['VolumeMountArgs']]] = None,
+                 volumes: Optional[Sequence[pulumi.InputType['VolumeArgs']]] = None,
+                 working_dir: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
-        Create a Deployment resource with the given unique name, props, and options.
+        An application deployed to Kubernetes in a namespace of its own, behind a Service, with the availability settings its environment calls for.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param bool allow_host_path: Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+        :param Mapping[str, str] annotations: Annotations added to every resource the component creates
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] args: Overrides the arguments of the application image
+        :param pulumi.InputType['AutoscalingArgs'] autoscaling: Scales the application with a HorizontalPodAutoscaler
+        :param pulumi.InputType['AwaitArgs'] await_: How long to wait for the application to become ready
+        :param pulumi.Input[pulumi.InputType['CacheConnectionArgs']] cache: A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+        :param Sequence[pulumi.InputType['ClusterArgs']] clusters: Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] command: Overrides the entrypoint of the application image
+        :param pulumi.Input[str] context: The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+        :param pulumi.Input[pulumi.InputType['DatabaseConnectionArgs']] database: A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+        :param 'Environment' environment: The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
         :param pulumi.Input[str] image: The image to deploy in your production application
+        :param pulumi.InputType['IngressArgs'] ingress: An Ingress that routes a host name to the application. The url becomes the Ingress's
+        :param pulumi.Input[str] kubeconfig: The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+        :param Mapping[str, str] labels: Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+        :param pulumi.InputType['ProbeArgs'] liveness_probe: An HTTP check that restarts the application's container when it fails
+        :param Mapping[str, pulumi.InputType['PatchArgs']] patches: Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+        :param Mapping[str, str] pod_annotations: Annotations added to the application's pods
         :param pulumi.Input[int] port: The port your container listens on
+        :param pulumi.InputType['PreDeployJobArgs'] pre_deploy_job: A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+        :param pulumi.InputType['QuotaArgs'] quota: Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+        :param pulumi.InputType['ProbeArgs'] readiness_probe: An HTTP check that must pass before the application's pods receive traffic
+        :param pulumi.Input[pulumi.InputType['RegistryCredentialsArgs']] registry_credentials: Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+        :param pulumi.InputType['ResourcesArgs'] resources: Resource requests and limits for the application's container, overriding the ones the environment or size sets
+        :param bool sanitize_name: Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+        :param 'ServiceType' service_type: The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+        :param pulumi.InputType['ShutdownArgs'] shutdown: How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+        :param 'Size' size: The resource preset for the application's container, replacing the environment's resources
+        :param pulumi.InputType['SmokeTestArgs'] smoke_test: An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+        :param Sequence[pulumi.InputType['VolumeMountArgs']] volume_mounts: Where to mount `volumes` in the application container
+        :param Sequence[pulumi.InputType['VolumeArgs']] volumes: Volumes available to the application container
+        :param pulumi.Input[str] working_dir: The working directory of the application container
         """
         ...
     @overload
@@ -70,7 +618,8 @@ class Deployment(pulumi.ComponentResource):
                  args: DeploymentArgs,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        Create a Deployment resource with the given unique name, props, and options.
+        An application deployed to Kubernetes in a namespace of its own, behind a Service, with the availability settings its environment calls for.
+
         :param str resource_name: The name of the resource.
         :param DeploymentArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -86,8 +635,38 @@ class Deployment(pulumi.ComponentResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allow_host_path: Optional[bool] = None,
+                 annotations: Optional[Mapping[str, str]] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 autoscaling: Optional[pulumi.InputType['AutoscalingArgs']] = None,
+                 await_: Optional[pulumi.InputType['AwaitArgs']] = None,
+                 cache: Optional[pulumi.Input[pulumi.InputType['CacheConnectionArgs']]] = None,
+                 clusters: Optional[Sequence[pulumi.InputType['ClusterArgs']]] = None,
+                 command: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 context: Optional[pulumi.Input[str]] = None,
+                 database: Optional[pulumi.Input[pulumi.InputType['DatabaseConnectionArgs']]] = None,
+                 environment: Optional['Environment'] = None,
                  image: Optional[pulumi.Input[str]] = None,
+                 ingress: Optional[pulumi.InputType['IngressArgs']] = None,
+                 kubeconfig: Optional[pulumi.Input[str]] = None,
+                 labels: Optional[Mapping[str, str]] = None,
+                 liveness_probe: Optional[pulumi.InputType['ProbeArgs']] = None,
+                 patches: Optional[Mapping[str, pulumi.InputType['PatchArgs']]] = None,
+                 pod_annotations: Optional[Mapping[str, str]] = None,
                  port: Optional[pulumi.Input[int]] = None,
+                 pre_deploy_job: Optional[pulumi.InputType['PreDeployJobArgs']] = None,
+                 quota: Optional[pulumi.InputType['QuotaArgs']] = None,
+                 readiness_probe: Optional[pulumi.InputType['ProbeArgs']] = None,
+                 registry_credentials: Optional[pulumi.Input[pulumi.InputType['RegistryCredentialsArgs']]] = None,
+                 resources: Optional[pulumi.InputType['ResourcesArgs']] = None,
+                 sanitize_name: Optional[bool] = None,
+                 service_type: Optional['ServiceType'] = None,
+                 shutdown: Optional[pulumi.InputType['ShutdownArgs']] = None,
+                 size: Optional['Size'] = None,
+                 smoke_test: Optional[pulumi.InputType['SmokeTestArgs']] = None,
+                 volume_mounts: Optional[Sequence[pulumi.InputType['VolumeMountArgs']]] = None,
+                 volumes: Optional[Sequence[pulumi.InputType['VolumeArgs']]] = None,
+                 working_dir: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         if opts is None:
             opts = pulumi.ResourceOptions()
@@ -102,13 +681,49 @@ class Deployment(pulumi.ComponentResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = DeploymentArgs.__new__(DeploymentArgs)
 
+            if allow_host_path is None:
+                allow_host_path = False
+            __props__.__dict__["allow_host_path"] = allow_host_path
+            __props__.__dict__["annotations"] = annotations
+            __props__.__dict__["args"] = args
+            __props__.__dict__["autoscaling"] = autoscaling
+            __props__.__dict__["await_"] = await_
+            __props__.__dict__["cache"] = cache
+            __props__.__dict__["clusters"] = clusters
+            __props__.__dict__["command"] = command
+            __props__.__dict__["context"] = context
+            __props__.__dict__["database"] = database
+            __props__.__dict__["environment"] = environment
             if image is None and not opts.urn:
                 raise TypeError("Missing required property 'image'")
             __props__.__dict__["image"] = image
+            __props__.__dict__["ingress"] = ingress
+            __props__.__dict__["kubeconfig"] = None if kubeconfig is None else pulumi.Output.secret(kubeconfig)
+            __props__.__dict__["labels"] = labels
+            __props__.__dict__["liveness_probe"] = liveness_probe
+            __props__.__dict__["patches"] = patches
+            __props__.__dict__["pod_annotations"] = pod_annotations
             if port is None and not opts.urn:
                 raise TypeError("Missing required property 'port'")
             __props__.__dict__["port"] = port
+            __props__.__dict__["pre_deploy_job"] = pre_deploy_job
+            __props__.__dict__["quota"] = quota
+            __props__.__dict__["readiness_probe"] = readiness_probe
+            __props__.__dict__["registry_credentials"] = registry_credentials
+            __props__.__dict__["resources"] = resources
+            __props__.__dict__["sanitize_name"] = sanitize_name
+            if service_type is None:
+                service_type = 'LoadBalancer'
+            __props__.__dict__["service_type"] = service_type
+            __props__.__dict__["shutdown"] = shutdown
+            __props__.__dict__["size"] = size
+            __props__.__dict__["smoke_test"] = smoke_test
+            __props__.__dict__["volume_mounts"] = volume_mounts
+            __props__.__dict__["volumes"] = volumes
+            __props__.__dict__["working_dir"] = working_dir
             __props__.__dict__["url"] = None
+            __props__.__dict__["urls"] = None
+            __props__.__dict__["workloads"] = None
         super(Deployment, __self__).__init__(
             'productionapp:index:Deployment',
             resource_name,
@@ -120,7 +735,136 @@ class Deployment(pulumi.ComponentResource):
     @pulumi.getter
     def url(self) -> pulumi.Output[str]:
         """
-        The URL from the generated service
+        The URL from the generated service, in the first cluster when deployed to several
         """
         return pulumi.get(self, "url")
 
+    @property
+    @pulumi.getter
+    def urls(self) -> pulumi.Output[Mapping[str, str]]:
+        """
+        The URL of the application in each cluster, keyed by cluster name
+        """
+        return pulumi.get(self, "urls")
+
+    @property
+    @pulumi.getter
+    def workloads(self) -> pulumi.Output[Sequence['outputs.Workload']]:
+        """
+        Where the application runs, one workload per cluster
+        """
+        return pulumi.get(self, "workloads")
+
+    @pulumi.output_type
+    class GetKubectlCommandsResult:
+        def __init__(__self__, commands=None):
+            if commands and not isinstance(commands, list):
+                raise TypeError("Expected argument 'commands' to be a list")
+            pulumi.set(__self__, "commands", commands)
+
+        @property
+        @pulumi.getter
+        def commands(self) -> Sequence['outputs.KubectlCommands']:
+            """
+            The commands for each of the application's workloads
+            """
+            return pulumi.get(self, "commands")
+
+    def get_kubectl_commands(__self__) -> pulumi.Output['Deployment.GetKubectlCommandsResult']:
+        """
+        Returns kubectl commands for inspecting and operating the application. Commands for a workload deployed with the contents of a kubeconfig read them from the file named by `kubeconfig`, which the workload's `kubeconfig` must be saved to.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        return pulumi.runtime.call('productionapp:index:Deployment/getKubectlCommands', __args__, res=__self__, typ=Deployment.GetKubectlCommandsResult)
+
+    @pulumi.output_type
+    class GetStatusResult:
+        def __init__(__self__, available_replicas=None, clusters=None, ready_replicas=None, replicas=None, updated_replicas=None):
+            if available_replicas and not isinstance(available_replicas, int):
+                raise TypeError("Expected argument 'available_replicas' to be a int")
+            pulumi.set(__self__, "available_replicas", available_replicas)
+            if clusters and not isinstance(clusters, dict):
+                raise TypeError("Expected argument 'clusters' to be a dict")
+            pulumi.set(__self__, "clusters", clusters)
+            if ready_replicas and not isinstance(ready_replicas, int):
+                raise TypeError("Expected argument 'ready_replicas' to be a int")
+            pulumi.set(__self__, "ready_replicas", ready_replicas)
+            if replicas and not isinstance(replicas, int):
+                raise TypeError("Expected argument 'replicas' to be a int")
+            pulumi.set(__self__, "replicas", replicas)
+            if updated_replicas and not isinstance(updated_replicas, int):
+                raise TypeError("Expected argument 'updated_replicas' to be a int")
+            pulumi.set(__self__, "updated_replicas", updated_replicas)
+
+        @property
+        @pulumi.getter(name="availableReplicas")
+        def available_replicas(self) -> int:
+            """
+            The number of available pods, across all clusters
+            """
+            return pulumi.get(self, "available_replicas")
+
+        @property
+        @pulumi.getter
+        def clusters(self) -> Mapping[str, 'outputs.ReplicaStatus']:
+            """
+            The status in each cluster, keyed by cluster name, when the application is deployed to several
+            """
+            return pulumi.get(self, "clusters")
+
+        @property
+        @pulumi.getter(name="readyReplicas")
+        def ready_replicas(self) -> int:
+            """
+            The number of ready pods, across all clusters
+            """
+            return pulumi.get(self, "ready_replicas")
+
+        @property
+        @pulumi.getter
+        def replicas(self) -> int:
+            """
+            The number of pods, across all clusters
+            """
+            return pulumi.get(self, "replicas")
+
+        @property
+        @pulumi.getter(name="updatedReplicas")
+        def updated_replicas(self) -> int:
+            """
+            The number of pods running the latest pod template, across all clusters
+            """
+            return pulumi.get(self, "updated_replicas")
+
+    def get_status(__self__) -> pulumi.Output['Deployment.GetStatusResult']:
+        """
+        Reads the live status of the application's Deployments with kubectl, using the kubeconfig and context each Deployment was deployed with.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        return pulumi.runtime.call('productionapp:index:Deployment/getStatus', __args__, res=__self__, typ=Deployment.GetStatusResult)
+
+    @pulumi.output_type
+    class RestartResult:
+        def __init__(__self__, restarted_at=None):
+            if restarted_at and not isinstance(restarted_at, str):
+                raise TypeError("Expected argument 'restarted_at' to be a str")
+            pulumi.set(__self__, "restarted_at", restarted_at)
+
+        @property
+        @pulumi.getter(name="restartedAt")
+        def restarted_at(self) -> str:
+            """
+            When the application was restarted, in RFC 3339 format. Empty during previews
+            """
+            return pulumi.get(self, "restarted_at")
+
+    def restart(__self__) -> pulumi.Output['Deployment.RestartResult']:
+        """
+        Restarts the application's pods with a rolling update, by bumping the `kubectl.kubernetes.io/restartedAt` annotation on the pod template of each of its Deployments. Runs kubectl with the kubeconfig and context each Deployment was deployed with. Previews don't restart anything.
+        """
+        __args__ = dict()
+        __args__['__self__'] = __self__
+        return pulumi.runtime.call('productionapp:index:Deployment/restart', __args__, res=__self__, typ=Deployment.RestartResult)
+
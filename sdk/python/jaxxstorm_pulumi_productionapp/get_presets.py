@@ -0,0 +1,68 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'GetPresetsResult',
+    'AwaitableGetPresetsResult',
+    'get_presets',
+]
+
+@pulumi.output_type
+class GetPresetsResult:
+    def __init__(__self__, environments=None, sizes=None):
+        if environments and not isinstance(environments, dict):
+            raise TypeError("Expected argument 'environments' to be a dict")
+        pulumi.set(__self__, "environments", environments)
+        if sizes and not isinstance(sizes, dict):
+            raise TypeError("Expected argument 'sizes' to be a dict")
+        pulumi.set(__self__, "sizes", sizes)
+
+    @property
+    @pulumi.getter
+    def environments(self) -> Mapping[str, 'outputs.EnvironmentPreset']:
+        """
+        The environment presets, keyed by the name `environment` selects them with
+        """
+        return pulumi.get(self, "environments")
+
+    @property
+    @pulumi.getter
+    def sizes(self) -> Mapping[str, 'outputs.ResourcePreset']:
+        """
+        The sizes, keyed by the name `size` selects them with
+        """
+        return pulumi.get(self, "sizes")
+
+
+class AwaitableGetPresetsResult(GetPresetsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetPresetsResult(
+            environments=self.environments,
+            sizes=self.sizes)
+
+
+def get_presets(opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetPresetsResult:
+    """
+    Returns the environment presets a Deployment can be deployed with and the sizes a Cache or a Deployment's container can have.
+    """
+    __args__ = dict()
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = _utilities.get_version()
+    __ret__ = pulumi.runtime.invoke('productionapp:index:getPresets', __args__, opts=opts, typ=GetPresetsResult).value
+
+    return AwaitableGetPresetsResult(
+        environments=__ret__.environments,
+        sizes=__ret__.sizes)
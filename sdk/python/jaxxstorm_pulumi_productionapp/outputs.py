@@ -0,0 +1,380 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._enums import *
+
+__all__ = [
+    'EnvironmentPreset',
+    'KubectlCommands',
+    'ReplicaStatus',
+    'ResourcePreset',
+    'Workload',
+]
+
+@pulumi.output_type
+class EnvironmentPreset(dict):
+    """
+    The defaults a Deployment gets in one environment tier.
+    """
+    def __init__(__self__, *,
+                 anti_affinity: bool,
+                 image_pull_policy: str,
+                 protect_namespace: bool,
+                 replicas: int,
+                 resources: 'outputs.ResourcePreset',
+                 max_replicas: Optional[int] = None,
+                 min_available: Optional[str] = None,
+                 target_cpu_utilization_percentage: Optional[int] = None):
+        """
+        The defaults a Deployment gets in one environment tier.
+        :param bool anti_affinity: Whether the pods are spread across nodes
+        :param str image_pull_policy: The application container's image pull policy
+        :param bool protect_namespace: Whether the namespace is protected from deletion
+        :param int replicas: The number of pods
+        :param 'ResourcePreset' resources: The application container's resource requests and limits
+        :param int max_replicas: The number of pods the HorizontalPodAutoscaler scales up to. Unset if there is no HorizontalPodAutoscaler
+        :param str min_available: The minimum number or percentage of pods the PodDisruptionBudget keeps available. Unset if there is no PodDisruptionBudget
+        :param int target_cpu_utilization_percentage: The CPU utilization the HorizontalPodAutoscaler scales at. Unset if there is no HorizontalPodAutoscaler
+        """
+        pulumi.set(__self__, "anti_affinity", anti_affinity)
+        pulumi.set(__self__, "image_pull_policy", image_pull_policy)
+        pulumi.set(__self__, "protect_namespace", protect_namespace)
+        pulumi.set(__self__, "replicas", replicas)
+        pulumi.set(__self__, "resources", resources)
+        if max_replicas is not None:
+            pulumi.set(__self__, "max_replicas", max_replicas)
+        if min_available is not None:
+            pulumi.set(__self__, "min_available", min_available)
+        if target_cpu_utilization_percentage is not None:
+            pulumi.set(__self__, "target_cpu_utilization_percentage", target_cpu_utilization_percentage)
+
+    @property
+    @pulumi.getter(name="antiAffinity")
+    def anti_affinity(self) -> bool:
+        """
+        Whether the pods are spread across nodes
+        """
+        return pulumi.get(self, "anti_affinity")
+
+    @property
+    @pulumi.getter(name="imagePullPolicy")
+    def image_pull_policy(self) -> str:
+        """
+        The application container's image pull policy
+        """
+        return pulumi.get(self, "image_pull_policy")
+
+    @property
+    @pulumi.getter(name="protectNamespace")
+    def protect_namespace(self) -> bool:
+        """
+        Whether the namespace is protected from deletion
+        """
+        return pulumi.get(self, "protect_namespace")
+
+    @property
+    @pulumi.getter
+    def replicas(self) -> int:
+        """
+        The number of pods
+        """
+        return pulumi.get(self, "replicas")
+
+    @property
+    @pulumi.getter
+    def resources(self) -> 'outputs.ResourcePreset':
+        """
+        The application container's resource requests and limits
+        """
+        return pulumi.get(self, "resources")
+
+    @property
+    @pulumi.getter(name="maxReplicas")
+    def max_replicas(self) -> Optional[int]:
+        """
+        The number of pods the HorizontalPodAutoscaler scales up to. Unset if there is no HorizontalPodAutoscaler
+        """
+        return pulumi.get(self, "max_replicas")
+
+    @property
+    @pulumi.getter(name="minAvailable")
+    def min_available(self) -> Optional[str]:
+        """
+        The minimum number or percentage of pods the PodDisruptionBudget keeps available. Unset if there is no PodDisruptionBudget
+        """
+        return pulumi.get(self, "min_available")
+
+    @property
+    @pulumi.getter(name="targetCpuUtilizationPercentage")
+    def target_cpu_utilization_percentage(self) -> Optional[int]:
+        """
+        The CPU utilization the HorizontalPodAutoscaler scales at. Unset if there is no HorizontalPodAutoscaler
+        """
+        return pulumi.get(self, "target_cpu_utilization_percentage")
+
+
+@pulumi.output_type
+class KubectlCommands(dict):
+    """
+    kubectl commands for one of the application's workloads.
+    """
+    def __init__(__self__, *,
+                 describe: str,
+                 get_pods: str,
+                 logs: str,
+                 restart: str,
+                 rollout_status: str,
+                 cluster: Optional[str] = None,
+                 kubeconfig: Optional[str] = None):
+        """
+        kubectl commands for one of the application's workloads.
+        :param str describe: Describes the application's Deployment
+        :param str get_pods: Lists the application's pods
+        :param str logs: Follows the application's logs
+        :param str restart: Restarts the application's pods
+        :param str rollout_status: Waits for the application's rollout to finish
+        :param str cluster: The name of the cluster, when the application is deployed to several
+        :param str kubeconfig: The kubeconfig file the commands use, when the application was deployed with a kubeconfig
+        """
+        pulumi.set(__self__, "describe", describe)
+        pulumi.set(__self__, "get_pods", get_pods)
+        pulumi.set(__self__, "logs", logs)
+        pulumi.set(__self__, "restart", restart)
+        pulumi.set(__self__, "rollout_status", rollout_status)
+        if cluster is not None:
+            pulumi.set(__self__, "cluster", cluster)
+        if kubeconfig is not None:
+            pulumi.set(__self__, "kubeconfig", kubeconfig)
+
+    @property
+    @pulumi.getter
+    def describe(self) -> str:
+        """
+        Describes the application's Deployment
+        """
+        return pulumi.get(self, "describe")
+
+    @property
+    @pulumi.getter(name="getPods")
+    def get_pods(self) -> str:
+        """
+        Lists the application's pods
+        """
+        return pulumi.get(self, "get_pods")
+
+    @property
+    @pulumi.getter
+    def logs(self) -> str:
+        """
+        Follows the application's logs
+        """
+        return pulumi.get(self, "logs")
+
+    @property
+    @pulumi.getter
+    def restart(self) -> str:
+        """
+        Restarts the application's pods
+        """
+        return pulumi.get(self, "restart")
+
+    @property
+    @pulumi.getter(name="rolloutStatus")
+    def rollout_status(self) -> str:
+        """
+        Waits for the application's rollout to finish
+        """
+        return pulumi.get(self, "rollout_status")
+
+    @property
+    @pulumi.getter
+    def cluster(self) -> Optional[str]:
+        """
+        The name of the cluster, when the application is deployed to several
+        """
+        return pulumi.get(self, "cluster")
+
+    @property
+    @pulumi.getter
+    def kubeconfig(self) -> Optional[str]:
+        """
+        The kubeconfig file the commands use, when the application was deployed with a kubeconfig
+        """
+        return pulumi.get(self, "kubeconfig")
+
+
+@pulumi.output_type
+class ReplicaStatus(dict):
+    """
+    The number of a Deployment's pods in each state.
+    """
+    def __init__(__self__, *,
+                 available_replicas: int,
+                 ready_replicas: int,
+                 replicas: int,
+                 updated_replicas: int):
+        """
+        The number of a Deployment's pods in each state.
+        :param int available_replicas: The number of available pods
+        :param int ready_replicas: The number of ready pods
+        :param int replicas: The number of pods
+        :param int updated_replicas: The number of pods running the latest pod template
+        """
+        pulumi.set(__self__, "available_replicas", available_replicas)
+        pulumi.set(__self__, "ready_replicas", ready_replicas)
+        pulumi.set(__self__, "replicas", replicas)
+        pulumi.set(__self__, "updated_replicas", updated_replicas)
+
+    @property
+    @pulumi.getter(name="availableReplicas")
+    def available_replicas(self) -> int:
+        """
+        The number of available pods
+        """
+        return pulumi.get(self, "available_replicas")
+
+    @property
+    @pulumi.getter(name="readyReplicas")
+    def ready_replicas(self) -> int:
+        """
+        The number of ready pods
+        """
+        return pulumi.get(self, "ready_replicas")
+
+    @property
+    @pulumi.getter
+    def replicas(self) -> int:
+        """
+        The number of pods
+        """
+        return pulumi.get(self, "replicas")
+
+    @property
+    @pulumi.getter(name="updatedReplicas")
+    def updated_replicas(self) -> int:
+        """
+        The number of pods running the latest pod template
+        """
+        return pulumi.get(self, "updated_replicas")
+
+
+@pulumi.output_type
+class ResourcePreset(dict):
+    """
+    Container resource requests and limits.
+    """
+    def __init__(__self__, *,
+                 limits: Mapping[str, str],
+                 requests: Mapping[str, str]):
+        """
+        Container resource requests and limits.
+        :param Mapping[str, str] limits: The resource limits, keyed by resource name
+        :param Mapping[str, str] requests: The resources requested, keyed by resource name
+        """
+        pulumi.set(__self__, "limits", limits)
+        pulumi.set(__self__, "requests", requests)
+
+    @property
+    @pulumi.getter
+    def limits(self) -> Mapping[str, str]:
+        """
+        The resource limits, keyed by resource name
+        """
+        return pulumi.get(self, "limits")
+
+    @property
+    @pulumi.getter
+    def requests(self) -> Mapping[str, str]:
+        """
+        The resources requested, keyed by resource name
+        """
+        return pulumi.get(self, "requests")
+
+
+@pulumi.output_type
+class Workload(dict):
+    """
+    Where the application runs in one cluster.
+    """
+    def __init__(__self__, *,
+                 deployment: str,
+                 namespace: str,
+                 url: str,
+                 cluster: Optional[str] = None,
+                 context: Optional[str] = None,
+                 kubeconfig: Optional[str] = None):
+        """
+        Where the application runs in one cluster.
+        :param str deployment: The name of the application's Deployment
+        :param str namespace: The namespace the application runs in
+        :param str url: The URL of the application's Service
+        :param str cluster: The name of the cluster, when the application is deployed to several
+        :param str context: The kubeconfig context the application was deployed with
+        :param str kubeconfig: The contents of the kubeconfig file, or the path to it, the application was deployed with
+        """
+        pulumi.set(__self__, "deployment", deployment)
+        pulumi.set(__self__, "namespace", namespace)
+        pulumi.set(__self__, "url", url)
+        if cluster is not None:
+            pulumi.set(__self__, "cluster", cluster)
+        if context is not None:
+            pulumi.set(__self__, "context", context)
+        if kubeconfig is not None:
+            pulumi.set(__self__, "kubeconfig", kubeconfig)
+
+    @property
+    @pulumi.getter
+    def deployment(self) -> str:
+        """
+        The name of the application's Deployment
+        """
+        return pulumi.get(self, "deployment")
+
+    @property
+    @pulumi.getter
+    def namespace(self) -> str:
+        """
+        The namespace the application runs in
+        """
+        return pulumi.get(self, "namespace")
+
+    @property
+    @pulumi.getter
+    def url(self) -> str:
+        """
+        The URL of the application's Service
+        """
+        return pulumi.get(self, "url")
+
+    @property
+    @pulumi.getter
+    def cluster(self) -> Optional[str]:
+        """
+        The name of the cluster, when the application is deployed to several
+        """
+        return pulumi.get(self, "cluster")
+
+    @property
+    @pulumi.getter
+    def context(self) -> Optional[str]:
+        """
+        The kubeconfig context the application was deployed with
+        """
+        return pulumi.get(self, "context")
+
+    @property
+    @pulumi.getter
+    def kubeconfig(self) -> Optional[str]:
+        """
+        The contents of the kubeconfig file, or the path to it, the application was deployed with
+        """
+        return pulumi.get(self, "kubeconfig")
+
+
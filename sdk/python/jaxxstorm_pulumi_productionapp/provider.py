@@ -12,11 +12,58 @@ __all__ = ['ProviderArgs', 'Provider']
 
 @pulumi.input_type
 class ProviderArgs:
-    def __init__(__self__):
+    def __init__(__self__, *,
+                 default_labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 default_registry: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a Provider resource.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] default_labels: Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+        :param pulumi.Input[str] default_registry: A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+        :param pulumi.Input[str] environment: The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
         """
-        pass
+        if default_labels is not None:
+            pulumi.set(__self__, "default_labels", default_labels)
+        if default_registry is not None:
+            pulumi.set(__self__, "default_registry", default_registry)
+        if environment is not None:
+            pulumi.set(__self__, "environment", environment)
+
+    @property
+    @pulumi.getter(name="defaultLabels")
+    def default_labels(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]:
+        """
+        Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+        """
+        return pulumi.get(self, "default_labels")
+
+    @default_labels.setter
+    def default_labels(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]]):
+        pulumi.set(self, "default_labels", value)
+
+    @property
+    @pulumi.getter(name="defaultRegistry")
+    def default_registry(self) -> Optional[pulumi.Input[str]]:
+        """
+        A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+        """
+        return pulumi.get(self, "default_registry")
+
+    @default_registry.setter
+    def default_registry(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "default_registry", value)
+
+    @property
+    @pulumi.getter
+    def environment(self) -> Optional[pulumi.Input[str]]:
+        """
+        The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
+        """
+        return pulumi.get(self, "environment")
+
+    @environment.setter
+    def environment(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "environment", value)
 
 
 class Provider(pulumi.ProviderResource):
@@ -24,11 +71,18 @@ class Provider(pulumi.ProviderResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 default_labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 default_registry: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
-        Create a Productionapp resource with the given unique name, props, and options.
+        The provider type for the productionapp package. Its configuration sets organisation-wide defaults for the components it creates.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Mapping[str, pulumi.Input[str]]] default_labels: Labels added to every Deployment's resources. Labels set on a Deployment take precedence
+        :param pulumi.Input[str] default_registry: A registry to pull images that don't name one from, such as `registry.example.com/mirror`
+        :param pulumi.Input[str] environment: The environment tier Deployments default to: `dev`, `staging` or `prod`. It selects their replicas, resources and availability settings, and is recorded as the `app.production.instance/environment` label
         """
         ...
     @overload
@@ -37,7 +91,8 @@ class Provider(pulumi.ProviderResource):
                  args: Optional[ProviderArgs] = None,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        Create a Productionapp resource with the given unique name, props, and options.
+        The provider type for the productionapp package. Its configuration sets organisation-wide defaults for the components it creates.
+
         :param str resource_name: The name of the resource.
         :param ProviderArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -53,6 +108,9 @@ class Provider(pulumi.ProviderResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 default_labels: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 default_registry: Optional[pulumi.Input[str]] = None,
+                 environment: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         if opts is None:
             opts = pulumi.ResourceOptions()
@@ -65,6 +123,9 @@ class Provider(pulumi.ProviderResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = ProviderArgs.__new__(ProviderArgs)
 
+            __props__.__dict__["default_labels"] = pulumi.Output.from_input(default_labels).apply(pulumi.runtime.to_json) if default_labels is not None else None
+            __props__.__dict__["default_registry"] = default_registry
+            __props__.__dict__["environment"] = environment
         super(Provider, __self__).__init__(
             'productionapp',
             resource_name,
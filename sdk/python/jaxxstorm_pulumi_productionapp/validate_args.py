@@ -0,0 +1,234 @@
+# coding=utf-8
+# *** WARNING: this file was generated by Pulumi SDK Generator. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._enums import *
+from ._inputs import *
+
+__all__ = [
+    'ValidateArgsResult',
+    'AwaitableValidateArgsResult',
+    'validate_args',
+    'validate_args_output',
+]
+
+@pulumi.output_type
+class ValidateArgsResult:
+    def __init__(__self__, problems=None):
+        if problems and not isinstance(problems, list):
+            raise TypeError("Expected argument 'problems' to be a list")
+        pulumi.set(__self__, "problems", problems)
+
+    @property
+    @pulumi.getter
+    def problems(self) -> Sequence[str]:
+        """
+        Every problem with the inputs, naming the offending input. Empty if they are valid
+        """
+        return pulumi.get(self, "problems")
+
+
+class AwaitableValidateArgsResult(ValidateArgsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return ValidateArgsResult(
+            problems=self.problems)
+
+
+def validate_args(allow_host_path: Optional[bool] = None,
+                  annotations: Optional[Mapping[str, str]] = None,
+                  args: Optional[Sequence[str]] = None,
+                  autoscaling: Optional[pulumi.InputType['Autoscaling']] = None,
+                  await_: Optional[pulumi.InputType['Await']] = None,
+                  cache: Optional[pulumi.InputType['CacheConnection']] = None,
+                  clusters: Optional[Sequence[pulumi.InputType['Cluster']]] = None,
+                  command: Optional[Sequence[str]] = None,
+                  context: Optional[str] = None,
+                  database: Optional[pulumi.InputType['DatabaseConnection']] = None,
+                  environment: Optional['Environment'] = None,
+                  image: Optional[str] = None,
+                  ingress: Optional[pulumi.InputType['Ingress']] = None,
+                  kubeconfig: Optional[str] = None,
+                  labels: Optional[Mapping[str, str]] = None,
+                  liveness_probe: Optional[pulumi.InputType['Probe']] = None,
+                  name: Optional[str] = None,
+                  patches: Optional[Mapping[str, pulumi.InputType['Patch']]] = None,
+                  pod_annotations: Optional[Mapping[str, str]] = None,
+                  port: Optional[int] = None,
+                  pre_deploy_job: Optional[pulumi.InputType['PreDeployJob']] = None,
+                  quota: Optional[pulumi.InputType['Quota']] = None,
+                  readiness_probe: Optional[pulumi.InputType['Probe']] = None,
+                  registry_credentials: Optional[pulumi.InputType['RegistryCredentials']] = None,
+                  resources: Optional[pulumi.InputType['Resources']] = None,
+                  sanitize_name: Optional[bool] = None,
+                  service_type: Optional['ServiceType'] = None,
+                  shutdown: Optional[pulumi.InputType['Shutdown']] = None,
+                  size: Optional['Size'] = None,
+                  smoke_test: Optional[pulumi.InputType['SmokeTest']] = None,
+                  volume_mounts: Optional[Sequence[pulumi.InputType['VolumeMount']]] = None,
+                  volumes: Optional[Sequence[pulumi.InputType['Volume']]] = None,
+                  working_dir: Optional[str] = None,
+                  opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableValidateArgsResult:
+    """
+    Checks a Deployment's name and inputs without creating anything, applying the provider's configuration as constructing it would.
+
+
+    :param bool allow_host_path: Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+    :param Mapping[str, str] annotations: Annotations added to every resource the component creates
+    :param Sequence[str] args: Overrides the arguments of the application image
+    :param pulumi.InputType['Autoscaling'] autoscaling: Scales the application with a HorizontalPodAutoscaler
+    :param pulumi.InputType['Await'] await_: How long to wait for the application to become ready
+    :param pulumi.InputType['CacheConnection'] cache: A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+    :param Sequence[pulumi.InputType['Cluster']] clusters: Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+    :param Sequence[str] command: Overrides the entrypoint of the application image
+    :param str context: The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+    :param pulumi.InputType['DatabaseConnection'] database: A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+    :param 'Environment' environment: The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+    :param str image: The image to deploy in your production application
+    :param pulumi.InputType['Ingress'] ingress: An Ingress that routes a host name to the application. The url becomes the Ingress's
+    :param str kubeconfig: The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+    :param Mapping[str, str] labels: Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+    :param pulumi.InputType['Probe'] liveness_probe: An HTTP check that restarts the application's container when it fails
+    :param str name: The name the Deployment would be given
+    :param Mapping[str, pulumi.InputType['Patch']] patches: Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+    :param Mapping[str, str] pod_annotations: Annotations added to the application's pods
+    :param int port: The port your container listens on
+    :param pulumi.InputType['PreDeployJob'] pre_deploy_job: A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+    :param pulumi.InputType['Quota'] quota: Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+    :param pulumi.InputType['Probe'] readiness_probe: An HTTP check that must pass before the application's pods receive traffic
+    :param pulumi.InputType['RegistryCredentials'] registry_credentials: Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+    :param pulumi.InputType['Resources'] resources: Resource requests and limits for the application's container, overriding the ones the environment or size sets
+    :param bool sanitize_name: Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+    :param 'ServiceType' service_type: The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+    :param pulumi.InputType['Shutdown'] shutdown: How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+    :param 'Size' size: The resource preset for the application's container, replacing the environment's resources
+    :param pulumi.InputType['SmokeTest'] smoke_test: An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+    :param Sequence[pulumi.InputType['VolumeMount']] volume_mounts: Where to mount `volumes` in the application container
+    :param Sequence[pulumi.InputType['Volume']] volumes: Volumes available to the application container
+    :param str working_dir: The working directory of the application container
+    """
+    __args__ = dict()
+    __args__['allowHostPath'] = allow_host_path
+    __args__['annotations'] = annotations
+    __args__['args'] = args
+    __args__['autoscaling'] = autoscaling
+    __args__['await'] = await_
+    __args__['cache'] = cache
+    __args__['clusters'] = clusters
+    __args__['command'] = command
+    __args__['context'] = context
+    __args__['database'] = database
+    __args__['environment'] = environment
+    __args__['image'] = image
+    __args__['ingress'] = ingress
+    __args__['kubeconfig'] = kubeconfig
+    __args__['labels'] = labels
+    __args__['livenessProbe'] = liveness_probe
+    __args__['name'] = name
+    __args__['patches'] = patches
+    __args__['podAnnotations'] = pod_annotations
+    __args__['port'] = port
+    __args__['preDeployJob'] = pre_deploy_job
+    __args__['quota'] = quota
+    __args__['readinessProbe'] = readiness_probe
+    __args__['registryCredentials'] = registry_credentials
+    __args__['resources'] = resources
+    __args__['sanitizeName'] = sanitize_name
+    __args__['serviceType'] = service_type
+    __args__['shutdown'] = shutdown
+    __args__['size'] = size
+    __args__['smokeTest'] = smoke_test
+    __args__['volumeMounts'] = volume_mounts
+    __args__['volumes'] = volumes
+    __args__['workingDir'] = working_dir
+    if opts is None:
+        opts = pulumi.InvokeOptions()
+    if opts.version is None:
+        opts.version = _utilities.get_version()
+    __ret__ = pulumi.runtime.invoke('productionapp:index:validateArgs', __args__, opts=opts, typ=ValidateArgsResult).value
+
+    return AwaitableValidateArgsResult(
+        problems=__ret__.problems)
+
+
+@_utilities.lift_output_func(validate_args)
+def validate_args_output(allow_host_path: Optional[pulumi.Input[Optional[bool]]] = None,
+                         annotations: Optional[pulumi.Input[Optional[Mapping[str, str]]]] = None,
+                         args: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                         autoscaling: Optional[pulumi.Input[Optional[pulumi.InputType['Autoscaling']]]] = None,
+                         await_: Optional[pulumi.Input[Optional[pulumi.InputType['Await']]]] = None,
+                         cache: Optional[pulumi.Input[Optional[pulumi.InputType['CacheConnection']]]] = None,
+                         clusters: Optional[pulumi.Input[Optional[Sequence[pulumi.InputType['Cluster']]]]] = None,
+                         command: Optional[pulumi.Input[Optional[Sequence[str]]]] = None,
+                         context: Optional[pulumi.Input[Optional[str]]] = None,
+                         database: Optional[pulumi.Input[Optional[pulumi.InputType['DatabaseConnection']]]] = None,
+                         environment: Optional[pulumi.Input[Optional['Environment']]] = None,
+                         image: Optional[pulumi.Input[Optional[str]]] = None,
+                         ingress: Optional[pulumi.Input[Optional[pulumi.InputType['Ingress']]]] = None,
+                         kubeconfig: Optional[pulumi.Input[Optional[str]]] = None,
+                         labels: Optional[pulumi.Input[Optional[Mapping[str, str]]]] = None,
+                         liveness_probe: Optional[pulumi.Input[Optional[pulumi.InputType['Probe']]]] = None,
+                         name: Optional[pulumi.Input[str]] = None,
+                         patches: Optional[pulumi.Input[Optional[Mapping[str, pulumi.InputType['Patch']]]]] = None,
+                         pod_annotations: Optional[pulumi.Input[Optional[Mapping[str, str]]]] = None,
+                         port: Optional[pulumi.Input[Optional[int]]] = None,
+                         pre_deploy_job: Optional[pulumi.Input[Optional[pulumi.InputType['PreDeployJob']]]] = None,
+                         quota: Optional[pulumi.Input[Optional[pulumi.InputType['Quota']]]] = None,
+                         readiness_probe: Optional[pulumi.Input[Optional[pulumi.InputType['Probe']]]] = None,
+                         registry_credentials: Optional[pulumi.Input[Optional[pulumi.InputType['RegistryCredentials']]]] = None,
+                         resources: Optional[pulumi.Input[Optional[pulumi.InputType['Resources']]]] = None,
+                         sanitize_name: Optional[pulumi.Input[Optional[bool]]] = None,
+                         service_type: Optional[pulumi.Input[Optional['ServiceType']]] = None,
+                         shutdown: Optional[pulumi.Input[Optional[pulumi.InputType['Shutdown']]]] = None,
+                         size: Optional[pulumi.Input[Optional['Size']]] = None,
+                         smoke_test: Optional[pulumi.Input[Optional[pulumi.InputType['SmokeTest']]]] = None,
+                         volume_mounts: Optional[pulumi.Input[Optional[Sequence[pulumi.InputType['VolumeMount']]]]] = None,
+                         volumes: Optional[pulumi.Input[Optional[Sequence[pulumi.InputType['Volume']]]]] = None,
+                         working_dir: Optional[pulumi.Input[Optional[str]]] = None,
+                         opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[ValidateArgsResult]:
+    """
+    Checks a Deployment's name and inputs without creating anything, applying the provider's configuration as constructing it would.
+
+
+    :param bool allow_host_path: Whether `hostPath` volumes are allowed. They expose the node's filesystem and are denied by default
+    :param Mapping[str, str] annotations: Annotations added to every resource the component creates
+    :param Sequence[str] args: Overrides the arguments of the application image
+    :param pulumi.InputType['Autoscaling'] autoscaling: Scales the application with a HorizontalPodAutoscaler
+    :param pulumi.InputType['Await'] await_: How long to wait for the application to become ready
+    :param pulumi.InputType['CacheConnection'] cache: A cache to connect the application to. `REDIS_HOST` and `REDIS_PORT` are set as environment variables and the connection string is injected as `REDIS_URL` from a Kubernetes secret
+    :param Sequence[pulumi.InputType['Cluster']] clusters: Clusters to deploy the application to. Each gets its own namespace, Deployment and Service, named after the resource and the cluster. Cannot be combined with `kubeconfig` or `context`
+    :param Sequence[str] command: Overrides the entrypoint of the application image
+    :param str context: The kubeconfig context to create the application's resources in. Defaults to the kubeconfig's current context
+    :param pulumi.InputType['DatabaseConnection'] database: A database to connect the application to. The connection string is injected as the `DATABASE_URL` environment variable from a Kubernetes secret
+    :param 'Environment' environment: The environment tier to deploy to: `dev` (one small replica), `staging` (two replicas spread across nodes with a PodDisruptionBudget) or `prod` (three to ten autoscaled replicas spread across nodes, with a PodDisruptionBudget and a protected namespace). Defaults to the provider's `environment`; without one, three replicas are deployed
+    :param str image: The image to deploy in your production application
+    :param pulumi.InputType['Ingress'] ingress: An Ingress that routes a host name to the application. The url becomes the Ingress's
+    :param str kubeconfig: The contents of a kubeconfig file, or the path to one, to create the application's resources with. Defaults to the ambient Kubernetes provider
+    :param Mapping[str, str] labels: Labels added to every resource the component creates. The labels that select the application's pods cannot be overridden
+    :param pulumi.InputType['Probe'] liveness_probe: An HTTP check that restarts the application's container when it fails
+    :param str name: The name the Deployment would be given
+    :param Mapping[str, pulumi.InputType['Patch']] patches: Patches applied to the arguments of the resources the component creates, keyed by resource kind (Deployment, HorizontalPodAutoscaler, Ingress, Job, LimitRange, Namespace, PodDisruptionBudget, ResourceQuota, Secret or Service). A patch applies to every resource of its kind
+    :param Mapping[str, str] pod_annotations: Annotations added to the application's pods
+    :param int port: The port your container listens on
+    :param pulumi.InputType['PreDeployJob'] pre_deploy_job: A one-shot job, such as a database migration, that must complete successfully before the application is rolled out. A new job runs whenever the image changes
+    :param pulumi.InputType['Quota'] quota: Caps on what the application may consume in its namespace. Creates a ResourceQuota and a LimitRange that gives containers default requests and limits
+    :param pulumi.InputType['Probe'] readiness_probe: An HTTP check that must pass before the application's pods receive traffic
+    :param pulumi.InputType['RegistryCredentials'] registry_credentials: Credentials for pulling the application image from a private registry. Either `server`, `username` and `password`, from which a `kubernetes.io/dockerconfigjson` secret is created in the application namespace, or the name of an existing secret
+    :param pulumi.InputType['Resources'] resources: Resource requests and limits for the application's container, overriding the ones the environment or size sets
+    :param bool sanitize_name: Derive valid Kubernetes names from the resource's name by lowercasing it, replacing invalid characters and truncating it with a hash suffix. By default, invalid names are rejected
+    :param 'ServiceType' service_type: The type of the Service in front of the application. Defaults to `LoadBalancer`. The url of other Services is only reachable from inside the cluster, unless an ingress is set
+    :param pulumi.InputType['Shutdown'] shutdown: How the application's pods are stopped during rollouts. Because the application sits behind a load balancer, pods sleep for 10 seconds before receiving SIGTERM by default so that endpoint deregistration can propagate
+    :param 'Size' size: The resource preset for the application's container, replacing the environment's resources
+    :param pulumi.InputType['SmokeTest'] smoke_test: An HTTP check the application's URL must pass after it is deployed. Failing it fails the update. The application must be reachable from outside the cluster, through a `LoadBalancer` Service or an ingress, and `await.waitForLoadBalancer` must not be disabled
+    :param Sequence[pulumi.InputType['VolumeMount']] volume_mounts: Where to mount `volumes` in the application container
+    :param Sequence[pulumi.InputType['Volume']] volumes: Volumes available to the application container
+    :param str working_dir: The working directory of the application container
+    """
+    ...